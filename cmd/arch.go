@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/huangsam/hotspot/core"
+	"github.com/spf13/cobra"
+)
+
+// archCmd groups the architecture analyses.
+var archCmd = &cobra.Command{
+	Use:   "arch",
+	Short: "Enforce declared component boundaries on co-change coupling",
+	Long: `Enforce declared component boundaries on co-change coupling.
+
+Components are the modules under coupling.modules in the config file. Each
+one names its path patterns and may list, under allow, the components it is
+allowed to change together with.
+
+Subcommands:
+  check - Fail CI when a change set adds coupling across component boundaries`,
+}
+
+// archCheckCmd checks a change set against the allowed component dependencies.
+var archCheckCmd = &cobra.Command{
+	Use:   "check [repo-path]",
+	Short: "Fail CI when a change set adds coupling across component boundaries",
+	Long: `Check the changes between Git references against the allowed component
+dependencies, and fail with a non-zero exit code on new violations.
+
+Two components that change together are a violation unless either lists
+the other under allow. The change set is checked as a whole, the way a
+squash merge would land it, and commit by commit. Each violation is then
+compared with the history of the base ref within --lookback (default 6
+months): it is new when no commit changed both components there, and
+existing otherwise. The check fails when the new violations exceed
+--tolerance (default 0). Existing violations are reported but do not fail
+the check.
+
+Example config:
+  coupling:
+    modules:
+      - { name: api, paths: ["services/api/**"], allow: [shared] }
+      - { name: billing, paths: ["services/billing/**"], allow: [shared] }
+      - { name: shared, paths: ["libs/**"] }
+
+Examples:
+  # Check a pull request against the main branch
+  hotspot arch check --base-ref origin/main --target-ref HEAD
+
+  # Accept up to two new cross-boundary pairs
+  hotspot arch check --base-ref main --target-ref feature --tolerance 2
+
+  # Compare against a year of history on the base branch
+  hotspot arch check --base-ref main --lookback "1 year"
+`,
+	Args:    cobra.MaximumNArgs(1),
+	PreRunE: sharedSetupWrapper,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := core.ExecuteHotspotArchCheck(cmd.Context(), cfg, gitClient); err != nil {
+			return fmt.Errorf("architecture check failed: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(archCmd)
+	archCmd.AddCommand(archCheckCmd)
+}
@@ -21,6 +21,30 @@ the explicit import graph, such as:
 - Microservices that share a database schema.
 - Configuration files married to specific binary logic.
 
+In Go modules, each pair of Go files is checked against the package
+import graph at HEAD. A pair is explained when both files share a package
+or one package imports the other, and hidden otherwise. Hidden pairs are
+the coupling the code does not declare; use --hidden to rank only them.
+
+Use --level folder to count co-changes between folders, named by their
+first --folder-depth directories, or --level module for the modules
+defined under coupling.modules in the config file. Each pair also reports
+its support (share of commits that changed both) and its confidence in
+both directions (share of one side's commits that also changed the other).
+
+Use --path to list everything that tends to change when one file, folder
+or module changes. Each entry reports its confidence P(B|A), support,
+co-change count and lift, and needs a confidence of at least 30%.
+Entries reached through other entries are included up to --impact-depth
+steps away, with an impact that multiplies the confidences on the way.
+
+Use --graph-format dot, graphml or json to export the pairs or the impact
+set as a graph for Graphviz, Gephi or other tools. Nodes carry their folder,
+hotspot score in the current mode and owners from a file analysis of the
+same window; folders and modules take the score of their top file. Edges
+are weighted by the coupling score, or by the confidence of impact rules.
+DOT edges use the co-change count as weight, since Graphviz needs integers.
+
 Examples:
   # Find the top coupled file pairs in the repo
   hotspot blast-radius
@@ -28,13 +52,25 @@ Examples:
   # Limit to top 20 pairs
   hotspot blast-radius --limit 20
 
+  # Rank Go pairs that no import explains
+  hotspot blast-radius --hidden
+
+  # Find the services that always change together
+  hotspot blast-radius --level folder --folder-depth 2 --filter services/
+
+  # List what tends to change with a file, two steps out
+  hotspot blast-radius --path core/builder.go --impact-depth 2
+
+  # Render folder coupling with Graphviz
+  hotspot blast-radius --level folder --graph-format dot | dot -Tsvg > coupling.svg
+
   # Analyze a specific repository
   hotspot blast-radius /path/to/repo
 `,
 	Args:    cobra.MaximumNArgs(1),
 	PreRunE: sharedSetupWrapper,
 	RunE: func(cmd *cobra.Command, _ []string) error {
-		if err := core.ExecuteHotspotBlastRadius(cmd.Context(), cfg, gitClient, resultWriter); err != nil {
+		if err := core.ExecuteHotspotBlastRadius(cmd.Context(), cfg, gitClient, cacheManager, resultWriter); err != nil {
 			return fmt.Errorf("cannot run blast radius analysis: %w", err)
 		}
 		return nil
@@ -16,7 +16,7 @@ var checkCmd = &cobra.Command{
 Designed specifically for CI/CD integration - fails with non-zero exit code when files
 exceed acceptable risk levels. Analyzes only the changed files, making it fast and focused.
 
-Default thresholds: 50.0 for all modes (hot, risk, complexity, roi, active_owners, refactor_now, legacy_debt)
+Default thresholds: 50.0 for all modes (hot, risk, complexity, roi, defects, active_owners, refactor_now, legacy_debt)
 
 Use cases:
 - Pull request gates - block merges with high-risk changes
@@ -45,7 +45,10 @@ func init() {
 	rootCmd.PersistentFlags().String("exclude", schema.DefaultExclude, "Comma-separated list of path prefixes or patterns to ignore")
 	rootCmd.PersistentFlags().StringP("filter", "f", "", "Filter targets by path prefix")
 	rootCmd.PersistentFlags().IntP("limit", "l", 0, "Number of results to display")
-	rootCmd.PersistentFlags().String("mode", "", "Scoring mode: hot, risk, complexity, roi, active_owners, refactor_now, legacy_debt")
+	rootCmd.PersistentFlags().String("mode", "", "Scoring mode: hot, risk, complexity, roi, defects, active_owners, refactor_now, legacy_debt")
+	rootCmd.PersistentFlags().String("normalization", "", "Metric normalization: fixed or percentile or log")
+	rootCmd.PersistentFlags().String("folder-aggregation", "", "Folder score aggregation: mean (LOC-weighted) or max or p90 or excess or count")
+	rootCmd.PersistentFlags().Float64("folder-threshold", 0, "File score above which --folder-aggregation excess and count treat a file as a hotspot (default 60)")
 	rootCmd.PersistentFlags().String("output", "", "Output format: text or csv or json or parquet or markdown or describe or heatmap")
 	rootCmd.PersistentFlags().String("output-file", "", "Optional path to write output to")
 	rootCmd.PersistentFlags().Bool("owner", false, "Print per-target owner")
@@ -66,6 +69,7 @@ func init() {
 	rootCmd.PersistentFlags().String("config", "", "Path to config file")
 	rootCmd.PersistentFlags().String("log-level", "warn", "Log level for telemetry output (error, warn, info, debug)")
 	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Silent mode. Suppresses all output except errors.")
+	rootCmd.PersistentFlags().Bool("no-work-patterns", false, "Do not collect the local hour and weekday of commits")
 	if err := viper.BindPFlags(rootCmd.PersistentFlags()); err != nil {
 		logger.Fatal("Error binding root flags", err)
 	}
@@ -77,6 +81,30 @@ func init() {
 		logger.Fatal("Error binding files flags", err)
 	}
 
+	// Bind all flags of foldersCmd to Viper
+	foldersCmd.Flags().Int("depth", 0, "Rank folders this many directories deep, rolling up all files below them (0 ranks the immediate parent folders of files)")
+	foldersCmd.Flags().Bool("tree", false, "Print folders as a nested tree with scores and owners at every level")
+	if err := viper.BindPFlags(foldersCmd.Flags()); err != nil {
+		logger.Fatal("Error binding folders flags", err)
+	}
+
+	// Bind all flags of blastRadiusCmd to Viper
+	blastRadiusCmd.Flags().Bool("hidden", false, "Rank only Go file pairs that no import explains")
+	blastRadiusCmd.Flags().String("level", "file", "Coupling unit: file, folder or module (modules come from the config file)")
+	blastRadiusCmd.Flags().Int("folder-depth", 1, "Number of leading directories that name a folder with --level folder")
+	blastRadiusCmd.Flags().String("path", "", "File, folder or module whose impact set to compute instead of the top pairs")
+	blastRadiusCmd.Flags().Int("impact-depth", 2, "Number of association rules to follow from --path")
+	blastRadiusCmd.Flags().String("graph-format", "", "Export the coupling as a graph instead of a table: dot, graphml or json")
+	if err := viper.BindPFlags(blastRadiusCmd.Flags()); err != nil {
+		logger.Fatal("Error binding blast radius flags", err)
+	}
+
+	// Bind all flags of functionsCmd to Viper
+	functionsCmd.Flags().Int("files", 5, "Number of top Go files to analyze when no file is given")
+	if err := viper.BindPFlags(functionsCmd.Flags()); err != nil {
+		logger.Fatal("Error binding functions flags", err)
+	}
+
 	// Bind all flags of timeseriesCmd to Viper
 	timeseriesCmd.Flags().String("path", "", "Path to the file or folder to analyze")
 	timeseriesCmd.Flags().String("interval", "3 months", "Total time interval")
@@ -85,8 +113,14 @@ func init() {
 		logger.Fatal("Error binding timeseries flags", err)
 	}
 
+	// Bind all flags of archCheckCmd to Viper
+	archCheckCmd.Flags().Int("tolerance", 0, "Number of new cross-boundary component pairs allowed before the check fails")
+	if err := viper.BindPFlags(archCheckCmd.Flags()); err != nil {
+		logger.Fatal("Error binding arch check flags", err)
+	}
+
 	// Bind all flags of checkCmd to Viper
-	checkCmd.Flags().String("thresholds-override", "", "Risk thresholds for CI/CD gating (format: 'hot:50,risk:50,complexity:50,roi:50,active_owners:50,refactor_now:50,legacy_debt:50')")
+	checkCmd.Flags().String("thresholds-override", "", "Risk thresholds for CI/CD gating (format: 'hot:50,risk:50,complexity:50,roi:50,defects:50,active_owners:50,refactor_now:50,legacy_debt:50')")
 	if err := viper.BindPFlags(checkCmd.Flags()); err != nil {
 		logger.Fatal("Error binding check flags", err)
 	}
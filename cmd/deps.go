@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/huangsam/hotspot/core"
+	"github.com/spf13/cobra"
+)
+
+// depsCmd analyzes the history of the dependency manifests.
+var depsCmd = &cobra.Command{
+	Use:   "deps [repo-path]",
+	Short: "Rank dependencies by how often they change and who changes them",
+	Long: `Walk the history of the dependency manifests and lockfiles and report
+which dependencies change most often, who upgrades them, when each was
+last changed and which manifests are knowledge silos.
+
+Supported files: go.mod, go.sum, package.json, package-lock.json,
+requirements.txt, Cargo.toml and Cargo.lock. Manifests under vendor/ and
+node_modules/ are skipped. The dependency list of each manifest is read
+at every commit that changed it, so a change counts when a dependency is
+added, removed or moved to another version. Excludes do not apply here.
+
+A manifest is a silo when one author made at least 80% of its commits,
+with 3 or more commits in the window.
+
+Examples:
+  # Find the dependencies that churn the most
+  hotspot deps
+
+  # Check one service over the last year
+  hotspot deps --filter services/api/ --start "1 year ago"
+
+  # Export the dependency history as JSON
+  hotspot deps --output json
+`,
+	Args:    cobra.MaximumNArgs(1),
+	PreRunE: sharedSetupWrapper,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := core.ExecuteHotspotDeps(cmd.Context(), cfg, gitClient, resultWriter); err != nil {
+			return fmt.Errorf("cannot run dependency analysis: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(depsCmd)
+}
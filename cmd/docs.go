@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/huangsam/hotspot/core"
+	"github.com/spf13/cobra"
+)
+
+// docsCmd groups the documentation analyses.
+var docsCmd = &cobra.Command{
+	Use:   "docs",
+	Short: "Analyze how well folder documentation keeps up with the code",
+	Long: `Analyze how well folder documentation keeps up with the code.
+
+A folder's docs are the Markdown files directly in it and every file under
+its docs/ directory.
+
+Subcommands:
+  drift - Rank documented folders by how stale their docs are`,
+}
+
+// docsDriftCmd ranks documented folders by code activity since their docs last changed.
+var docsDriftCmd = &cobra.Command{
+	Use:   "drift [repo-path]",
+	Short: "Rank documented folders by how stale their docs are",
+	Long: `Rank documented folders by how much their code changed since their docs
+were last modified.
+
+For every active folder with docs, this reports the code commits and churn
+made after the latest commit that touched the docs, and the share of the
+folder's code commits that the docs missed. A commit that changes code and
+docs together is not drift. Docs not modified within the analysis window
+are reported as changed "before window".
+
+Examples:
+  # Find the READMEs and ADRs most likely to be out of date
+  hotspot docs drift
+
+  # Check one subsystem over the last year
+  hotspot docs drift --filter internal/ --start "1 year ago"
+
+  # Export the drift report as CSV
+  hotspot docs drift --output csv
+`,
+	Args:    cobra.MaximumNArgs(1),
+	PreRunE: sharedSetupWrapper,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := core.ExecuteHotspotDocsDrift(cmd.Context(), cfg, gitClient, cacheManager, resultWriter); err != nil {
+			return fmt.Errorf("cannot run documentation drift analysis: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(docsCmd)
+	docsCmd.AddCommand(docsDriftCmd)
+}
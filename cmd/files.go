@@ -26,6 +26,7 @@ Base Modes:
 - risk: Few contributors or concentrated ownership (knowledge risk)
 - complexity: Large, old, volatile files (technical debt)
 - roi: High churn on complex files (refactoring priority)
+- defects: High share of bug-fix commits (defect-prone code)
 
 Composite Modes (blend multiple base modes):
 - active_owners: 50% hot + 50% risk (volatile + siloed code)
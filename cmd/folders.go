@@ -19,7 +19,17 @@ Aggregates file-level analysis to folder level. Helps you:
 - Find areas that need architectural attention
 - Plan refactoring efforts strategically
 
-Each folder's score is weighted by file size and activity.
+Each folder's score is the LOC-weighted mean of its file scores. Use
+--folder-aggregation max, p90, excess or count so a single hot file is not
+averaged away. --detail shows the file that contributes most to each folder.
+
+By default every file counts toward its immediate parent folder. Files at the
+repository root have no folder of their own and are left out unless --path is
+set; they count toward the root (".") of the --tree roll-up. With --depth N,
+folders N directories deep are ranked instead, and each rolls up the metrics,
+owners and Gini of every file below it. With --tree, the roll-up is printed as a
+nested tree: --limit caps the subfolders shown under each folder and --depth caps
+how deep the tree goes.
 
 Examples:
   # Find the riskiest subsystems
@@ -35,7 +45,16 @@ Examples:
   hotspot folders --mode roi
 
   # Include metrics and owner information
-  hotspot folders --detail --owner`,
+  hotspot folders --detail --owner
+
+  # Rank folders by their worst file
+  hotspot folders --folder-aggregation max --detail
+
+  # Rank top-level subsystems by everything below them
+  hotspot folders --depth 1
+
+  # Print a nested roll-up three levels deep with owners
+  hotspot folders --tree --depth 3 --owner`,
 	Args:    cobra.MaximumNArgs(1),
 	PreRunE: sharedSetupWrapper,
 	RunE: func(cmd *cobra.Command, _ []string) error {
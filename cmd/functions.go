@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/huangsam/hotspot/core"
+	"github.com/spf13/cobra"
+)
+
+// functionsCmd ranks the functions of Go files by their change history and complexity.
+var functionsCmd = &cobra.Command{
+	Use:   "functions [file]",
+	Short: "Rank Go functions by commits, churn, authors and complexity",
+	Long: `Break Go files down into their functions and score each function with
+the same pipeline as files, so the hot or risky functions inside a large
+file stand out.
+
+Every hunk in a file's history is mapped to the function that enclosed it
+at that revision, so a function keeps its history when it moves within the
+file. Deleted lines count toward the function that held them before the
+commit. Only functions still declared at HEAD (or at the last commit before
+--end) are reported, and a renamed function starts a new history.
+
+Pass a .go file to analyze it alone. Without one, the functions of the top
+Go files in the current mode are analyzed (--files, default 5). Functions
+are normalized against each other rather than against the file maxima.
+
+Examples:
+  # Find the hottest functions in one file
+  hotspot functions core/builder.go
+
+  # Rank the functions of the 10 riskiest Go files
+  hotspot functions --mode risk --files 10
+
+  # Export function complexity hotspots as JSON
+  hotspot functions --mode complexity --output json
+`,
+	Args:    cobra.MaximumNArgs(1),
+	PreRunE: sharedSetupWrapper,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := core.ExecuteHotspotFunctions(cmd.Context(), cfg, gitClient, cacheManager, resultWriter); err != nil {
+			return fmt.Errorf("cannot run function analysis: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(functionsCmd)
+}
@@ -147,6 +147,11 @@ func sharedSetup(cmd *cobra.Command, args []string) error {
 	}
 
 	// 2. Unmarshal all resolved values from Viper into our raw input struct.
+	// Commands may declare flags with the same name (e.g. --path), and Viper keeps only
+	// the last flag bound to a key, so rebind the flags of the command being run first.
+	if err := viper.BindPFlags(cmd.Flags()); err != nil {
+		return fmt.Errorf("unable to bind flags: %w", err)
+	}
 	if err := viper.Unmarshal(input); err != nil {
 		return fmt.Errorf("unable to unmarshal config: %w", err)
 	}
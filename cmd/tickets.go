@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/huangsam/hotspot/core"
+	"github.com/spf13/cobra"
+)
+
+// ticketsCmd lists the issue tracker tickets behind a file or folder.
+var ticketsCmd = &cobra.Command{
+	Use:   "tickets [path]",
+	Short: "List the issue tracker tickets whose commits touched a file or folder",
+	Long: `Extract issue tracker keys from commit subjects and bodies and list the
+tickets that touched a path, ordered by the number of referencing commits.
+
+JIRA-style keys (PAY-1234) and GitHub references (#567) are found by default.
+Use the 'tickets.patterns' config key to match other formats. This view
+helps justify refactoring a hotspot with its real incident history.
+
+Examples:
+  # List the tickets behind a hotspot file
+  hotspot tickets core/analysis.go
+
+  # List the tickets that touched a folder in the last year
+  hotspot tickets internal/payments/ --start "1 year ago"
+
+  # Export the ticket history as CSV
+  hotspot tickets core/analysis.go --output csv
+`,
+	Args:    cobra.MaximumNArgs(1),
+	PreRunE: sharedSetupWrapper,
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		if err := core.ExecuteHotspotTickets(cmd.Context(), cfg, gitClient, resultWriter); err != nil {
+			return fmt.Errorf("cannot run ticket analysis: %w", err)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(ticketsCmd)
+}
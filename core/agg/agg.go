@@ -5,7 +5,9 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"math"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -38,9 +40,10 @@ func aggregateActivity(ctx context.Context, gitSettings config.GitSettings, clie
 		endTime = time.Now()
 	}
 	output := initializeAggregateOutput(endTime)
+	output.Temporal = gitSettings.GetTemporalModel()
 
 	// 3. Determine recent window threshold (e.g., 30 days before EndTime or Now)
-	recentThreshold := endTime.AddDate(0, 0, -30) // Fixed 30-day window for now
+	recentThreshold := endTime.AddDate(0, 0, -output.Temporal.RecentWindowDays)
 
 	// 4. Run the git log command
 	out, err := client.GetActivityLog(ctx, gitSettings.GetRepoPath(), gitSettings.GetPathFilter(), gitSettings.GetStartTime(), gitSettings.GetEndTime())
@@ -48,8 +51,29 @@ func aggregateActivity(ctx context.Context, gitSettings config.GitSettings, clie
 		return nil, err
 	}
 
-	// 5. Parse and aggregate the git log output
-	parseAndAggregateGitLog(out, fileExists, output, recentThreshold)
+	// 5. Find commits whose message body marks them as reverts
+	revertLog, err := client.GetRevertLog(ctx, gitSettings.GetRepoPath(), gitSettings.GetPathFilter(), gitSettings.GetStartTime(), gitSettings.GetEndTime())
+	if err != nil {
+		return nil, err
+	}
+
+	// 6. Read commit bodies, where ticket references usually sit
+	bodyLog, err := client.GetCommitBodyLog(ctx, gitSettings.GetRepoPath(), gitSettings.GetPathFilter(), gitSettings.GetStartTime(), gitSettings.GetEndTime())
+	if err != nil {
+		return nil, err
+	}
+
+	// 7. Parse and aggregate the git log output
+	rules := commitRules{
+		fixes:   gitSettings.GetFixClassifier(),
+		tickets: gitSettings.GetTicketExtractor(),
+		reverts: parseRevertLog(revertLog),
+		bodies:  ParseCommitBodyLog(bodyLog),
+		tests:   gitSettings.GetTestPairing(),
+
+		noWorkPatterns: !gitSettings.IsWorkPatterns(),
+	}
+	parseAndAggregateGitLog(out, fileExists, output, recentThreshold, rules)
 
 	return output, nil
 }
@@ -73,18 +97,91 @@ func initializeAggregateOutput(endTime time.Time) *schema.AggregateOutput {
 	}
 }
 
+// commitInfo holds the parsed header of the commit whose file stats are being aggregated.
+type commitInfo struct {
+	hash     string
+	author   string
+	date     time.Time
+	subject  string
+	isFix    bool
+	isRevert bool
+	offHours bool // Made outside working hours in the author's local time
+	weekend  bool // Made on a weekend in the author's local time
+	tickets  []string
+}
+
+// commitRules holds the settings used to interpret commit headers. Nil rules fall back to the defaults.
+type commitRules struct {
+	fixes   *schema.CommitClassifier
+	tickets *schema.TicketExtractor
+	reverts map[string]bool   // Hashes of commits whose message body marks them as reverts
+	bodies  map[string]string // Message bodies by commit hash, scanned for tickets
+	tests   *schema.TestPairing
+
+	noWorkPatterns bool // Skip collecting the local hour and weekday of commits
+}
+
+// parseRevertLog reads the commit hashes listed by GetRevertLog.
+func parseRevertLog(out []byte) map[string]bool {
+	reverts := make(map[string]bool)
+	for line := range bytes.SplitSeq(out, []byte("\n")) {
+		if hash := bytes.Trim(line, " \t\r'"); len(hash) > 0 {
+			reverts[string(hash)] = true
+		}
+	}
+	return reverts
+}
+
+// ParseCommitBodyLog reads the records of GetCommitBodyLog into message bodies by commit
+// hash. Commits without a body are left out.
+func ParseCommitBodyLog(out []byte) map[string]string {
+	bodies := make(map[string]string)
+	for record := range bytes.SplitSeq(out, []byte{0x1e}) {
+		hash, body, ok := bytes.Cut(record, []byte{0x1f})
+		if !ok {
+			continue
+		}
+		if body = bytes.TrimSpace(body); len(body) > 0 {
+			bodies[string(bytes.TrimSpace(hash))] = string(body)
+		}
+	}
+	return bodies
+}
+
 // parseAndAggregateGitLog processes the git log output and aggregates data into the output maps.
-func parseAndAggregateGitLog(out []byte, fileExists map[string]string, output *schema.AggregateOutput, recentThreshold time.Time) {
+// Commit subjects are classified, scanned for tickets and checked for reverts using rules.
+func parseAndAggregateGitLog(out []byte, fileExists map[string]string, output *schema.AggregateOutput, recentThreshold time.Time, rules commitRules) {
 	scanner := bufio.NewScanner(bytes.NewReader(out))
-	var currentAuthor string
-	var currentDate time.Time
+	var current commitInfo
+	classifier := rules.fixes
+	if classifier == nil {
+		classifier = schema.DefaultCommitClassifier()
+	}
+	extractor := rules.tickets
+	if extractor == nil {
+		extractor = schema.DefaultTicketExtractor()
+	}
+	temporal := output.Temporal.WithDefaults()
+	rework := NewReworkTracker(temporal.ReworkWindowDays)
+	entropy := newEntropyTracker(output.EndTime, temporal.EntropyPeriodDays)
+	sizes := newCommitSizeTracker()
+	pairing := rules.tests
+	if pairing == nil {
+		pairing = schema.DefaultTestPairing()
+	}
+	tests := newTestPairingTracker(pairing, fileExists)
+	docs := newDocDriftTracker(fileExists)
+	seq := 0
+	if !rules.noWorkPatterns {
+		output.WorkHeatmap = &schema.WorkHeatmap{}
+	}
 
 	// authorCache interns strings to reuse author names across many commits
 	authorCache := make(map[string]string)
 
 	for scanner.Scan() {
 		// We must trim whitespace and single quotes because git log format
-		// --pretty=format:'--%H|%an|%ad' wraps the header in quotes.
+		// --pretty=format:'--%H|%an|%ad|%s' wraps the header in quotes.
 		l := bytes.Trim(scanner.Bytes(), " \t\r\n'")
 		if len(l) == 0 {
 			continue
@@ -92,26 +189,52 @@ func parseAndAggregateGitLog(out []byte, fileExists map[string]string, output *s
 
 		if bytes.HasPrefix(l, []byte("--")) {
 			// Commit header line
-			currentAuthor, currentDate = parseCommitHeader(l, authorCache)
+			current = parseCommitHeader(l, authorCache)
+			current.isFix = classifier.IsFix(current.subject)
+			current.tickets = extractor.ExtractMessage(current.subject, rules.bodies[current.hash])
+			current.isRevert = schema.IsRevertSubject(current.subject) || rules.reverts[current.hash]
+			if !rules.noWorkPatterns && !current.date.IsZero() {
+				current.offHours = schema.IsOffHours(current.date)
+				current.weekend = schema.IsWeekend(current.date)
+				output.WorkHeatmap.Add(current.date)
+			}
+			seq++
+			sizes.start(current.author)
+			tests.start()
+			docs.start(current.date)
 			continue
 		}
 
 		// File stats line
 		p1, p2, add, del := parseFileStatsLine(l, fileExists)
+		sizes.observe(p1, p2, add+del)
 		if p1 != "" {
-			aggregateForPath(p1, add, del, currentAuthor, currentDate, output, recentThreshold)
+			aggregateForPath(p1, add, del, current, output, recentThreshold)
+			output.FileStats[p1].ReworkLines += rework.Observe(p1, current.date, add, del)
+			entropy.observe(seq, current.date, p1)
+			tests.observe(p1)
+			docs.observe(p1, add+del)
 		}
 		if p2 != "" {
-			aggregateForPath(p2, add, del, currentAuthor, currentDate, output, recentThreshold)
+			aggregateForPath(p2, add, del, current, output, recentThreshold)
+			output.FileStats[p2].ReworkLines += rework.Observe(p2, current.date, add, del)
+			entropy.observe(seq, current.date, p2)
+			tests.observe(p2)
+			docs.observe(p2, add+del)
 		}
 	}
+	entropy.apply(output)
+	sizes.apply(output)
+	tests.apply(output)
+	docs.apply(output)
 }
 
-// parseCommitHeader extracts author and date from a commit header line.
+// parseCommitHeader extracts author, date and subject from a commit header line.
+// The subject is optional so headers without one still parse.
 // It uses authorCache for string interning to avoid redundant allocations.
-func parseCommitHeader(line []byte, authorCache map[string]string) (string, time.Time) {
+func parseCommitHeader(line []byte, authorCache map[string]string) commitInfo {
 	if !bytes.HasPrefix(line, []byte("--")) || len(line) < 5 { // --x|y|z minimum
-		return "", time.Time{}
+		return commitInfo{}
 	}
 
 	// Skip the leading "--"
@@ -120,16 +243,21 @@ func parseCommitHeader(line []byte, authorCache map[string]string) (string, time
 	// Manually find components to avoid SplitN allocations
 	firstSep := bytes.IndexByte(line, '|')
 	if firstSep == -1 {
-		return "", time.Time{}
+		return commitInfo{}
 	}
 	secondSep := bytes.IndexByte(line[firstSep+1:], '|')
 	if secondSep == -1 {
-		return "", time.Time{}
+		return commitInfo{}
 	}
 	secondSep += firstSep + 1
 
 	authorBytes := line[firstSep+1 : secondSep]
 	dateBytes := line[secondSep+1:]
+	var subjectBytes []byte
+	if thirdSep := bytes.IndexByte(dateBytes, '|'); thirdSep != -1 {
+		subjectBytes = dateBytes[thirdSep+1:]
+		dateBytes = dateBytes[:thirdSep]
+	}
 
 	// Optimization: compiler avoids allocation for string(authorBytes) when used as map key
 	author, ok := authorCache[string(authorBytes)]
@@ -142,10 +270,10 @@ func parseCommitHeader(line []byte, authorCache map[string]string) (string, time
 	// We still allocate for the date string since time.Parse needs it,
 	// but this is only once per commit header.
 	if date, err := time.Parse(time.RFC3339, string(dateBytes)); err == nil {
-		return author, date
+		return commitInfo{hash: string(line[:firstSep]), author: author, date: date, subject: string(subjectBytes)}
 	}
 
-	return "", time.Time{}
+	return commitInfo{}
 }
 
 // parseFileStatsLine parses a file stats line and returns paths to aggregate and churn values.
@@ -260,15 +388,16 @@ func parseRenamePath(path string) (string, string) {
 }
 
 // aggregateForPath updates the aggregation maps for a single path.
-func aggregateForPath(path string, add schema.Metric, del schema.Metric, author string, date time.Time, output *schema.AggregateOutput, recentThreshold time.Time) {
+func aggregateForPath(path string, add schema.Metric, del schema.Metric, commit commitInfo, output *schema.AggregateOutput, recentThreshold time.Time) {
+	author, date := commit.author, commit.date
 	churn := add + del
 
-	// Calculate decay factor (Half-life: 180 days)
+	// Calculate decay factor using the configured kernel and half-life
 	decayFactor := 1.0
 	if !date.IsZero() {
 		// Calculate age relative to the analysis end time
 		ageDays := output.EndTime.Sub(date).Hours() / 24.0
-		decayFactor = schema.CalculateDecayFactor(ageDays, 180.0)
+		decayFactor = output.Temporal.WithDefaults().DecayFactor(ageDays)
 	}
 
 	// Get or create the aggregation struct for this path
@@ -288,6 +417,28 @@ func aggregateForPath(path string, add schema.Metric, del schema.Metric, author
 	stat.DecayedChurn += churn * schema.Metric(decayFactor)
 	stat.LinesAdded += add
 	stat.LinesDeleted += del
+	if commit.isFix {
+		stat.FixCommits++
+		stat.FixChurn += churn
+	}
+	if commit.offHours {
+		stat.OffHoursCommits++
+	}
+	if commit.weekend {
+		stat.WeekendCommits++
+	}
+	if commit.isRevert {
+		stat.Reverts++
+		if !date.IsZero() && (stat.FirstRevert.IsZero() || date.Before(stat.FirstRevert)) {
+			stat.FirstRevert = date
+		}
+	}
+	for _, ticket := range commit.tickets {
+		if stat.Tickets == nil {
+			stat.Tickets = make(map[string]schema.Metric)
+		}
+		stat.Tickets[ticket]++
+	}
 
 	if author != "" {
 		stat.Contributors[author]++
@@ -341,63 +492,128 @@ func BuildFilteredFileList(gitSettings config.GitSettings, output *schema.Aggreg
 	return files
 }
 
-// AggregateAndScoreFolders correctly aggregates file results into folders.
+// AggregateAndScoreFolders correctly aggregates file results into folders. Root files are
+// skipped unless a path filter is set; AggregateFolderTree credits them to ".".
 func AggregateAndScoreFolders(gitSettings config.GitSettings, scoringSettings config.ScoringSettings, fileResults []schema.FileResult) []schema.FolderResult {
+	skipRoot := gitSettings.GetPathFilter() == ""
+	return aggregateFolders(scoringSettings, fileResults, func(path string) []string {
+		folderPath := filepath.Dir(path)
+		if skipRoot && folderPath == "." {
+			return nil // Skip the root if not filtered
+		}
+		return []string{folderPath}
+	})
+}
+
+// AggregateFolderTree aggregates file results into every ancestor folder, so each folder
+// accumulates the metrics, owners and Gini of all the files below it. The repository
+// root is included as ".".
+func AggregateFolderTree(scoringSettings config.ScoringSettings, fileResults []schema.FileResult) []schema.FolderResult {
+	return aggregateFolders(scoringSettings, fileResults, folderAncestors)
+}
+
+// folderAncestors returns the repository root and every folder that contains a file.
+func folderAncestors(path string) []string {
+	folders := []string{"."}
+	for folder := filepath.Dir(path); folder != "."; folder = filepath.Dir(folder) {
+		folders = append(folders, folder)
+	}
+	return folders
+}
+
+// FolderDepth returns how many directories deep a folder is, with 0 for the root.
+func FolderDepth(folder string) int {
+	if folder == "." || folder == "" {
+		return 0
+	}
+	return strings.Count(folder, "/") + 1
+}
+
+// aggregateFolders aggregates every file result into the folders that foldersOf returns for it.
+func aggregateFolders(scoringSettings config.ScoringSettings, fileResults []schema.FileResult, foldersOf func(string) []string) []schema.FolderResult {
 	folderResults := make(map[string]*schema.FolderResult)
 
 	// Map to track the aggregate commit count per author per folder:
 	// folderPath -> authorName -> totalCommitsByAuthorInFolder
 	folderAuthorContributions := make(map[string]map[string]schema.Metric)
 
-	pathFilter := gitSettings.GetPathFilter()
+	// Map to track every author's commits across the folder's files, for ownership fragmentation:
+	// folderPath -> authorName -> commitsByAuthorInFolder
+	folderContributors := make(map[string]map[string]schema.Metric)
+
+	// folderPath -> scores of the folder's files, for the non-mean aggregations
+	folderFileScores := make(map[string][]float64)
+
+	// folderPath -> contribution of the folder's top file to its score
+	folderTopContribution := make(map[string]float64)
+
 	scoringMode := scoringSettings.GetMode()
+	aggregation := scoringSettings.GetFolderAggregation()
+	if aggregation == "" {
+		aggregation = schema.MeanFolderAggregation
+	}
 
 	for _, fr := range fileResults {
-		// 1. Determine the folder path
-		folderPath := filepath.Dir(fr.Path)
-		if pathFilter == "" && folderPath == "." {
-			continue // Skip the root if not filtered
-		}
+		// 1. Determine the folder paths
+		for _, folderPath := range foldersOf(fr.Path) {
+			if _, ok := folderResults[folderPath]; !ok {
+				folderResults[folderPath] = &schema.FolderResult{
+					Path:          folderPath,
+					Mode:          scoringMode,
+					ModeType:      schema.GetModeType(scoringMode),
+					Aggregation:   aggregation,
+					Normalization: fr.Normalization,
+				}
+			}
 
-		if _, ok := folderResults[folderPath]; !ok {
-			folderResults[folderPath] = &schema.FolderResult{
-				Path: folderPath,
-				Mode: scoringMode,
+			// 2. Aggregate simple metrics and score components
+			folderResults[folderPath].Commits += fr.Commits
+			folderResults[folderPath].Churn += fr.Churn
+			folderResults[folderPath].DecayedCommits += fr.DecayedCommits
+			folderResults[folderPath].DecayedChurn += fr.DecayedChurn
+			folderResults[folderPath].TotalLOC += fr.LinesOfCode
+			folderResults[folderPath].WeightedScoreSum += fr.ModeScore * fr.LinesOfCode.Float64()
+			folderFileScores[folderPath] = append(folderFileScores[folderPath], fr.ModeScore)
+
+			// Under the mean a file contributes its LOC-weighted score, while every other
+			// strategy is driven by the highest file scores.
+			contribution := fr.ModeScore
+			if aggregation == schema.MeanFolderAggregation {
+				contribution *= fr.LinesOfCode.Float64()
 			}
-			// Set ModeType based on whether the mode is composite or base
-			if schema.IsCompositeMode(scoringMode) {
-				folderResults[folderPath].ModeType = "composite"
-			} else {
-				folderResults[folderPath].ModeType = "base"
+			if best, ok := folderTopContribution[folderPath]; !ok || contribution > best {
+				folderTopContribution[folderPath] = contribution
+				folderResults[folderPath].TopFile = fr.Path
+				folderResults[folderPath].TopFileScore = fr.ModeScore
 			}
-		}
 
-		// 2. Aggregate simple metrics and score components
-		folderResults[folderPath].Commits += fr.Commits
-		folderResults[folderPath].Churn += fr.Churn
-		folderResults[folderPath].DecayedCommits += fr.DecayedCommits
-		folderResults[folderPath].DecayedChurn += fr.DecayedChurn
-		folderResults[folderPath].TotalLOC += fr.LinesOfCode
-		folderResults[folderPath].WeightedScoreSum += fr.ModeScore * fr.LinesOfCode.Float64()
-
-		// 3. Aggregate author contributions for owner calculation
-		if len(fr.Owners) > 0 {
-			if folderAuthorContributions[folderPath] == nil {
-				folderAuthorContributions[folderPath] = make(map[string]schema.Metric)
-			}
+			// 3. Aggregate author contributions for owner calculation
+			if len(fr.Owners) > 0 {
+				if folderAuthorContributions[folderPath] == nil {
+					folderAuthorContributions[folderPath] = make(map[string]schema.Metric)
+				}
 
-			// Use the file's primary owner's total commits as the weight for its author's contribution
-			// to the folder. This finds the author who has done the most work (measured by commits)
-			// across all files in the folder.
-			folderAuthorContributions[folderPath][fr.Owners[0]] += fr.Commits
+				// Use the file's primary owner's total commits as the weight for its author's contribution
+				// to the folder. This finds the author who has done the most work (measured by commits)
+				// across all files in the folder.
+				folderAuthorContributions[folderPath][fr.Owners[0]] += fr.Commits
+			}
+			if len(fr.Contributors) > 0 {
+				if folderContributors[folderPath] == nil {
+					folderContributors[folderPath] = make(map[string]schema.Metric)
+				}
+				for author, commits := range fr.Contributors {
+					folderContributors[folderPath][author] += commits
+				}
+			}
 		}
 	}
 
 	// Finalize: Calculate unique contributor count and the final score
 	finalResults := make([]schema.FolderResult, 0, len(folderResults))
 	for _, res := range folderResults {
-		// Calculate the score (Average File Score, weighted by LOC)
-		res.Score = computeFolderScore(res)
+		// Calculate the score with the configured aggregation strategy
+		res.Score = aggregateFolderScore(res, folderFileScores[res.Path], aggregation, scoringSettings.GetFolderThreshold())
 
 		// Determine the Most Frequent Author (Owner)
 		if authorMap := folderAuthorContributions[res.Path]; len(authorMap) > 0 {
@@ -431,12 +647,52 @@ func AggregateAndScoreFolders(gitSettings config.GitSettings, scoringSettings co
 			}
 		}
 
+		// Ownership fragmentation across all contributors to the folder's files
+		ownership := algo.Ownership(folderContributors[res.Path])
+		res.MinorContributors = schema.Metric(ownership.MinorContributors)
+		res.TopOwnerShare = ownership.TopOwnerShare
+		res.OwnershipEntropy = ownership.Entropy
+
 		finalResults = append(finalResults, *res)
 	}
 
 	return finalResults
 }
 
+// aggregateFolderScore combines the scores of a folder's files with an aggregation strategy.
+func aggregateFolderScore(folderResult *schema.FolderResult, scores []float64, aggregation schema.FolderAggregation, threshold float64) float64 {
+	if threshold == 0 {
+		threshold = schema.DefaultFolderThreshold
+	}
+	switch aggregation {
+	case schema.MaxFolderAggregation:
+		return slices.Max(append([]float64{0}, scores...))
+	case schema.P90FolderAggregation:
+		if len(scores) == 0 {
+			return 0.0
+		}
+		sorted := slices.Sorted(slices.Values(scores))
+		rank := int(math.Ceil(0.9*float64(len(sorted)))) - 1
+		return sorted[min(max(rank, 0), len(sorted)-1)]
+	case schema.ExcessFolderAggregation:
+		var excess float64
+		for _, s := range scores {
+			excess += max(s-threshold, 0)
+		}
+		return excess
+	case schema.CountFolderAggregation:
+		var count int
+		for _, s := range scores {
+			if s > threshold {
+				count++
+			}
+		}
+		return float64(count)
+	default:
+		return computeFolderScore(folderResult)
+	}
+}
+
 // computeFolderScore computes the final score for a folder as a weighted average.
 // The weight for the average is Lines of Code (LOC).
 func computeFolderScore(folderResult *schema.FolderResult) float64 {
@@ -14,7 +14,7 @@ import (
 )
 
 // currentCacheVersion defines the version of the cache schema.
-const currentCacheVersion = 3
+const currentCacheVersion = 13
 
 // CachedAggregateActivity - Simplified and validated using DB columns.
 func CachedAggregateActivity(
@@ -98,13 +98,41 @@ func generateCacheKey(ctx context.Context, gitSettings config.GitSettings, compa
 		repoID = git.ResolveURN(ctx, client, gitSettings.GetRepoPath())
 	}
 
-	key := fmt.Sprintf("%s:%s:%d:%d:%d:%s",
+	// The temporal model changes recent and decayed metrics, so it is part of the key
+	temporal := gitSettings.GetTemporalModel()
+
+	// So do the fix rules, ticket patterns and test pairing rules, which decide the fix,
+	// ticket and test co-change counts, and the privacy switch that turns off work
+	// pattern collection
+	classifier := gitSettings.GetFixClassifier()
+	if classifier == nil {
+		classifier = schema.DefaultCommitClassifier()
+	}
+	extractor := gitSettings.GetTicketExtractor()
+	if extractor == nil {
+		extractor = schema.DefaultTicketExtractor()
+	}
+	pairing := gitSettings.GetTestPairing()
+	if pairing == nil {
+		pairing = schema.DefaultTestPairing()
+	}
+
+	key := fmt.Sprintf("%s:%s:%d:%d:%d:%s:%d:%g:%s:%d:%d:%s:%s:%s:%t",
 		repoID,
 		gitSettings.GetPathFilter(),
 		int64(compareSettings.GetLookback()),
 		startHour.Unix(),
 		endHour.Unix(),
 		repoHash,
+		temporal.RecentWindowDays,
+		temporal.DecayHalfLifeDays,
+		temporal.DecayKernel,
+		temporal.ReworkWindowDays,
+		temporal.EntropyPeriodDays,
+		classifier.Signature(),
+		extractor.Signature(),
+		pairing.Signature(),
+		gitSettings.IsWorkPatterns(),
 	)
 	return fmt.Sprintf("%x", sha256.Sum256([]byte(key)))
 }
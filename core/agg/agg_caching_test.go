@@ -138,6 +138,11 @@ func TestGenerateCacheKey(t *testing.T) {
 	key2 := generateCacheKey(context.Background(), cfg.Git, cfg.Compare, mockClient, "git:github.com/different/repo")
 	assert.NotEqual(t, key1, key2)
 
+	// Turning off work pattern collection changes the aggregation, so it needs its own key
+	private := cfg.Git
+	private.NoWorkPatterns = true
+	assert.NotEqual(t, key1, generateCacheKey(context.Background(), private, cfg.Compare, mockClient, ""))
+
 	mockClient.AssertExpectations(t)
 }
 
@@ -223,6 +228,8 @@ func TestCachedAggregateActivity_CacheMiss(t *testing.T) {
 	// Setup for aggregateActivity
 	mockClient.On("ListFilesAtRef", ctx, "/test/repo", "HEAD").Return(strings.Split(strings.TrimSpace(fileListFixture), "\n"), nil)
 	mockClient.On("GetActivityLog", ctx, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(gitLogBasicFixture, nil)
+	mockClient.On("GetRevertLog", ctx, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", ctx, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 	mockClient.On("GetRepoHash", ctx, "/test/repo").Return("abcd1234", nil)
 	mockClient.On("GetRemoteURL", mock.Anything, mock.AnythingOfType("string")).Return("", nil).Maybe()
 	mockClient.On("GetRootCommitHash", mock.Anything, mock.AnythingOfType("string")).Return("root123", nil).Maybe()
@@ -260,6 +267,8 @@ func TestCachedAggregateActivity_NoCacheManager(t *testing.T) {
 	// Setup for aggregateActivity
 	mockClient.On("ListFilesAtRef", ctx, "/test/repo", "HEAD").Return(strings.Split(strings.TrimSpace(fileListFixture), "\n"), nil)
 	mockClient.On("GetActivityLog", ctx, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(gitLogBasicFixture, nil)
+	mockClient.On("GetRevertLog", ctx, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", ctx, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	// No cache manager
 	mockMgr.On("GetActivityStore").Return(nil)
@@ -316,3 +325,28 @@ func TestCachedAggregateActivity_AggregateError(t *testing.T) {
 	mockStore.AssertExpectations(t)
 	mockClient.AssertExpectations(t)
 }
+
+func TestGenerateCacheKey_TemporalModel(t *testing.T) {
+	mockClient := &git.MockGitClient{}
+	mockClient.On("GetRepoHash", mock.Anything, mock.AnythingOfType("string")).Return("abcd1234", nil)
+
+	gitCfg := config.GitConfig{RepoPath: "/test/repo", StartTime: time.Unix(1000000, 0), EndTime: time.Unix(2000000, 0)}
+	compareCfg := config.CompareConfig{Lookback: 30 * 24 * time.Hour}
+	urn := "git:github.com/org/repo"
+
+	base := generateCacheKey(context.Background(), gitCfg, compareCfg, mockClient, urn)
+	assert.Equal(t, base, generateCacheKey(context.Background(), config.GitConfig{
+		RepoPath: gitCfg.RepoPath, StartTime: gitCfg.StartTime, EndTime: gitCfg.EndTime,
+		Temporal: schema.DefaultTemporalModel(),
+	}, compareCfg, mockClient, urn), "unset temporal fields resolve to defaults")
+
+	for _, temporal := range []schema.TemporalModel{
+		{RecentWindowDays: 7},
+		{DecayHalfLifeDays: 90},
+		{DecayKernel: schema.StepDecay},
+	} {
+		changed := gitCfg
+		changed.Temporal = temporal
+		assert.NotEqual(t, base, generateCacheKey(context.Background(), changed, compareCfg, mockClient, urn), "%+v", temporal)
+	}
+}
@@ -16,7 +16,7 @@ func TestParseAndAggregateGitLog_Comprehensive(t *testing.T) {
 	recentThreshold := time.Now().AddDate(0, 0, -30)
 
 	// Execute parsing
-	parseAndAggregateGitLog(gitLogData, fileExists, output, recentThreshold)
+	parseAndAggregateGitLog(gitLogData, fileExists, output, recentThreshold, commitRules{})
 
 	// Property-based assertions instead of hardcoded values
 	// Check that all expected files have been processed
@@ -56,7 +56,7 @@ func TestParseAndAggregateGitLog_WithRenames(t *testing.T) {
 	output := initializeAggregateOutput(time.Now())
 	recentThreshold := time.Now().AddDate(0, 0, -30)
 
-	parseAndAggregateGitLog(gitLogData, fileExists, output, recentThreshold)
+	parseAndAggregateGitLog(gitLogData, fileExists, output, recentThreshold, commitRules{})
 
 	// Property-based checks for rename handling
 	expectedFiles := []string{"src/utils/helper.go", "src/helpers/utility.go", "src/main.go"}
@@ -83,7 +83,7 @@ func TestParseAndAggregateGitLog_EdgeCases(t *testing.T) {
 	output := initializeAggregateOutput(time.Now())
 	recentThreshold := time.Now().AddDate(0, 0, -30)
 
-	parseAndAggregateGitLog(gitLogData, fileExists, output, recentThreshold)
+	parseAndAggregateGitLog(gitLogData, fileExists, output, recentThreshold, commitRules{})
 
 	// Property-based checks for edge cases
 	expectedFiles := []string{"src/main.go", "src/logo.png", "src/empty.txt"}
@@ -104,31 +104,101 @@ func TestParseAndAggregateGitLog_EdgeCases(t *testing.T) {
 
 func TestParseCommitHeader(t *testing.T) {
 	testCases := []struct {
-		name         string
-		line         string
-		expectedAuth string
-		expectZero   bool
+		name            string
+		line            string
+		expectedAuth    string
+		expectedSubject string
+		expectZero      bool
 	}{
-		{"valid header", "--abc123|John Doe|2024-01-15T10:30:00Z", "John Doe", false},
-		{"invalid date", "--abc123|John Doe|invalid-date", "", true},
-		{"malformed header", "--abc123|John Doe", "", true},
-		{"empty line", "", "", true},
-		{"timezone offset", "--abc123|Jane Smith|2024-01-15T10:30:00-08:00", "Jane Smith", false},
+		{"valid header", "--abc123|John Doe|2024-01-15T10:30:00Z", "John Doe", "", false},
+		{"invalid date", "--abc123|John Doe|invalid-date", "", "", true},
+		{"malformed header", "--abc123|John Doe", "", "", true},
+		{"empty line", "", "", "", true},
+		{"timezone offset", "--abc123|Jane Smith|2024-01-15T10:30:00-08:00", "Jane Smith", "", false},
+		{"with subject", "--abc123|John Doe|2024-01-15T10:30:00Z|fix: handle a|b pipes", "John Doe", "fix: handle a|b pipes", false},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			auth, date := parseCommitHeader([]byte(tc.line), make(map[string]string))
-			assert.Equal(t, tc.expectedAuth, auth)
+			info := parseCommitHeader([]byte(tc.line), make(map[string]string))
+			assert.Equal(t, tc.expectedAuth, info.author)
+			assert.Equal(t, tc.expectedSubject, info.subject)
 			if tc.expectZero {
-				assert.True(t, date.IsZero())
+				assert.True(t, info.date.IsZero())
 			} else {
-				assert.False(t, date.IsZero())
+				assert.False(t, info.date.IsZero())
 			}
 		})
 	}
 }
 
+func TestParseAndAggregateGitLog_FixCommits(t *testing.T) {
+	fileExists := createTestFileExistsMap([]string{"src/main.go", "src/utils.go"})
+	logData := []byte(`'--c3|Alice|2024-01-17T10:00:00Z|fix(parser): handle empty input'
+4	2	src/main.go
+
+'--c2|Bob|2024-01-16T10:00:00Z|feat: add utils'
+10	0	src/utils.go
+3	1	src/main.go
+
+'--c1|Alice|2024-01-15T10:00:00Z|Initial commit'
+20	0	src/main.go
+`)
+
+	t.Run("default classifier", func(t *testing.T) {
+		output := initializeAggregateOutput(time.Now())
+		parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{})
+
+		main := output.FileStats["src/main.go"]
+		assert.Equal(t, schema.Metric(3), main.Commits)
+		assert.Equal(t, schema.Metric(1), main.FixCommits)
+		assert.Equal(t, schema.Metric(6), main.FixChurn)
+		assert.InDelta(t, 1.0/3.0, main.FixRatio(), 1e-9)
+		assert.Zero(t, output.FileStats["src/utils.go"].FixCommits)
+	})
+
+	t.Run("custom classifier", func(t *testing.T) {
+		classifier, err := schema.NewCommitClassifier(nil, []string{`^Initial`})
+		assert.NoError(t, err)
+		output := initializeAggregateOutput(time.Now())
+		parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{fixes: classifier})
+
+		main := output.FileStats["src/main.go"]
+		assert.Equal(t, schema.Metric(1), main.FixCommits)
+		assert.Equal(t, schema.Metric(20), main.FixChurn)
+	})
+}
+
+func TestParseAndAggregateGitLog_Tickets(t *testing.T) {
+	fileExists := createTestFileExistsMap([]string{"src/main.go", "src/utils.go"})
+	logData := []byte(`'--c2|Bob|2024-01-16T10:00:00Z|PAY-7 add utils (#12)'
+10	0	src/utils.go
+3	1	src/main.go
+
+'--c1|Alice|2024-01-15T10:00:00Z|PAY-7 initial commit'
+20	0	src/main.go
+`)
+
+	output := initializeAggregateOutput(time.Now())
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{})
+	assert.Equal(t, map[string]schema.Metric{"PAY-7": 2, "#12": 1}, output.FileStats["src/main.go"].Tickets)
+	assert.Equal(t, map[string]schema.Metric{"PAY-7": 1, "#12": 1}, output.FileStats["src/utils.go"].Tickets)
+
+	// Custom patterns replace the defaults
+	extractor, err := schema.NewTicketExtractor([]string{`#(\d+)`})
+	assert.NoError(t, err)
+	output = initializeAggregateOutput(time.Now())
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{tickets: extractor})
+	assert.Equal(t, map[string]schema.Metric{"12": 1}, output.FileStats["src/main.go"].Tickets)
+
+	// Keys in commit bodies count too, once per commit
+	bodies := ParseCommitBodyLog([]byte("\x1ec2\x1fFixes #40\nPAY-7 follow-up\n\x1ec1\x1f\n"))
+	assert.Equal(t, map[string]string{"c2": "Fixes #40\nPAY-7 follow-up"}, bodies)
+	output = initializeAggregateOutput(time.Now())
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{bodies: bodies})
+	assert.Equal(t, map[string]schema.Metric{"PAY-7": 1, "#12": 1, "#40": 1}, output.FileStats["src/utils.go"].Tickets)
+}
+
 func TestParseFileStatsLine(t *testing.T) {
 	fileExists := createTestFileExistsMap([]string{"src/main.go", "src/utils.go"})
 
@@ -263,7 +333,7 @@ func TestAggregateForPath(t *testing.T) {
 	t.Run("single aggregation", func(t *testing.T) {
 		output := initializeAggregateOutput(time.Now())
 
-		aggregateForPath("src/main.go", 10, 5, "Alice", testTime, output, time.Time{})
+		aggregateForPath("src/main.go", 10, 5, commitInfo{author: "Alice", date: testTime}, output, time.Time{})
 
 		stat := output.FileStats["src/main.go"]
 		assert.Equal(t, schema.Metric(1), stat.Commits)
@@ -278,9 +348,9 @@ func TestAggregateForPath(t *testing.T) {
 		output := initializeAggregateOutput(time.Now())
 
 		// First aggregation
-		aggregateForPath("src/main.go", 10, 5, "Alice", testTime, output, time.Time{})
+		aggregateForPath("src/main.go", 10, 5, commitInfo{author: "Alice", date: testTime}, output, time.Time{})
 		// Second aggregation
-		aggregateForPath("src/main.go", 8, 2, "Alice", laterTime, output, time.Time{})
+		aggregateForPath("src/main.go", 8, 2, commitInfo{author: "Alice", date: laterTime}, output, time.Time{})
 
 		stat := output.FileStats["src/main.go"]
 		assert.Equal(t, schema.Metric(2), stat.Commits)
@@ -298,8 +368,8 @@ func TestAggregateForPath(t *testing.T) {
 	t.Run("multiple authors", func(t *testing.T) {
 		output := initializeAggregateOutput(time.Now())
 
-		aggregateForPath("src/main.go", 10, 5, "Alice", testTime, output, time.Time{})
-		aggregateForPath("src/main.go", 5, 5, "Bob", laterTime, output, time.Time{})
+		aggregateForPath("src/main.go", 10, 5, commitInfo{author: "Alice", date: testTime}, output, time.Time{})
+		aggregateForPath("src/main.go", 5, 5, commitInfo{author: "Bob", date: laterTime}, output, time.Time{})
 
 		stat := output.FileStats["src/main.go"]
 		assert.Equal(t, schema.Metric(2), stat.Commits)
@@ -310,7 +380,7 @@ func TestAggregateForPath(t *testing.T) {
 	t.Run("empty author", func(t *testing.T) {
 		output := initializeAggregateOutput(time.Now())
 
-		aggregateForPath("src/utils.go", 4, 4, "", laterTime, output, time.Time{})
+		aggregateForPath("src/utils.go", 4, 4, commitInfo{author: "", date: laterTime}, output, time.Time{})
 
 		stat := output.FileStats["src/utils.go"]
 		assert.Equal(t, schema.Metric(1), stat.Commits)
@@ -321,7 +391,7 @@ func TestAggregateForPath(t *testing.T) {
 	t.Run("zero time", func(t *testing.T) {
 		output := initializeAggregateOutput(time.Now())
 
-		aggregateForPath("src/zero.go", 1, 2, "Charlie", time.Time{}, output, time.Time{})
+		aggregateForPath("src/zero.go", 1, 2, commitInfo{author: "Charlie", date: time.Time{}}, output, time.Time{})
 
 		stat := output.FileStats["src/zero.go"]
 		assert.Equal(t, schema.Metric(1), stat.Commits)
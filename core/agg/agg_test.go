@@ -13,6 +13,7 @@ import (
 	"github.com/huangsam/hotspot/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 //go:embed testdata/git_log_basic.txt
@@ -30,6 +31,8 @@ func TestAggregateActivity(t *testing.T) {
 	// Setup expectations
 	mockClient.On("ListFilesAtRef", ctx, "/test/repo", "HEAD").Return(strings.Split(strings.TrimSpace(fileListFixture), "\n"), nil)
 	mockClient.On("GetActivityLog", ctx, "/test/repo", "", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(gitLogBasicFixture, nil)
+	mockClient.On("GetRevertLog", ctx, "/test/repo", "", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", ctx, "/test/repo", "", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	// Create config
 	cfg := &config.Config{
@@ -173,6 +176,61 @@ func TestComputeFolderScore(t *testing.T) {
 	})
 }
 
+// TestAggregateFolderScore validates the folder aggregation strategies.
+func TestAggregateFolderScore(t *testing.T) {
+	folder := &schema.FolderResult{TotalLOC: 100, WeightedScoreSum: 3000.0}
+	scores := []float64{90, 20, 10, 10, 10, 10, 10, 10, 10, 10}
+
+	tests := []struct {
+		aggregation schema.FolderAggregation
+		want        float64
+	}{
+		{schema.MeanFolderAggregation, 30.0},
+		{schema.MaxFolderAggregation, 90.0},
+		{schema.P90FolderAggregation, 20.0},
+		{schema.ExcessFolderAggregation, 75.0 + 5.0},
+		{schema.CountFolderAggregation, 2.0},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.aggregation), func(t *testing.T) {
+			assert.InDelta(t, tt.want, aggregateFolderScore(folder, scores, tt.aggregation, 15.0), 0.001)
+		})
+	}
+
+	t.Run("no files", func(t *testing.T) {
+		empty := &schema.FolderResult{}
+		assert.Zero(t, aggregateFolderScore(empty, nil, schema.MaxFolderAggregation, 60.0))
+		assert.Zero(t, aggregateFolderScore(empty, nil, schema.P90FolderAggregation, 60.0))
+	})
+}
+
+// TestAggregateAndScoreFoldersTopFile validates that a god file is not averaged away.
+func TestAggregateAndScoreFoldersTopFile(t *testing.T) {
+	fileResults := []schema.FileResult{
+		{Path: "svc/god.go", ModeScore: 90.0, LinesOfCode: 100},
+		{Path: "svc/a.go", ModeScore: 10.0, LinesOfCode: 400},
+		{Path: "svc/b.go", ModeScore: 5.0, LinesOfCode: 500},
+	}
+
+	mean := AggregateAndScoreFolders(config.GitConfig{}, config.ScoringConfig{Mode: schema.HotMode}, fileResults)
+	require.Len(t, mean, 1)
+	assert.Equal(t, schema.MeanFolderAggregation, mean[0].Aggregation)
+	assert.InDelta(t, 15.5, mean[0].Score, 0.001)
+	assert.Equal(t, "svc/god.go", mean[0].TopFile)
+	assert.Equal(t, 90.0, mean[0].TopFileScore)
+
+	scoring := config.ScoringConfig{Mode: schema.HotMode, FolderAggregation: schema.MaxFolderAggregation}
+	worst := AggregateAndScoreFolders(config.GitConfig{}, scoring, fileResults)
+	require.Len(t, worst, 1)
+	assert.Equal(t, 90.0, worst[0].Score)
+	assert.Equal(t, "svc/god.go", worst[0].TopFile)
+
+	// Under the mean a large file can contribute more than a small, worse one
+	fileResults[1].LinesOfCode = 5000
+	mean = AggregateAndScoreFolders(config.GitConfig{}, config.ScoringConfig{Mode: schema.HotMode}, fileResults)
+	assert.Equal(t, "svc/a.go", mean[0].TopFile)
+}
+
 func TestAggregateAndScoreFolders(t *testing.T) {
 	t.Run("basic aggregation", func(t *testing.T) {
 		fileResults := []schema.FileResult{
@@ -614,7 +672,7 @@ func BenchmarkParseAndAggregateGitLog(b *testing.B) {
 
 	for b.Loop() {
 		output := initializeAggregateOutput(endTime)
-		parseAndAggregateGitLog(logData, fileExists, output, recentThreshold)
+		parseAndAggregateGitLog(logData, fileExists, output, recentThreshold, commitRules{})
 	}
 }
 
@@ -630,7 +688,7 @@ func BenchmarkAggregateForPath(b *testing.B) {
 
 	b.ResetTimer()
 	for b.Loop() {
-		aggregateForPath(path, 10, 5, author, now, output, threshold)
+		aggregateForPath(path, 10, 5, commitInfo{author: author, date: now}, output, threshold)
 	}
 }
 
@@ -655,3 +713,29 @@ func BenchmarkParseChurnValue(b *testing.B) {
 		_ = parseChurnValue(input)
 	}
 }
+
+func TestAggregateActivity_TemporalModel(t *testing.T) {
+	ctx := context.Background()
+	endTime := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	log := []byte("--abc|alice|2024-01-21T00:00:00Z\n10\t0\tmain.go\n")
+
+	mockClient := &git.MockGitClient{}
+	mockClient.On("GetActivityLog", ctx, "/test/repo", "", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return(log, nil)
+	mockClient.On("GetRevertLog", ctx, "/test/repo", "", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", ctx, "/test/repo", "", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+
+	// A 7-day window excludes the 10-day-old commit, and a 10-day step kernel still counts it fully
+	gitCfg := config.GitConfig{
+		RepoPath: "/test/repo",
+		EndTime:  endTime,
+		Temporal: schema.TemporalModel{RecentWindowDays: 7, DecayHalfLifeDays: 10, DecayKernel: schema.StepDecay},
+	}
+	output, err := aggregateActivity(ctx, gitCfg, mockClient, []string{"main.go"})
+	require.NoError(t, err)
+
+	stat := output.FileStats["main.go"]
+	require.NotNil(t, stat)
+	assert.Equal(t, schema.Metric(0), stat.RecentCommits)
+	assert.Equal(t, schema.Metric(1), stat.DecayedCommits)
+	assert.Equal(t, 7, output.Temporal.RecentWindowDays)
+}
@@ -0,0 +1,124 @@
+package agg
+
+import (
+	"slices"
+
+	"github.com/huangsam/hotspot/schema"
+)
+
+// commitSize holds the size of the commit being parsed.
+type commitSize struct {
+	author string
+	files  int
+	churn  schema.Metric
+	paths  []string // Tracked files changed by the commit
+}
+
+// commitSizeTracker records how many files and lines each commit changed, so that files
+// mostly changed inside large commits can be told apart from files changed in focused ones.
+// Every numstat line counts towards a commit's size, including files that no longer exist.
+type commitSizeTracker struct {
+	current     commitSize
+	fileSizes   map[string][]float64 // Path -> files changed by each commit touching it
+	fileChurns  map[string][]float64 // Path -> churn of each commit touching it
+	authorSizes map[string][]float64 // Author -> files changed by each of their commits
+}
+
+// newCommitSizeTracker creates an empty tracker.
+func newCommitSizeTracker() *commitSizeTracker {
+	return &commitSizeTracker{
+		fileSizes:   make(map[string][]float64),
+		fileChurns:  make(map[string][]float64),
+		authorSizes: make(map[string][]float64),
+	}
+}
+
+// start finishes the previous commit and begins a new one by author.
+func (t *commitSizeTracker) start(author string) {
+	t.finish()
+	t.current = commitSize{author: author, paths: t.current.paths[:0]}
+}
+
+// observe records one numstat line of the current commit. The paths are the tracked
+// files it maps to, which are empty for files that no longer exist.
+func (t *commitSizeTracker) observe(p1, p2 string, churn schema.Metric) {
+	t.current.files++
+	t.current.churn += churn
+	if p1 != "" {
+		t.current.paths = append(t.current.paths, p1)
+	}
+	if p2 != "" {
+		t.current.paths = append(t.current.paths, p2)
+	}
+}
+
+// finish records the size of the current commit against its files and author.
+func (t *commitSizeTracker) finish() {
+	c := t.current
+	if c.files == 0 {
+		return // Merges and empty commits have no numstat lines
+	}
+	files := float64(c.files)
+	for _, path := range c.paths {
+		t.fileSizes[path] = append(t.fileSizes[path], files)
+		t.fileChurns[path] = append(t.fileChurns[path], c.churn.Float64())
+	}
+	if c.author != "" {
+		t.authorSizes[c.author] = append(t.authorSizes[c.author], files)
+	}
+	t.current.files = 0
+}
+
+// apply finishes the last commit and records the commit size statistics on output.
+func (t *commitSizeTracker) apply(output *schema.AggregateOutput) {
+	t.finish()
+
+	for path, sizes := range t.fileSizes {
+		stat, ok := output.FileStats[path]
+		if !ok {
+			continue
+		}
+		stat.MedianCommitFiles = schema.Metric(median(sizes))
+		stat.MedianCommitChurn = schema.Metric(median(t.fileChurns[path]))
+		stat.LargeCommits = schema.Metric(countLarge(sizes))
+	}
+
+	output.AuthorFocus = make(map[string]schema.AuthorFocus, len(t.authorSizes))
+	for author, sizes := range t.authorSizes {
+		focus := 0.0
+		for _, files := range sizes {
+			focus += 1 / files
+		}
+		output.AuthorFocus[author] = schema.AuthorFocus{
+			Author:           author,
+			Commits:          len(sizes),
+			MedianFiles:      median(sizes),
+			LargeCommitShare: float64(countLarge(sizes)) / float64(len(sizes)),
+			Focus:            focus / float64(len(sizes)),
+		}
+	}
+}
+
+// countLarge returns how many commit sizes are at least schema.LargeCommitFiles.
+func countLarge(sizes []float64) int {
+	large := 0
+	for _, files := range sizes {
+		if files >= schema.LargeCommitFiles {
+			large++
+		}
+	}
+	return large
+}
+
+// median returns the median of values, or 0 if there are none. It sorts values in place.
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	slices.Sort(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
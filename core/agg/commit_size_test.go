@@ -0,0 +1,64 @@
+package agg
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAndAggregateGitLog_CommitSizes(t *testing.T) {
+	fileExists := createTestFileExistsMap([]string{"a.go", "b.go"})
+
+	// c3 is a 25-file commit; all but a.go are files that no longer exist
+	var log strings.Builder
+	log.WriteString("'--c3|Bob|2024-02-20T10:00:00Z|mass rename'\n1\t1\ta.go\n")
+	for i := range 24 {
+		fmt.Fprintf(&log, "1\t1\tgen/old%d.go\n", i)
+	}
+	log.WriteString(`
+'--c2|Alice|2024-02-10T10:00:00Z|touch a and b'
+5	5	a.go
+10	0	b.go
+
+'--c1|Alice|2024-01-10T10:00:00Z|touch a'
+3	0	a.go
+`)
+
+	output := initializeAggregateOutput(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	parseAndAggregateGitLog([]byte(log.String()), fileExists, output, time.Time{}, commitRules{})
+
+	// a.go was changed by commits of 25, 2 and 1 files with churn 50, 20 and 3
+	a := output.FileStats["a.go"]
+	assert.Equal(t, schema.Metric(2), a.MedianCommitFiles)
+	assert.Equal(t, schema.Metric(20), a.MedianCommitChurn)
+	assert.Equal(t, schema.Metric(1), a.LargeCommits)
+	assert.InDelta(t, 1.0/3.0, a.LargeCommitShare(), 1e-9)
+
+	b := output.FileStats["b.go"]
+	assert.Equal(t, schema.Metric(2), b.MedianCommitFiles)
+	assert.Zero(t, b.LargeCommitShare())
+
+	require.Len(t, output.AuthorFocus, 2)
+	alice := output.AuthorFocus["Alice"]
+	assert.Equal(t, 2, alice.Commits)
+	assert.Equal(t, 1.5, alice.MedianFiles)
+	assert.Zero(t, alice.LargeCommitShare)
+	assert.InDelta(t, 0.75, alice.Focus, 1e-9)
+
+	bob := output.AuthorFocus["Bob"]
+	assert.Equal(t, 1, bob.Commits)
+	assert.Equal(t, 25.0, bob.MedianFiles)
+	assert.Equal(t, 1.0, bob.LargeCommitShare)
+	assert.InDelta(t, 1.0/25.0, bob.Focus, 1e-9)
+}
+
+func TestMedian(t *testing.T) {
+	assert.Zero(t, median(nil))
+	assert.Equal(t, 3.0, median([]float64{5, 1, 3}))
+	assert.Equal(t, 2.5, median([]float64{4, 1, 3, 2}))
+}
@@ -0,0 +1,89 @@
+package agg
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+)
+
+// docDriftTracker measures, per folder, how much code changed since the folder's docs
+// were last modified. Commits arrive newest first, so every code change seen before the
+// first doc change of a folder happened after its docs were last touched.
+type docDriftTracker struct {
+	drift       map[string]*schema.DocDrift
+	docsSeen    map[string]bool          // Folders whose latest doc change has been seen
+	date        time.Time                // Date of the current commit
+	docsChanged map[string]bool          // Folders whose docs the current commit changed
+	codeChurn   map[string]schema.Metric // Folder -> code churn of the current commit
+}
+
+// newDocDriftTracker counts the existing documentation files of each folder.
+func newDocDriftTracker(fileExists map[string]string) *docDriftTracker {
+	t := &docDriftTracker{
+		drift:       make(map[string]*schema.DocDrift),
+		docsSeen:    make(map[string]bool),
+		docsChanged: make(map[string]bool),
+		codeChurn:   make(map[string]schema.Metric),
+	}
+	for path := range fileExists {
+		if folder, ok := schema.DocFolder(path); ok {
+			t.folder(folder).DocFiles++
+		}
+	}
+	return t
+}
+
+// folder returns the drift entry of a folder, creating it if needed.
+func (t *docDriftTracker) folder(path string) *schema.DocDrift {
+	d, ok := t.drift[path]
+	if !ok {
+		d = &schema.DocDrift{}
+		t.drift[path] = d
+	}
+	return d
+}
+
+// start finishes the previous commit and begins a new one made at date.
+func (t *docDriftTracker) start(date time.Time) {
+	t.finish()
+	t.date = date
+}
+
+// observe records that the current commit changed path by churn lines.
+func (t *docDriftTracker) observe(path string, churn schema.Metric) {
+	if folder, ok := schema.DocFolder(path); ok {
+		t.docsChanged[folder] = true
+		return
+	}
+	t.codeChurn[filepath.Dir(path)] += churn
+}
+
+// finish records the current commit. Code changed together with its docs is not drift.
+func (t *docDriftTracker) finish() {
+	for folder := range t.docsChanged {
+		if !t.docsSeen[folder] {
+			t.docsSeen[folder] = true
+			t.folder(folder).DocsLastModified = t.date
+		}
+	}
+	for folder, churn := range t.codeChurn {
+		d := t.folder(folder)
+		d.CodeCommits++
+		if !t.docsSeen[folder] {
+			d.CommitsSinceDocs++
+			d.ChurnSinceDocs += churn
+		}
+	}
+	clear(t.docsChanged)
+	clear(t.codeChurn)
+}
+
+// apply finishes the last commit and records the drift of each folder on output.
+func (t *docDriftTracker) apply(output *schema.AggregateOutput) {
+	t.finish()
+	output.DocDrift = make(map[string]schema.DocDrift, len(t.drift))
+	for folder, d := range t.drift {
+		output.DocDrift[folder] = *d
+	}
+}
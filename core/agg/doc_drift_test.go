@@ -0,0 +1,60 @@
+package agg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAndAggregateGitLog_DocDrift(t *testing.T) {
+	fileExists := createTestFileExistsMap([]string{
+		"pay/ledger.go", "pay/README.md", "pay/docs/adr.md",
+		"auth/login.go", "auth/README.md",
+		"cli/main.go",
+	})
+	logData := []byte(`'--c5|Bob|2024-02-25T10:00:00Z|tweak ledger'
+4	1	pay/ledger.go
+
+'--c4|Bob|2024-02-20T10:00:00Z|ledger and cli'
+10	0	pay/ledger.go
+2	0	cli/main.go
+
+'--c3|Alice|2024-02-10T10:00:00Z|record ledger decision'
+5	0	pay/docs/adr.md
+
+'--c2|Alice|2024-02-01T10:00:00Z|login with docs'
+3	3	auth/login.go
+1	0	auth/README.md
+
+'--c1|Alice|2024-01-10T10:00:00Z|first ledger'
+20	0	pay/ledger.go
+`)
+
+	output := initializeAggregateOutput(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{})
+
+	// pay's docs changed in c3; c4 and c5 came after
+	pay := output.DocDrift["pay"]
+	assert.Equal(t, 2, pay.DocFiles)
+	assert.Equal(t, time.Date(2024, 2, 10, 10, 0, 0, 0, time.UTC), pay.DocsLastModified)
+	assert.Equal(t, schema.Metric(3), pay.CodeCommits)
+	assert.Equal(t, schema.Metric(2), pay.CommitsSinceDocs)
+	assert.Equal(t, schema.Metric(15), pay.ChurnSinceDocs)
+
+	// Code changed together with its docs is not drift
+	auth := output.DocDrift["auth"]
+	assert.Equal(t, schema.Metric(1), auth.CodeCommits)
+	assert.Zero(t, auth.CommitsSinceDocs)
+
+	// Folders without docs still count their code activity
+	cli := output.DocDrift["cli"]
+	assert.Zero(t, cli.DocFiles)
+	assert.True(t, cli.DocsLastModified.IsZero())
+	assert.Equal(t, schema.Metric(1), cli.CommitsSinceDocs)
+
+	// Doc-only changes are not code activity
+	require.NotContains(t, output.DocDrift, "pay/docs")
+}
@@ -0,0 +1,97 @@
+package agg
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+)
+
+// entropyPeriod collects the changes made in one period of the analysis window.
+type entropyPeriod struct {
+	changes    map[string]int // Path -> commits that changed it
+	commits    int
+	lastCommit int
+}
+
+// entropyTracker measures Hassan-style change entropy: how evenly each period's changes
+// are spread across files. Periods are counted back from the end of the analysis window.
+type entropyTracker struct {
+	end     time.Time
+	period  time.Duration
+	periods map[int]*entropyPeriod
+}
+
+// newEntropyTracker creates a tracker that splits history into periods of periodDays.
+func newEntropyTracker(end time.Time, periodDays int) *entropyTracker {
+	return &entropyTracker{
+		end:     end,
+		period:  time.Duration(periodDays) * 24 * time.Hour,
+		periods: make(map[int]*entropyPeriod),
+	}
+}
+
+// observe records that the commit with sequence number seq changed path.
+func (t *entropyTracker) observe(seq int, date time.Time, path string) {
+	if date.IsZero() || t.period <= 0 {
+		return
+	}
+	idx := max(0, int(t.end.Sub(date)/t.period))
+	p, ok := t.periods[idx]
+	if !ok {
+		p = &entropyPeriod{changes: make(map[string]int), lastCommit: -1}
+		t.periods[idx] = p
+	}
+	if p.lastCommit != seq {
+		p.lastCommit = seq
+		p.commits++
+	}
+	p.changes[path]++
+}
+
+// apply computes the entropy of every period with changes, records it on output, and adds
+// each file's share of the period entropy to its ChangeEntropy.
+func (t *entropyTracker) apply(output *schema.AggregateOutput) {
+	indexes := make([]int, 0, len(t.periods))
+	for idx := range t.periods {
+		indexes = append(indexes, idx)
+	}
+	sort.Ints(indexes)
+
+	output.EntropyPeriods = make([]schema.EntropyPeriod, 0, len(indexes))
+	for _, idx := range indexes {
+		p := t.periods[idx]
+		paths := make([]string, 0, len(p.changes))
+		total := 0
+		for path, c := range p.changes {
+			paths = append(paths, path)
+			total += c
+		}
+		sort.Strings(paths) // Fixed summation order keeps the entropy deterministic
+
+		// Normalize by the maximum entropy for the number of files changed, so that
+		// periods with different numbers of files stay comparable
+		entropy := 0.0
+		if len(p.changes) > 1 {
+			maxEntropy := math.Log2(float64(len(p.changes)))
+			for _, path := range paths {
+				prob := float64(p.changes[path]) / float64(total)
+				share := -prob * math.Log2(prob) / maxEntropy
+				entropy += share
+				if stat, ok := output.FileStats[path]; ok {
+					stat.ChangeEntropy += share
+				}
+			}
+		}
+
+		periodEnd := t.end.Add(-time.Duration(idx) * t.period)
+		output.EntropyPeriods = append(output.EntropyPeriods, schema.EntropyPeriod{
+			Start:   periodEnd.Add(-t.period),
+			End:     periodEnd,
+			Commits: p.commits,
+			Files:   len(p.changes),
+			Entropy: entropy,
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package agg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAndAggregateGitLog_ChangeEntropy(t *testing.T) {
+	fileExists := createTestFileExistsMap([]string{"a.go", "b.go", "c.go"})
+	logData := []byte(`'--c3|Bob|2024-02-20T10:00:00Z|touch three files'
+1	0	a.go
+1	0	b.go
+2	0	c.go
+
+'--c2|Bob|2024-02-10T10:00:00Z|touch c again'
+1	0	c.go
+
+'--c1|Alice|2024-01-10T10:00:00Z|touch a'
+1	0	a.go
+
+'--c0|Alice|2024-01-05T10:00:00Z|touch a again'
+1	1	a.go
+`)
+
+	end := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	output := initializeAggregateOutput(end)
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{})
+
+	// Newest period: a and b changed once, c twice, so p = 1/4, 1/4, 1/2
+	require.Len(t, output.EntropyPeriods, 2)
+	newest := output.EntropyPeriods[0]
+	assert.Equal(t, end, newest.End)
+	assert.Equal(t, end.AddDate(0, 0, -30), newest.Start)
+	assert.Equal(t, 2, newest.Commits)
+	assert.Equal(t, 3, newest.Files)
+	assert.InDelta(t, 1.5/1.584962500721156, newest.Entropy, 1e-9)
+
+	// Older period: only a changed, so the changes are not scattered at all
+	older := output.EntropyPeriods[1]
+	assert.Equal(t, 2, older.Commits)
+	assert.Equal(t, 1, older.Files)
+	assert.Zero(t, older.Entropy)
+
+	// Each file gets its share of the period entropy
+	assert.InDelta(t, 0.5/1.584962500721156, output.FileStats["a.go"].ChangeEntropy, 1e-9)
+	assert.InDelta(t, 0.5/1.584962500721156, output.FileStats["b.go"].ChangeEntropy, 1e-9)
+	assert.InDelta(t, 0.5/1.584962500721156, output.FileStats["c.go"].ChangeEntropy, 1e-9)
+	assert.InDelta(t, newest.Entropy/2, output.ChangeEntropy(), 1e-9)
+
+	// A longer period merges both into one
+	output = initializeAggregateOutput(end)
+	output.Temporal = schema.TemporalModel{EntropyPeriodDays: 90}
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{})
+	require.Len(t, output.EntropyPeriods, 1)
+	assert.Equal(t, 4, output.EntropyPeriods[0].Commits)
+}
@@ -0,0 +1,66 @@
+package agg
+
+import (
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+)
+
+// pendingDeletion holds deleted lines from a newer commit that can still be matched
+// against additions made shortly before it.
+type pendingDeletion struct {
+	date  time.Time
+	lines schema.Metric
+}
+
+// ReworkTracker approximates rework from numstat timing: lines deleted from a file within
+// the window after another commit added lines to it count as rework of those additions.
+// Commits must be observed newest first, which is the order git log emits them in.
+type ReworkTracker struct {
+	window  time.Duration
+	pending map[string][]pendingDeletion
+}
+
+// NewReworkTracker creates a tracker with a rework window of windowDays.
+func NewReworkTracker(windowDays int) *ReworkTracker {
+	return &ReworkTracker{
+		window:  time.Duration(windowDays) * 24 * time.Hour,
+		pending: make(map[string][]pendingDeletion),
+	}
+}
+
+// Observe records one commit's numstat for path and returns how many of its added lines
+// were deleted again by newer commits within the window.
+func (t *ReworkTracker) Observe(path string, date time.Time, add, del schema.Metric) schema.Metric {
+	if date.IsZero() {
+		return 0
+	}
+
+	// Drop deletions that are too far after this commit to match it or anything older
+	queue := t.pending[path]
+	kept := queue[:0]
+	for _, d := range queue {
+		if d.lines > 0 && d.date.Sub(date) <= t.window {
+			kept = append(kept, d)
+		}
+	}
+
+	// Match this commit's additions against the deletions closest after it first
+	var reworked schema.Metric
+	remaining := add
+	for i := len(kept) - 1; i >= 0 && remaining > 0; i-- {
+		if !kept[i].date.After(date) {
+			continue
+		}
+		matched := min(remaining, kept[i].lines)
+		kept[i].lines -= matched
+		remaining -= matched
+		reworked += matched
+	}
+
+	if del > 0 {
+		kept = append(kept, pendingDeletion{date: date, lines: del})
+	}
+	t.pending[path] = kept
+	return reworked
+}
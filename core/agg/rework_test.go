@@ -0,0 +1,63 @@
+package agg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReworkTracker(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2024, 1, d, 12, 0, 0, 0, time.UTC) }
+	tracker := NewReworkTracker(7)
+
+	// Newest first: day 10 deletes 30 lines, day 5 deletes 10 lines and adds 5
+	assert.Equal(t, schema.Metric(0), tracker.Observe("a.go", day(10), 0, 30))
+	assert.Equal(t, schema.Metric(5), tracker.Observe("a.go", day(5), 5, 10))
+
+	// Day 4 adds 50 lines: 10 are matched by day 5 and 25 by day 10
+	assert.Equal(t, schema.Metric(35), tracker.Observe("a.go", day(4), 50, 0))
+
+	// Deletions older than the window no longer match, and paths are tracked separately
+	assert.Equal(t, schema.Metric(0), tracker.Observe("b.go", day(4), 20, 0))
+	assert.Equal(t, schema.Metric(0), tracker.Observe("a.go", day(1), 20, 0))
+
+	// Commits without a date are ignored
+	assert.Equal(t, schema.Metric(0), tracker.Observe("a.go", time.Time{}, 20, 20))
+}
+
+func TestParseAndAggregateGitLog_RevertsAndRework(t *testing.T) {
+	fileExists := createTestFileExistsMap([]string{"src/main.go", "src/utils.go"})
+	logData := []byte(`'--c3|Carol|2024-01-20T10:00:00Z|Revert "add retries"'
+0	12	src/main.go
+
+'--c2|Bob|2024-01-18T10:00:00Z|undo utils change'
+1	4	src/utils.go
+
+'--c1|Alice|2024-01-15T10:00:00Z|add retries'
+12	0	src/main.go
+40	0	src/utils.go
+`)
+
+	// The subject marks c3 as a revert, and the revert log adds c2
+	rules := commitRules{reverts: parseRevertLog([]byte("c2\n'c9'\n"))}
+	output := initializeAggregateOutput(time.Now())
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, rules)
+
+	main := output.FileStats["src/main.go"]
+	assert.Equal(t, schema.Metric(1), main.Reverts)
+	assert.Equal(t, time.Date(2024, 1, 20, 10, 0, 0, 0, time.UTC), main.FirstRevert)
+	assert.Equal(t, schema.Metric(12), main.ReworkLines)
+	assert.Equal(t, 1.0, main.ReworkRatio())
+
+	utils := output.FileStats["src/utils.go"]
+	assert.Equal(t, schema.Metric(1), utils.Reverts)
+	assert.Equal(t, schema.Metric(4), utils.ReworkLines)
+	assert.InDelta(t, 4.0/41.0, utils.ReworkRatio(), 1e-9)
+}
+
+func TestParseRevertLog(t *testing.T) {
+	assert.Equal(t, map[string]bool{"abc": true, "def": true}, parseRevertLog([]byte("abc\n'def'\n\n")))
+	assert.Empty(t, parseRevertLog(nil))
+}
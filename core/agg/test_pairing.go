@@ -0,0 +1,80 @@
+package agg
+
+import (
+	"github.com/huangsam/hotspot/schema"
+)
+
+// testPairingTracker counts how often commits to a production file also changed one of
+// its paired tests. Pairs are resolved against the files that currently exist.
+type testPairingTracker struct {
+	status    map[string]string   // Path -> schema.TestStatus*
+	tests     map[string][]string // Production path -> existing paired tests
+	changed   map[string]bool     // Paths changed by the current commit
+	coChanges map[string]int      // Production path -> commits that also changed a paired test
+}
+
+// newTestPairingTracker classifies the existing files using pairing.
+func newTestPairingTracker(pairing *schema.TestPairing, fileExists map[string]string) *testPairingTracker {
+	t := &testPairingTracker{
+		status:    make(map[string]string),
+		tests:     make(map[string][]string),
+		changed:   make(map[string]bool),
+		coChanges: make(map[string]int),
+	}
+	for path := range fileExists {
+		if pairing.IsTest(path) {
+			t.status[path] = schema.TestStatusTest
+			continue
+		}
+		candidates, covered := pairing.TestCandidates(path)
+		if !covered {
+			continue
+		}
+		for _, test := range candidates {
+			if canonical, ok := fileExists[test]; ok {
+				t.tests[path] = append(t.tests[path], canonical)
+			}
+		}
+		t.status[path] = schema.TestStatusOrphan
+		if len(t.tests[path]) > 0 {
+			t.status[path] = schema.TestStatusPaired
+		}
+	}
+	return t
+}
+
+// start finishes the previous commit and begins a new one.
+func (t *testPairingTracker) start() {
+	t.finish()
+}
+
+// observe records that the current commit changed path.
+func (t *testPairingTracker) observe(path string) {
+	if _, ok := t.status[path]; ok {
+		t.changed[path] = true
+	}
+}
+
+// finish credits every production file in the current commit whose tests changed too.
+func (t *testPairingTracker) finish() {
+	for path := range t.changed {
+		for _, test := range t.tests[path] {
+			if t.changed[test] {
+				t.coChanges[path]++
+				break
+			}
+		}
+	}
+	clear(t.changed)
+}
+
+// apply finishes the last commit and records the test status and co-changes on output.
+func (t *testPairingTracker) apply(output *schema.AggregateOutput) {
+	t.finish()
+	for path, status := range t.status {
+		if stat, ok := output.FileStats[path]; ok {
+			stat.TestStatus = status
+			stat.TestCoChanges = schema.Metric(t.coChanges[path])
+		}
+	}
+}
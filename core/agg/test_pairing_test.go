@@ -0,0 +1,45 @@
+package agg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAndAggregateGitLog_TestCoChanges(t *testing.T) {
+	fileExists := createTestFileExistsMap([]string{"api.go", "api_test.go", "db.go", "README.md"})
+	logData := []byte(`'--c4|Bob|2024-02-20T10:00:00Z|tweak api'
+1	0	api.go
+
+'--c3|Bob|2024-02-10T10:00:00Z|api change with tests'
+3	1	api.go
+5	0	api_test.go
+
+'--c2|Alice|2024-02-01T10:00:00Z|db and docs'
+1	0	db.go
+1	0	README.md
+
+'--c1|Alice|2024-01-10T10:00:00Z|tests only'
+2	0	api_test.go
+`)
+
+	output := initializeAggregateOutput(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{})
+
+	api := output.FileStats["api.go"]
+	assert.Equal(t, schema.TestStatusPaired, api.TestStatus)
+	assert.Equal(t, schema.Metric(1), api.TestCoChanges)
+	assert.InDelta(t, 0.5, api.TestCoChangeRatio(), 1e-9)
+
+	assert.Equal(t, schema.TestStatusOrphan, output.FileStats["db.go"].TestStatus)
+	assert.Equal(t, schema.TestStatusTest, output.FileStats["api_test.go"].TestStatus)
+	assert.Empty(t, output.FileStats["README.md"].TestStatus)
+
+	// An empty rule set turns pairing off
+	output = initializeAggregateOutput(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC))
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{tests: &schema.TestPairing{}})
+	assert.Empty(t, output.FileStats["api.go"].TestStatus)
+	assert.Zero(t, output.FileStats["api.go"].TestCoChanges)
+}
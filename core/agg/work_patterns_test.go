@@ -0,0 +1,48 @@
+package agg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAndAggregateGitLog_WorkPatterns(t *testing.T) {
+	fileExists := createTestFileExistsMap([]string{"a.go", "b.go"})
+	logData := []byte(`'--c3|Bob|2024-03-02T23:30:00+02:00|saturday night hotfix'
+1	0	a.go
+
+'--c2|Alice|2024-03-04T09:00:00+09:00|monday morning in tokyo'
+1	0	a.go
+1	0	b.go
+
+'--c1|Alice|2024-03-05T06:15:00-05:00|early tuesday'
+1	0	a.go
+`)
+
+	end := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	output := initializeAggregateOutput(end)
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{})
+
+	// Hours and weekdays are taken in each author's local time
+	a := output.FileStats["a.go"]
+	assert.Equal(t, schema.Metric(2), a.OffHoursCommits)
+	assert.Equal(t, schema.Metric(1), a.WeekendCommits)
+	assert.InDelta(t, 2.0/3.0, a.OffHoursRatio(), 1e-9)
+	assert.InDelta(t, 1.0/3.0, a.WeekendRatio(), 1e-9)
+	assert.Zero(t, output.FileStats["b.go"].OffHoursRatio())
+
+	require.NotNil(t, output.WorkHeatmap)
+	assert.Equal(t, 1, output.WorkHeatmap[time.Saturday][23])
+	assert.Equal(t, 1, output.WorkHeatmap[time.Monday][9])
+	assert.Equal(t, 1, output.WorkHeatmap[time.Tuesday][6])
+
+	// The privacy switch turns collection off entirely
+	output = initializeAggregateOutput(end)
+	parseAndAggregateGitLog(logData, fileExists, output, time.Time{}, commitRules{noWorkPatterns: true})
+	assert.Nil(t, output.WorkHeatmap)
+	assert.Zero(t, output.FileStats["a.go"].OffHoursCommits)
+	assert.Zero(t, output.FileStats["a.go"].WeekendCommits)
+}
@@ -0,0 +1,243 @@
+package algo
+
+import (
+	"go/ast"
+	"go/parser"
+	gotoken "go/token"
+	"path/filepath"
+	"strings"
+
+	"github.com/huangsam/hotspot/schema"
+)
+
+// IsGoSource reports whether a file is Go source that GoFunctions can analyze.
+func IsGoSource(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".go")
+}
+
+// GoFunctions parses Go source and returns the complexity of every function and method
+// with a body, in source order. Function literals count toward their enclosing declaration.
+func GoFunctions(path string, src []byte) ([]schema.FunctionComplexity, error) {
+	fset := gotoken.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	var functions []schema.FunctionComplexity
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		functions = append(functions, schema.FunctionComplexity{
+			Name:       funcName(fn),
+			Line:       fset.Position(fn.Pos()).Line,
+			EndLine:    fset.Position(fn.End()).Line,
+			Cyclomatic: cyclomatic(fn),
+			Cognitive:  cognitive(fn),
+		})
+	}
+	return functions, nil
+}
+
+// funcName returns the name of a declaration, qualified by its receiver type for methods.
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	typ := fn.Recv.List[0].Type
+	pointer := false
+	if star, ok := typ.(*ast.StarExpr); ok {
+		typ, pointer = star.X, true
+	}
+	// Drop the type parameters of generic receivers
+	switch t := typ.(type) {
+	case *ast.IndexExpr:
+		typ = t.X
+	case *ast.IndexListExpr:
+		typ = t.X
+	}
+	name := "?"
+	if ident, ok := typ.(*ast.Ident); ok {
+		name = ident.Name
+	}
+	if pointer {
+		return "(*" + name + ")." + fn.Name.Name
+	}
+	return name + "." + fn.Name.Name
+}
+
+// cyclomatic returns the McCabe complexity of a function: one plus each if, for, range,
+// non-default case and logical operator.
+func cyclomatic(fn *ast.FuncDecl) int {
+	complexity := 1
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			complexity++
+		case *ast.CaseClause:
+			if n.List != nil {
+				complexity++
+			}
+		case *ast.CommClause:
+			if n.Comm != nil {
+				complexity++
+			}
+		case *ast.BinaryExpr:
+			if isLogicalOp(n.Op) {
+				complexity++
+			}
+		}
+		return true
+	})
+	return complexity
+}
+
+// cognitive returns the cognitive complexity of a function. Control flow breaks add one
+// plus their nesting depth, else branches and labeled jumps add one, each run of like
+// logical operators adds one, and so does direct recursion.
+func cognitive(fn *ast.FuncDecl) int {
+	v := &cognitiveVisitor{
+		name:       fn.Name.Name,
+		elseIfs:    make(map[*ast.IfStmt]bool),
+		calculated: make(map[ast.Expr]bool),
+	}
+	if fn.Recv != nil && len(fn.Recv.List) > 0 && len(fn.Recv.List[0].Names) > 0 {
+		v.receiver = fn.Recv.List[0].Names[0].Name
+	}
+	ast.Walk(v, fn.Body)
+	return v.complexity
+}
+
+// cognitiveVisitor accumulates the cognitive complexity of one function body.
+type cognitiveVisitor struct {
+	name, receiver string
+	complexity     int
+	nesting        int
+	elseIfs        map[*ast.IfStmt]bool // If statements that are else-if branches
+	calculated     map[ast.Expr]bool    // Logical expressions already counted as part of a run
+}
+
+// Visit implements ast.Visitor.
+func (v *cognitiveVisitor) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case *ast.IfStmt:
+		if v.elseIfs[n] {
+			v.complexity++
+		} else {
+			v.complexity += 1 + v.nesting
+		}
+		walkOptional(v, n.Init)
+		ast.Walk(v, n.Cond)
+		v.walkNested(n.Body)
+		switch e := n.Else.(type) {
+		case *ast.BlockStmt:
+			v.complexity++
+			v.walkNested(e)
+		case *ast.IfStmt:
+			v.elseIfs[e] = true
+			ast.Walk(v, e)
+		}
+		return nil
+	case *ast.SwitchStmt:
+		v.complexity += 1 + v.nesting
+		walkOptional(v, n.Init)
+		if n.Tag != nil {
+			ast.Walk(v, n.Tag)
+		}
+		v.walkNested(n.Body)
+		return nil
+	case *ast.TypeSwitchStmt:
+		v.complexity += 1 + v.nesting
+		walkOptional(v, n.Init)
+		ast.Walk(v, n.Assign)
+		v.walkNested(n.Body)
+		return nil
+	case *ast.SelectStmt:
+		v.complexity += 1 + v.nesting
+		v.walkNested(n.Body)
+		return nil
+	case *ast.ForStmt:
+		v.complexity += 1 + v.nesting
+		walkOptional(v, n.Init)
+		if n.Cond != nil {
+			ast.Walk(v, n.Cond)
+		}
+		walkOptional(v, n.Post)
+		v.walkNested(n.Body)
+		return nil
+	case *ast.RangeStmt:
+		v.complexity += 1 + v.nesting
+		ast.Walk(v, n.X)
+		v.walkNested(n.Body)
+		return nil
+	case *ast.FuncLit:
+		v.walkNested(n.Body)
+		return nil
+	case *ast.BranchStmt:
+		if n.Tok == gotoken.GOTO || n.Label != nil {
+			v.complexity++
+		}
+	case *ast.BinaryExpr:
+		if isLogicalOp(n.Op) && !v.calculated[n] {
+			var last gotoken.Token
+			for _, op := range v.logicalOps(n) {
+				if op != last {
+					v.complexity++
+					last = op
+				}
+			}
+		}
+	case *ast.CallExpr:
+		if v.isRecursive(n) {
+			v.complexity++
+		}
+	}
+	return v
+}
+
+// walkNested walks a body one nesting level deeper.
+func (v *cognitiveVisitor) walkNested(body ast.Node) {
+	v.nesting++
+	ast.Walk(v, body)
+	v.nesting--
+}
+
+// logicalOps flattens an unparenthesized chain of logical operators in source order and
+// marks its sub-expressions as counted.
+func (v *cognitiveVisitor) logicalOps(expr ast.Expr) []gotoken.Token {
+	v.calculated[expr] = true
+	bin, ok := expr.(*ast.BinaryExpr)
+	if !ok {
+		return nil
+	}
+	ops := v.logicalOps(bin.X)
+	if isLogicalOp(bin.Op) {
+		ops = append(ops, bin.Op)
+	}
+	return append(ops, v.logicalOps(bin.Y)...)
+}
+
+// isRecursive reports whether a call invokes the function being measured.
+func (v *cognitiveVisitor) isRecursive(call *ast.CallExpr) bool {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		return v.receiver == "" && fun.Name == v.name
+	case *ast.SelectorExpr:
+		recv, ok := fun.X.(*ast.Ident)
+		return ok && v.receiver != "" && recv.Name == v.receiver && fun.Sel.Name == v.name
+	}
+	return false
+}
+
+// walkOptional walks a statement that may be absent.
+func walkOptional(v ast.Visitor, stmt ast.Stmt) {
+	if stmt != nil {
+		ast.Walk(v, stmt)
+	}
+}
+
+func isLogicalOp(op gotoken.Token) bool {
+	return op == gotoken.LAND || op == gotoken.LOR
+}
@@ -0,0 +1,88 @@
+package algo
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const complexitySource = `package demo
+
+func Simple() int { return 1 }
+
+func Branchy(xs []int, ok bool) int {
+	total := 0
+	for _, x := range xs {
+		if x > 0 && ok {
+			total += x
+		} else if x < 0 {
+			total -= x
+		} else {
+			continue
+		}
+	}
+	switch {
+	case total > 10:
+		return 10
+	default:
+	}
+	return total
+}
+
+type T struct{}
+
+func (t *T) Fact(n int) int {
+	if n <= 1 || n > 100 {
+		return 1
+	}
+	return n * t.Fact(n-1)
+}
+
+func Mixed(a, b, c bool) bool {
+	return a && b || c
+}
+
+func Literal() {
+	f := func() {
+		if true {
+			return
+		}
+	}
+	f()
+}
+
+func Stub()
+`
+
+func TestGoFunctions(t *testing.T) {
+	functions, err := GoFunctions("demo.go", []byte(complexitySource))
+	require.NoError(t, err)
+	assert.Equal(t, []schema.FunctionComplexity{
+		{Name: "Simple", Line: 3, EndLine: 3, Cyclomatic: 1, Cognitive: 0},
+		{Name: "Branchy", Line: 5, EndLine: 22, Cyclomatic: 6, Cognitive: 7},
+		{Name: "(*T).Fact", Line: 26, EndLine: 31, Cyclomatic: 3, Cognitive: 3},
+		{Name: "Mixed", Line: 33, EndLine: 35, Cyclomatic: 3, Cognitive: 2},
+		{Name: "Literal", Line: 37, EndLine: 44, Cyclomatic: 2, Cognitive: 2},
+	}, functions)
+
+	stats := schema.SummarizeComplexity(functions)
+	assert.Equal(t, schema.ComplexityStats{Functions: 5, CyclomaticTotal: 15, CyclomaticMax: 6, CognitiveTotal: 14, CognitiveMax: 7}, stats)
+
+	_, err = GoFunctions("broken.go", []byte("package demo\nfunc {"))
+	assert.Error(t, err)
+
+	assert.True(t, IsGoSource("cmd/main.go"))
+	assert.False(t, IsGoSource("main.py"))
+}
+
+func TestGoImports(t *testing.T) {
+	src := []byte("package x\n\nimport (\n\t\"fmt\"\n\talias \"example.com/m/internal/a\"\n\t_ \"example.com/m/b\"\n)\n\nfunc F() {}\n")
+	imports, err := GoImports("x.go", src)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fmt", "example.com/m/internal/a", "example.com/m/b"}, imports)
+
+	_, err = GoImports("bad.go", []byte("not go"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+package algo
+
+import (
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/huangsam/hotspot/schema"
+)
+
+// compiledExpressions caches compiled formulas by source so each file does not re-parse them.
+var compiledExpressions sync.Map
+
+// ComputeExpressionScore evaluates an expression mode against a file's metrics.
+// The result is scaled to [0,100] like the base modes, and each top-level term of the
+// formula is reported in the breakdown as its percentage contribution.
+func ComputeExpressionScore(m *schema.FileResult, cfg *schema.ExpressionModeConfig) (float64, map[schema.BreakdownKey]float64) {
+	if m == nil || cfg == nil || m.SizeBytes == 0 {
+		return 0.0, map[schema.BreakdownKey]float64{}
+	}
+
+	var expr *schema.Expression
+	if cached, ok := compiledExpressions.Load(cfg.Expression); ok {
+		expr = cached.(*schema.Expression)
+	} else {
+		compiled, err := schema.CompileExpression(cfg.Expression)
+		if err != nil {
+			// Expressions are validated when the config is loaded, so this is unreachable in practice.
+			return 0.0, map[schema.BreakdownKey]float64{}
+		}
+		compiledExpressions.Store(cfg.Expression, compiled)
+		expr = compiled
+	}
+
+	raw, contributions := expr.Evaluate(ExpressionVariables(m))
+	breakdown := make(map[schema.BreakdownKey]float64, len(contributions))
+	for k, v := range contributions {
+		breakdown[k] = v * 100.0
+	}
+
+	return math.Min(math.Max(raw, 0), 1) * 100.0, breakdown
+}
+
+// ExpressionReasoning explains an expression score by naming its dominant term.
+func ExpressionReasoning(mode schema.ScoringMode, breakdown map[schema.BreakdownKey]float64) []string {
+	var topKey schema.BreakdownKey
+	topValue := 0.0
+	for k, v := range breakdown {
+		if v > topValue || (v == topValue && k < topKey) {
+			topKey, topValue = k, v
+		}
+	}
+	if topKey == "" {
+		return []string{}
+	}
+	return []string{fmt.Sprintf("Formula Driver: %s contributes %.0f points to the %s score.", topKey, topValue, mode)}
+}
@@ -0,0 +1,42 @@
+package algo
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComputeExpressionScore(t *testing.T) {
+	cfg := &schema.ExpressionModeConfig{Expression: "0.5*churn_norm + 0.5*(1-contrib_norm)"}
+	file := &schema.FileResult{
+		Path:               "core/engine.go",
+		SizeBytes:          1024,
+		Churn:              2500, // churn_norm = 0.5
+		UniqueContributors: 2,    // contrib_norm = 0.1
+	}
+
+	score, breakdown := ComputeExpressionScore(file, cfg)
+	assert.InDelta(t, 70.0, score, 1e-9)
+	assert.InDelta(t, 25.0, breakdown["0.5*churn_norm"], 1e-9)
+	assert.InDelta(t, 45.0, breakdown["0.5*(1-contrib_norm)"], 1e-9)
+
+	reasoning := ExpressionReasoning("churny_silos", breakdown)
+	require.Len(t, reasoning, 1)
+	assert.Contains(t, reasoning[0], "0.5*(1-contrib_norm)")
+
+	// Empty files score zero.
+	file.SizeBytes = 0
+	score, _ = ComputeExpressionScore(file, cfg)
+	assert.Equal(t, 0.0, score)
+}
+
+func TestExpressionVariables_CoverAllNames(t *testing.T) {
+	vars := ExpressionVariables(&schema.FileResult{})
+	for _, name := range schema.ExpressionVariableNames() {
+		_, ok := vars[name]
+		assert.True(t, ok, "missing variable %s", name)
+	}
+	assert.Len(t, vars, len(schema.ExpressionVariableNames()))
+}
@@ -0,0 +1,23 @@
+package algo
+
+import (
+	"go/parser"
+	gotoken "go/token"
+	"strconv"
+)
+
+// GoImports parses the import declarations of Go source and returns the imported paths.
+func GoImports(path string, src []byte) ([]string, error) {
+	fset := gotoken.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ImportsOnly|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	imports := make([]string, 0, len(file.Imports))
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err == nil {
+			imports = append(imports, p)
+		}
+	}
+	return imports, nil
+}
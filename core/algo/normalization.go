@@ -0,0 +1,93 @@
+package algo
+
+import (
+	"math"
+	"slices"
+
+	"github.com/huangsam/hotspot/schema"
+)
+
+// normalizationPercentile is the percentile used as the saturation point by the percentile strategy.
+// Files above it still normalize to 1.0, but outliers no longer compress everyone else.
+const normalizationPercentile = 95.0
+
+// FixedNormalizationScales returns the built-in maxima used by the fixed strategy.
+func FixedNormalizationScales() schema.NormalizationScales {
+	return schema.NormalizationScales{
+		Strategy:      schema.FixedNormalization,
+		Contributors:  maxContrib,
+		Commits:       maxCommits,
+		SizeKB:        maxSizeKB,
+		AgeDays:       maxAgeDays,
+		Churn:         maxChurn,
+		RecentCommits: maxRecent,
+		LinesOfCode:   maxLOC,
+		Indent:        maxIndent,
+		Cyclomatic:    maxCyclomatic,
+		Cognitive:     maxCognitive,
+	}
+}
+
+// ComputeNormalizationScales derives per-metric scales from the analyzed population.
+// The percentile strategy uses the 95th percentile of each metric and the log strategy
+// uses the largest value. Files without content are ignored, and every scale is at
+// least 1 so tiny repositories do not amplify noise.
+func ComputeNormalizationScales(files []schema.FileResult, strategy schema.NormalizationStrategy) schema.NormalizationScales {
+	if strategy != schema.PercentileNormalization && strategy != schema.LogNormalization {
+		return FixedNormalizationScales()
+	}
+
+	var contrib, commits, sizeKB, age, churn, recent, loc, indent []float64
+	var cyclomatic, cognitive []float64 // Only files with Go functions
+	for i := range files {
+		f := &files[i]
+		if f.SizeBytes == 0 {
+			continue
+		}
+		contrib = append(contrib, f.UniqueContributors.Float64())
+		commits = append(commits, f.Commits.Float64())
+		sizeKB = append(sizeKB, float64(f.SizeBytes)/1024.0)
+		age = append(age, f.AgeDays.Float64())
+		churn = append(churn, f.Churn.Float64())
+		recent = append(recent, f.RecentCommits.Float64())
+		loc = append(loc, f.LinesOfCode.Float64())
+		indent = append(indent, f.IndentComplexity.Float64())
+		if f.Functions > 0 {
+			cyclomatic = append(cyclomatic, f.CyclomaticTotal.Float64())
+			cognitive = append(cognitive, f.CognitiveTotal.Float64())
+		}
+	}
+
+	scales := schema.NormalizationScales{Strategy: strategy}
+	pick := func(values []float64) float64 {
+		slices.Sort(values)
+		if strategy == schema.LogNormalization {
+			return populationScale(values, 100)
+		}
+		return populationScale(values, normalizationPercentile)
+	}
+	if strategy == schema.PercentileNormalization {
+		scales.Percentile = normalizationPercentile
+	}
+	scales.Contributors = pick(contrib)
+	scales.Commits = pick(commits)
+	scales.SizeKB = pick(sizeKB)
+	scales.AgeDays = pick(age)
+	scales.Churn = pick(churn)
+	scales.RecentCommits = pick(recent)
+	scales.LinesOfCode = pick(loc)
+	scales.Indent = pick(indent)
+	scales.Cyclomatic = pick(cyclomatic)
+	scales.Cognitive = pick(cognitive)
+	return scales
+}
+
+// populationScale returns the nearest-rank percentile of sorted values, floored at 1.
+func populationScale(sorted []float64, percentile float64) float64 {
+	if len(sorted) == 0 {
+		return 1
+	}
+	rank := int(math.Ceil(percentile/100*float64(len(sorted)))) - 1
+	rank = min(max(rank, 0), len(sorted)-1)
+	return math.Max(sorted[rank], 1)
+}
@@ -0,0 +1,69 @@
+package algo
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func normalizationPopulation() []schema.FileResult {
+	files := make([]schema.FileResult, 0, 21)
+	for i := 1; i <= 20; i++ {
+		files = append(files, schema.FileResult{
+			SizeBytes:          int64(i) * 1024,
+			Commits:            schema.Metric(i),
+			Churn:              schema.Metric(i * 10),
+			UniqueContributors: schema.Metric(1),
+			LinesOfCode:        schema.Metric(i * 100),
+			AgeDays:            schema.Metric(i),
+		})
+	}
+	// Empty files are not part of the population
+	files = append(files, schema.FileResult{Commits: 1000})
+	return files
+}
+
+func TestComputeNormalizationScales(t *testing.T) {
+	files := normalizationPopulation()
+
+	fixed := ComputeNormalizationScales(files, schema.FixedNormalization)
+	assert.Equal(t, FixedNormalizationScales(), fixed)
+
+	percentile := ComputeNormalizationScales(files, schema.PercentileNormalization)
+	assert.Equal(t, schema.PercentileNormalization, percentile.Strategy)
+	assert.Equal(t, 95.0, percentile.Percentile)
+	assert.Equal(t, 19.0, percentile.Commits)
+	assert.Equal(t, 190.0, percentile.Churn)
+	assert.Equal(t, 1900.0, percentile.LinesOfCode)
+	assert.Equal(t, 19.0, percentile.SizeKB)
+	assert.Equal(t, 1.0, percentile.Contributors)
+	assert.Equal(t, 1.0, percentile.RecentCommits, "scales are floored at 1")
+
+	logScales := ComputeNormalizationScales(files, schema.LogNormalization)
+	assert.Equal(t, schema.LogNormalization, logScales.Strategy)
+	assert.Zero(t, logScales.Percentile)
+	assert.Equal(t, 20.0, logScales.Commits)
+	assert.Equal(t, 200.0, logScales.Churn)
+
+	empty := ComputeNormalizationScales(nil, schema.PercentileNormalization)
+	assert.Equal(t, 1.0, empty.Commits)
+}
+
+func TestNormalizeMetrics_Strategies(t *testing.T) {
+	m := &schema.FileResult{SizeBytes: 1024, Commits: 10, Churn: 100}
+
+	fixed := normalizeMetrics(m)
+	assert.InDelta(t, 10.0/maxCommits, fixed.commits, 1e-9)
+
+	m.Normalization = &schema.NormalizationScales{Strategy: schema.PercentileNormalization, Commits: 20, Churn: 50, AgeDays: maxAgeDays}
+	percentile := normalizeMetrics(m)
+	assert.InDelta(t, 0.5, percentile.commits, 1e-9)
+	assert.Equal(t, 1.0, percentile.churn, "values above the scale saturate")
+	assert.Zero(t, percentile.contrib, "a zero scale normalizes to zero")
+
+	m.Normalization = &schema.NormalizationScales{Strategy: schema.LogNormalization, Commits: 10, Churn: 1000, AgeDays: maxAgeDays}
+	logScaled := normalizeMetrics(m)
+	assert.InDelta(t, 1.0, logScaled.commits, 1e-9)
+	assert.Greater(t, logScaled.churn, 100.0/1000.0, "log scaling lifts small values")
+}
@@ -1,10 +1,9 @@
 package algo
 
 import (
+	"fmt"
 	"math"
-	"path/filepath"
 	"slices"
-	"strings"
 
 	"github.com/huangsam/hotspot/schema"
 )
@@ -21,44 +20,15 @@ func ComputeScore(m *schema.FileResult, mode schema.ScoringMode, weights map[sch
 		return 0.0
 	}
 
-	// Tunable maxima to normalize metrics.
-	const (
-		maxContrib = 20.0    // contributors beyond this saturate
-		maxCommits = 500.0   // commits beyond this saturate
-		maxSizeKB  = 500.0   // file size in KB beyond this saturate
-		maxAgeDays = 3650.0  // ~10 years
-		maxChurn   = 5000.0  // total added+deleted lines
-		maxRecent  = 50.0    // 50 recent commits is high activity
-		maxLOC     = 10000.0 // Lines of Code beyond this saturate (10k lines)
-	)
-
-	clamp01 := func(v float64) float64 {
-		if v < 0 {
-			return 0
-		}
-		if v > 1 {
-			return 1
-		}
-		return v
-	}
-
-	// --- Normalized Metrics [0,1] ---
-	nContrib := clamp01(m.UniqueContributors.Float64() / maxContrib)
-	nCommits := clamp01(m.Commits.Float64() / maxCommits)
-	nSize := clamp01((float64(m.SizeBytes) / 1024.0) / maxSizeKB)
-	nAge := clamp01(math.Log1p(m.AgeDays.Float64()) / math.Log1p(maxAgeDays))
-	nChurn := clamp01(m.Churn.Float64() / maxChurn)
-	nLOC := clamp01(m.LinesOfCode.Float64() / maxLOC) // Normalized Lines of Code
-
-	// Decayed Activity Metrics
-	nDecayedCommits := clamp01(m.DecayedCommits.Float64() / maxCommits)
-	nDecayedChurn := clamp01(m.DecayedChurn.Float64() / maxChurn)
-
-	// Inverted Metrics
-	nGiniRaw := clamp01(m.Gini)            // Gini (raw: high is bad)
-	nInvContrib := clamp01(1.0 - nContrib) // Inverse Contributors (high is bad/risky)
-	nRecentCommits := clamp01(m.RecentCommits.Float64() / maxRecent)
-	nInvRecentCommits := clamp01(1.0 - nRecentCommits) // Inverse Recent Activity (high indicates low activity)
+	n := normalizeMetrics(m)
+	nContrib, nCommits, nSize, nAge := n.contrib, n.commits, n.size, n.age
+	nChurn, nLOC := n.churn, n.loc
+	nDecayedCommits, nDecayedChurn := n.decayedCommits, n.decayedChurn
+	nGiniRaw, nInvContrib, nInvRecentCommits := n.gini, n.invContrib, n.invRecentCommits
+	nFixes, nEntropy := n.fixes, n.entropy
+	nMinorContrib, nOwnerShare, nOwnEntropy := n.minorContrib, n.ownerShare, n.ownEntropy
+	nOffHours, nTestGap := n.offHours, n.testGap
+	nIndent, nCyclomatic, nCognitive := n.indent, n.cyclomatic, n.cognitive
 
 	// --- Recency Signal Calculation ---
 	// Freshness ratio: how much of the lifetime volume is recent (fixed window)?
@@ -110,17 +80,41 @@ func ComputeScore(m *schema.FileResult, mode schema.ScoringMode, weights map[sch
 
 	// Mode-specific metrics
 	switch mode {
+	case schema.HotMode:
+		breakdown[schema.BreakdownEntropy] = weights[schema.BreakdownEntropy] * nEntropy
 	case schema.RiskMode:
 		breakdown[schema.BreakdownGini] = weights[schema.BreakdownGini] * nGiniRaw
 		breakdown[schema.BreakdownInvContrib] = weights[schema.BreakdownInvContrib] * nInvContrib
 		breakdown[schema.BreakdownLOC] = weights[schema.BreakdownLOC] * nLOC
 		breakdown[schema.BreakdownLowRecent] = weights[schema.BreakdownLowRecent] * nInvRecentCommits
+		breakdown[schema.BreakdownFixes] = weights[schema.BreakdownFixes] * nFixes
+		breakdown[schema.BreakdownMinorContrib] = weights[schema.BreakdownMinorContrib] * nMinorContrib
+		breakdown[schema.BreakdownOwnerShare] = weights[schema.BreakdownOwnerShare] * nOwnerShare
+		breakdown[schema.BreakdownOwnershipEntropy] = weights[schema.BreakdownOwnershipEntropy] * nOwnEntropy
+		breakdown[schema.BreakdownOffHours] = weights[schema.BreakdownOffHours] * nOffHours
+		breakdown[schema.BreakdownTestGap] = weights[schema.BreakdownTestGap] * nTestGap
 	case schema.ComplexityMode:
 		breakdown[schema.BreakdownLOC] = weights[schema.BreakdownLOC] * nLOC
 		breakdown[schema.BreakdownLowRecent] = weights[schema.BreakdownLowRecent] * nInvRecentCommits
+		breakdown[schema.BreakdownFixes] = weights[schema.BreakdownFixes] * nFixes
+		breakdown[schema.BreakdownEntropy] = weights[schema.BreakdownEntropy] * nEntropy
+		breakdown[schema.BreakdownTestGap] = weights[schema.BreakdownTestGap] * nTestGap
+		breakdown[schema.BreakdownIndent] = weights[schema.BreakdownIndent] * nIndent
+		breakdown[schema.BreakdownCyclomatic] = weights[schema.BreakdownCyclomatic] * nCyclomatic
+		breakdown[schema.BreakdownCognitive] = weights[schema.BreakdownCognitive] * nCognitive
+	case schema.DefectsMode:
+		breakdown[schema.BreakdownFixes] = weights[schema.BreakdownFixes] * nFixes
+		breakdown[schema.BreakdownLOC] = weights[schema.BreakdownLOC] * nLOC
+		breakdown[schema.BreakdownEntropy] = weights[schema.BreakdownEntropy] * nEntropy
+		breakdown[schema.BreakdownOffHours] = weights[schema.BreakdownOffHours] * nOffHours
+		breakdown[schema.BreakdownCyclomatic] = weights[schema.BreakdownCyclomatic] * nCyclomatic
+		breakdown[schema.BreakdownCognitive] = weights[schema.BreakdownCognitive] * nCognitive
 	case schema.ROIMode:
 		breakdown[schema.BreakdownGini] = weights[schema.BreakdownGini] * nGiniRaw
 		breakdown[schema.BreakdownLOC] = weights[schema.BreakdownLOC] * nLOC
+		breakdown[schema.BreakdownIndent] = weights[schema.BreakdownIndent] * nIndent
+		breakdown[schema.BreakdownCyclomatic] = weights[schema.BreakdownCyclomatic] * nCyclomatic
+		breakdown[schema.BreakdownCognitive] = weights[schema.BreakdownCognitive] * nCognitive
 	}
 
 	for _, value := range breakdown {
@@ -128,25 +122,6 @@ func ComputeScore(m *schema.FileResult, mode schema.ScoringMode, weights map[sch
 	}
 	score := raw * 100.0
 
-	pathLower := strings.ToLower(m.Path)
-	extLower := strings.ToLower(filepath.Ext(m.Path))
-
-	// Debuff signals on tests, autogenerated code, mock code and example code
-	if strings.Contains(pathLower, "test") || strings.Contains(pathLower, "generate") || strings.Contains(pathLower, "example") || strings.Contains(pathLower, "mock") {
-		if mode == schema.RiskMode {
-			score *= 0.75
-		} else {
-			score *= 0.50
-		}
-	}
-
-	// Debuff signals on configuration
-	if isConfigurationFile(extLower) {
-		if mode == schema.ComplexityMode {
-			score *= 0.50
-		}
-	}
-
 	// Scale breakdown values to percent contributions
 	for k, v := range breakdown {
 		breakdown[k] = v * 100.0
@@ -158,6 +133,219 @@ func ComputeScore(m *schema.FileResult, mode schema.ScoringMode, weights map[sch
 	return score
 }
 
+// Tunable maxima to normalize metrics.
+const (
+	maxContrib    = 20.0    // contributors beyond this saturate
+	maxCommits    = 500.0   // commits beyond this saturate
+	maxSizeKB     = 500.0   // file size in KB beyond this saturate
+	maxAgeDays    = 3650.0  // ~10 years
+	maxChurn      = 5000.0  // total added+deleted lines
+	maxRecent     = 50.0    // 50 recent commits is high activity
+	maxLOC        = 10000.0 // Lines of Code beyond this saturate (10k lines)
+	maxIndent     = 20000.0 // summed indentation depth beyond this saturate (10k lines nested 2 deep)
+	maxCyclomatic = 500.0   // summed cyclomatic complexity of a Go file beyond this saturate
+	maxCognitive  = 500.0   // summed cognitive complexity of a Go file beyond this saturate
+	minFixes      = 5.0     // fix commits needed before the fix ratio counts in full
+	maxEntropy    = 3.0     // summed change entropy contribution beyond this saturate
+	maxMinor      = 10.0    // minor contributors beyond this saturate
+	maxOwnBits    = 4.0     // ownership entropy in bits (16 equal contributors) beyond this saturate
+	minRatio      = 5.0     // commits needed before off-hours and test co-change ratios count in full
+)
+
+// normalizedMetrics holds a file's metrics scaled to [0,1].
+type normalizedMetrics struct {
+	contrib, commits, size, age, churn, loc float64
+	decayedCommits, decayedChurn            float64
+	gini, invContrib                        float64
+	recentCommits, invRecentCommits         float64
+	fixes, entropy                          float64
+	minorContrib, ownerShare, ownEntropy    float64
+	offHours, testGap                       float64
+	indent, cyclomatic, cognitive           float64
+}
+
+func clamp01(v float64) float64 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
+
+// normalizeMetrics scales the raw file metrics against the file's normalization scales,
+// falling back to the tunable maxima when none were derived for the run.
+func normalizeMetrics(m *schema.FileResult) normalizedMetrics {
+	scales := FixedNormalizationScales()
+	if m.Normalization != nil {
+		scales = *m.Normalization
+	}
+	scale := linearScale
+	if scales.Strategy == schema.LogNormalization {
+		scale = logScale
+	}
+
+	n := normalizedMetrics{
+		contrib: scale(m.UniqueContributors.Float64(), scales.Contributors),
+		commits: scale(m.Commits.Float64(), scales.Commits),
+		size:    scale(float64(m.SizeBytes)/1024.0, scales.SizeKB),
+		age:     logScale(m.AgeDays.Float64(), scales.AgeDays), // Age is always log-scaled
+		churn:   scale(m.Churn.Float64(), scales.Churn),
+		loc:     scale(m.LinesOfCode.Float64(), scales.LinesOfCode),
+		indent:  scale(m.IndentComplexity.Float64(), scales.Indent),
+
+		// Go complexity (0 for other languages)
+		cyclomatic: scale(m.CyclomaticTotal.Float64(), scales.Cyclomatic),
+		cognitive:  scale(m.CognitiveTotal.Float64(), scales.Cognitive),
+
+		// Decayed Activity Metrics
+		decayedCommits: scale(m.DecayedCommits.Float64(), scales.Commits),
+		decayedChurn:   scale(m.DecayedChurn.Float64(), scales.Churn),
+
+		// Inverted Metrics
+		gini:          clamp01(m.Gini), // Gini (raw: high is bad)
+		recentCommits: scale(m.RecentCommits.Float64(), scales.RecentCommits),
+	}
+	n.invContrib = clamp01(1.0 - n.contrib)             // Inverse Contributors (high is bad/risky)
+	n.invRecentCommits = clamp01(1.0 - n.recentCommits) // Inverse Recent Activity (high indicates low activity)
+
+	// Bug-fix density: the fix ratio, damped for files with only a handful of fixes
+	n.fixes = clamp01(m.FixRatio) * clamp01(m.FixCommits.Float64()/minFixes)
+
+	// Change entropy: how much of each period's scattered changes involved this file
+	n.entropy = clamp01(m.ChangeEntropy / maxEntropy)
+
+	// Ownership fragmentation
+	n.minorContrib = clamp01(m.MinorContributors.Float64() / maxMinor)
+	n.ownerShare = clamp01(m.TopOwnerShare)
+	n.ownEntropy = clamp01(m.OwnershipEntropy / maxOwnBits)
+
+	// Off-hours work: the off-hours ratio, damped for files with only a handful of commits
+	n.offHours = clamp01(m.OffHoursRatio) * clamp01(m.Commits.Float64()/minRatio)
+
+	// Test gap: the share of commits that left the tests untouched (all of them for orphans),
+	// damped for files with only a handful of commits. Files no pairing rule covers have none.
+	switch m.TestStatus {
+	case schema.TestStatusPaired:
+		n.testGap = clamp01(1-m.TestCoChangeRatio) * clamp01(m.Commits.Float64()/minRatio)
+	case schema.TestStatusOrphan:
+		n.testGap = clamp01(m.Commits.Float64() / minRatio)
+	}
+	return n
+}
+
+func linearScale(v, limit float64) float64 {
+	if limit <= 0 {
+		return 0
+	}
+	return clamp01(v / limit)
+}
+
+func logScale(v, limit float64) float64 {
+	if limit <= 0 {
+		return 0
+	}
+	return clamp01(math.Log1p(math.Max(v, 0)) / math.Log1p(limit))
+}
+
+// ApplyScoreModifiers scales a score by every modifier matching the path and mode, in order.
+// It returns the adjusted score and a label such as "test_and_generated x0.50" for each
+// modifier that was applied.
+func ApplyScoreModifiers(path string, mode schema.ScoringMode, score float64, modifiers []schema.ScoreModifier) (float64, []string) {
+	var applied []string
+	for _, r := range modifiers {
+		if !r.Matches(path, mode) {
+			continue
+		}
+		score *= r.Multiplier
+		applied = append(applied, fmt.Sprintf("%s x%.2f", r.Name, r.Multiplier))
+	}
+	return score, applied
+}
+
+// ModifierReasoning explains each applied score modifier.
+func ModifierReasoning(applied []string) []string {
+	results := make([]string, 0, len(applied))
+	for _, label := range applied {
+		results = append(results, "Score Modifier: "+label)
+	}
+	return results
+}
+
+// ExpressionVariables binds a file's normalized metrics and raw fields to expression variable names.
+func ExpressionVariables(m *schema.FileResult) map[string]float64 {
+	n := normalizeMetrics(m)
+	return map[string]float64{
+		"contrib_norm":            n.contrib,
+		"commits_norm":            n.commits,
+		"size_norm":               n.size,
+		"age_norm":                n.age,
+		"churn_norm":              n.churn,
+		"loc_norm":                n.loc,
+		"decayed_commits_norm":    n.decayedCommits,
+		"decayed_churn_norm":      n.decayedChurn,
+		"gini_norm":               n.gini,
+		"inv_contrib_norm":        n.invContrib,
+		"recent_commits_norm":     n.recentCommits,
+		"inv_recent_commits_norm": n.invRecentCommits,
+		"fixes_norm":              n.fixes,
+		"entropy_norm":            n.entropy,
+		"minor_contrib_norm":      n.minorContrib,
+		"owner_share_norm":        n.ownerShare,
+		"ownership_entropy_norm":  n.ownEntropy,
+		"off_hours_norm":          n.offHours,
+		"test_gap_norm":           n.testGap,
+		"indent_norm":             n.indent,
+		"cyclomatic_norm":         n.cyclomatic,
+		"cognitive_norm":          n.cognitive,
+		"contributors":            m.UniqueContributors.Float64(),
+		"commits":                 m.Commits.Float64(),
+		"churn":                   m.Churn.Float64(),
+		"lines_added":             m.LinesAdded.Float64(),
+		"lines_deleted":           m.LinesDeleted.Float64(),
+		"lines_of_code":           m.LinesOfCode.Float64(),
+		"size_bytes":              float64(m.SizeBytes),
+		"age_days":                m.AgeDays.Float64(),
+		"gini":                    m.Gini,
+		"decayed_commits":         m.DecayedCommits.Float64(),
+		"decayed_churn":           m.DecayedChurn.Float64(),
+		"recent_contributors":     m.RecentContributors.Float64(),
+		"recent_commits":          m.RecentCommits.Float64(),
+		"recent_churn":            m.RecentChurn.Float64(),
+		"recent_lines_added":      m.RecentLinesAdded.Float64(),
+		"recent_lines_deleted":    m.RecentLinesDeleted.Float64(),
+		"recency_signal":          m.RecencySignal,
+		"fix_commits":             m.FixCommits.Float64(),
+		"fix_churn":               m.FixChurn.Float64(),
+		"fix_ratio":               m.FixRatio,
+		"reverts":                 m.Reverts.Float64(),
+		"rework_lines":            m.ReworkLines.Float64(),
+		"rework_ratio":            m.ReworkRatio,
+		"change_entropy":          m.ChangeEntropy,
+		"minor_contributors":      m.MinorContributors.Float64(),
+		"top_owner_share":         m.TopOwnerShare,
+		"ownership_entropy":       m.OwnershipEntropy,
+		"median_commit_files":     m.MedianCommitFiles.Float64(),
+		"median_commit_churn":     m.MedianCommitChurn.Float64(),
+		"large_commit_share":      m.LargeCommitShare,
+		"off_hours_ratio":         m.OffHoursRatio,
+		"weekend_ratio":           m.WeekendRatio,
+		"test_co_changes":         m.TestCoChanges.Float64(),
+		"test_co_change_ratio":    m.TestCoChangeRatio,
+		"sloc":                    m.SLOC.Float64(),
+		"comment_lines":           m.CommentLines.Float64(),
+		"comment_ratio":           m.CommentRatio,
+		"indent_complexity":       m.IndentComplexity.Float64(),
+		"max_indent":              m.MaxIndent.Float64(),
+		"functions":               m.Functions.Float64(),
+		"cyclomatic_total":        m.CyclomaticTotal.Float64(),
+		"cyclomatic_max":          m.CyclomaticMax.Float64(),
+		"cognitive_total":         m.CognitiveTotal.Float64(),
+		"cognitive_max":           m.CognitiveMax.Float64(),
+	}
+}
+
 // computeReasoning translates the numerical ModeBreakdown into an array of semantic justifications.
 func computeReasoning(m *schema.FileResult, mode schema.ScoringMode) []string {
 	var results []string
@@ -167,6 +355,18 @@ func computeReasoning(m *schema.FileResult, mode schema.ScoringMode) []string {
 	const (
 		significant = 20.0 // 20% contribution is "significant"
 		dominant    = 30.0 // 30% contribution is "dominant"
+
+		minUnstableReverts = 2   // Reverts before a file is called unstable
+		minUnstableRework  = 0.3 // Share of added lines reworked before a file is called unstable
+		minReworkLines     = 50  // Lines added before the rework ratio is trusted
+
+		minFragmentedMinors = 3   // Minor contributors before ownership is called fragmented
+		maxFragmentedShare  = 0.5 // Top owner share below which no one clearly owns the file
+
+		minTangledCommits = 3 // Commits before the median commit size is trusted
+
+		minTestCommits = 5   // Commits before a file's test co-evolution is judged
+		maxTestLag     = 0.2 // Test co-change ratio below which the tests are lagging
 	)
 
 	// 1. Core Synthesis Patterns (Cross-metric logic)
@@ -210,6 +410,62 @@ func computeReasoning(m *schema.FileResult, mode schema.ScoringMode) []string {
 		}
 	}
 
+	// Pattern: Deep Nesting (indentation shows the code is heavily nested)
+	if b[schema.BreakdownIndent] > significant {
+		results = append(results, fmt.Sprintf("Deep Nesting: %d lines of code reach %d levels of indentation.", int(m.SLOC), int(m.MaxIndent)))
+	}
+
+	// Pattern: Complex Functions (Go functions with many paths or deeply nested logic)
+	weightedComplexity := b[schema.BreakdownCyclomatic] > significant || b[schema.BreakdownCognitive] > significant
+	if weightedComplexity || (mode == schema.ComplexityMode && m.CyclomaticMax >= schema.HighCyclomatic) {
+		results = append(results, fmt.Sprintf("Complex Functions: the most complex of %d functions has cyclomatic complexity %d and cognitive complexity up to %d.", int(m.Functions), int(m.CyclomaticMax), int(m.CognitiveMax)))
+	}
+
+	// Pattern: Defect Magnet (fix commits dominate the file's history)
+	if b[schema.BreakdownFixes] > significant {
+		results = append(results, fmt.Sprintf("Defect Magnet: %.0f%% of commits (%d) were bug fixes.", m.FixRatio*100, int(m.FixCommits)))
+	}
+
+	// Pattern: Scattered Changes (the file keeps changing alongside many others)
+	if b[schema.BreakdownEntropy] > significant {
+		results = append(results, fmt.Sprintf("Scattered Changes: Change entropy of %.2f shows this file is often edited as part of wide, unfocused changes.", m.ChangeEntropy))
+	}
+
+	// Pattern: Instability (repeated reverts or lines rewritten soon after being written)
+	if mode == schema.HotMode || mode == schema.ComplexityMode || mode == schema.DefectsMode {
+		if m.Reverts >= minUnstableReverts {
+			results = append(results, fmt.Sprintf("Unstable: reverted %d times in %d days.", int(m.Reverts), m.RevertWindowDays))
+		}
+		if m.ReworkRatio >= minUnstableRework && m.LinesAdded >= minReworkLines {
+			results = append(results, fmt.Sprintf("Rework: %.0f%% of added lines were rewritten within %d days.", m.ReworkRatio*100, m.ReworkWindowDays))
+		}
+	}
+
+	// Pattern: After Hours (much of the work on the file happens off-hours)
+	if b[schema.BreakdownOffHours] > significant {
+		results = append(results, fmt.Sprintf("After Hours: %.0f%% of commits were made outside %02d:00-%02d:00 local time.", m.OffHoursRatio*100, schema.WorkdayStartHour, schema.WorkdayEndHour))
+	}
+
+	// Pattern: Tangled (the file usually changes inside large commits)
+	if mode != schema.RiskMode && m.Commits >= minTangledCommits && m.MedianCommitFiles >= schema.LargeCommitFiles {
+		results = append(results, fmt.Sprintf("Tangled: usually changed alongside %d+ files.", int(m.MedianCommitFiles)-1))
+	}
+
+	// Pattern: Untested Changes (the file keeps changing while its tests do not)
+	if (mode == schema.RiskMode || mode == schema.ComplexityMode) && m.Commits >= minTestCommits {
+		switch {
+		case m.TestStatus == schema.TestStatusOrphan:
+			results = append(results, "Untested: no test file pairs with this file.")
+		case m.TestStatus == schema.TestStatusPaired && m.TestCoChangeRatio < maxTestLag:
+			results = append(results, fmt.Sprintf("Tests Lag: only %.0f%% of commits also changed its tests.", m.TestCoChangeRatio*100))
+		}
+	}
+
+	// Pattern: Fragmented Ownership (many drive-by changes and no clear owner)
+	if mode == schema.RiskMode && m.MinorContributors >= minFragmentedMinors && m.TopOwnerShare < maxFragmentedShare {
+		results = append(results, fmt.Sprintf("Fragmented Ownership: %d drive-by contributors each made under 5%% of the commits, and the top owner made %.0f%%.", int(m.MinorContributors), m.TopOwnerShare*100))
+	}
+
 	// 3. Mode-Specific Nuance
 	if mode == schema.ROIMode {
 		if churn > significant && loc > significant {
@@ -260,15 +516,44 @@ func Gini(values []float64) float64 {
 	return math.Min(math.Max(g, 0), 1) // clamp to [0,1]
 }
 
-// isConfigurationFile returns true if the extension belongs to a data or configuration format.
-func isConfigurationFile(ext string) bool {
-	// Remove leading dot if present
-	ext = strings.TrimPrefix(ext, ".")
+// minorContributorShare is the share of commits below which a contributor counts as minor.
+const minorContributorShare = 0.05
 
-	return slices.Contains([]string{
-		"yml", "yaml", "json", "toml", "cfg", "xml", "ini",
-		"lock", "sum", "csv", "tsv", "md", "txt", "tfstate",
-	}, ext)
+// OwnershipStats describes how commits are spread across contributors.
+type OwnershipStats struct {
+	MinorContributors int     // Contributors with under 5% of the commits
+	TopOwnerShare     float64 // Share of commits made by the top contributor (0-1)
+	Entropy           float64 // Shannon entropy of the commit distribution, in bits
+}
+
+// Ownership computes the ownership fragmentation of a contributor -> commits map.
+func Ownership(contributors map[string]schema.Metric) OwnershipStats {
+	var total, top float64
+	for _, c := range contributors {
+		total += c.Float64()
+		top = math.Max(top, c.Float64())
+	}
+	if total == 0 {
+		return OwnershipStats{}
+	}
+
+	// Sort the shares so the entropy sum does not depend on map order
+	shares := make([]float64, 0, len(contributors))
+	for _, c := range contributors {
+		shares = append(shares, c.Float64()/total)
+	}
+	slices.Sort(shares)
+
+	stats := OwnershipStats{TopOwnerShare: top / total}
+	for _, p := range shares {
+		if p < minorContributorShare {
+			stats.MinorContributors++
+		}
+		if p > 0 {
+			stats.Entropy -= p * math.Log2(p)
+		}
+	}
+	return stats
 }
 
 // ComputeCompositeScore blends base mode scores to produce a composite score.
@@ -276,7 +561,7 @@ func isConfigurationFile(ext string) bool {
 // returns both the blended score and blended breakdown without mutating the input.
 //
 // Parameters:
-//   - file: FileResult with all 4 base mode scores already computed (in AllScores)
+//   - file: FileResult with all base mode scores already computed (in AllScores)
 //   - composite: CompositeConfig specifying which modes to blend and their weights
 //
 // Returns: The blended composite score [0-100] and blended breakdown map.
@@ -277,6 +277,259 @@ func TestComputeScoreRiskKnowledgeDecay(t *testing.T) {
 	assert.Equal(t, 0.0, activeFile.ModeBreakdown[schema.BreakdownLowRecent])
 }
 
+// TestComputeScoreDefects tests that the defects mode rewards files dominated by fix commits.
+func TestComputeScoreDefects(t *testing.T) {
+	buggy := &schema.FileResult{
+		Path:        "buggy.go",
+		Commits:     20,
+		FixCommits:  10,
+		FixRatio:    0.5,
+		Churn:       400,
+		LinesOfCode: 800,
+		SizeBytes:   20 * 1024,
+	}
+	stable := &schema.FileResult{
+		Path:        "stable.go",
+		Commits:     20,
+		Churn:       400,
+		LinesOfCode: 800,
+		SizeBytes:   20 * 1024,
+	}
+	rare := &schema.FileResult{
+		Path:        "rare.go",
+		Commits:     2,
+		FixCommits:  1,
+		FixRatio:    0.5,
+		Churn:       400,
+		LinesOfCode: 800,
+		SizeBytes:   20 * 1024,
+	}
+
+	weights := getWeightsForMode(schema.DefectsMode, nil)
+	buggyScore := ComputeScore(buggy, schema.DefectsMode, weights, 0.1, 0.4)
+	stableScore := ComputeScore(stable, schema.DefectsMode, weights, 0.1, 0.4)
+	rareScore := ComputeScore(rare, schema.DefectsMode, weights, 0.1, 0.4)
+
+	assert.Greater(t, buggyScore, stableScore)
+	assert.Greater(t, buggyScore, rareScore, "A single fix should count for less than a sustained fix history")
+	assert.InDelta(t, weights[schema.BreakdownFixes]*0.5*100, buggy.ModeBreakdown[schema.BreakdownFixes], 1e-9)
+	assert.Zero(t, stable.ModeBreakdown[schema.BreakdownFixes])
+	assert.Contains(t, strings.Join(buggy.Reasoning, " "), "Defect Magnet")
+
+	// The fixes factor is unweighted in risk by default, but can be enabled
+	riskDefault := ComputeScore(buggy, schema.RiskMode, getWeightsForMode(schema.RiskMode, nil), 0.1, 0.4)
+	riskWeights := getWeightsForMode(schema.RiskMode, map[schema.ScoringMode]map[schema.BreakdownKey]float64{
+		schema.RiskMode: {schema.BreakdownFixes: 0.2},
+	})
+	riskWithFixes := ComputeScore(buggy, schema.RiskMode, riskWeights, 0.1, 0.4)
+	assert.Greater(t, riskWithFixes, riskDefault)
+}
+
+func TestComputeScoreUnstableReasoning(t *testing.T) {
+	unstable := &schema.FileResult{
+		Path:             "unstable.go",
+		Commits:          20,
+		Churn:            400,
+		LinesOfCode:      800,
+		LinesAdded:       200,
+		SizeBytes:        20 * 1024,
+		Reverts:          3,
+		RevertWindowDays: 45,
+		ReworkRatio:      0.4,
+		ReworkWindowDays: 21,
+	}
+	ComputeScore(unstable, schema.HotMode, getWeightsForMode(schema.HotMode, nil), 0.1, 0.4)
+	assert.Contains(t, unstable.Reasoning, "Unstable: reverted 3 times in 45 days.")
+	assert.Contains(t, unstable.Reasoning, "Rework: 40% of added lines were rewritten within 21 days.")
+
+	// Small files and risk mode do not report instability
+	small := &schema.FileResult{Path: "small.go", Commits: 5, SizeBytes: 1024, LinesAdded: 10, Reverts: 1, ReworkRatio: 0.9, ReworkWindowDays: 21}
+	ComputeScore(small, schema.HotMode, getWeightsForMode(schema.HotMode, nil), 0.1, 0.4)
+	assert.NotContains(t, strings.Join(small.Reasoning, " "), "Unstable")
+	assert.NotContains(t, strings.Join(small.Reasoning, " "), "Rework")
+
+	ComputeScore(unstable, schema.RiskMode, getWeightsForMode(schema.RiskMode, nil), 0.1, 0.4)
+	assert.NotContains(t, strings.Join(unstable.Reasoning, " "), "Unstable")
+}
+
+func TestComputeScoreChangeEntropy(t *testing.T) {
+	scattered := &schema.FileResult{Path: "scattered.go", Commits: 20, Churn: 400, SizeBytes: 20 * 1024, ChangeEntropy: 1.5}
+	focused := &schema.FileResult{Path: "focused.go", Commits: 20, Churn: 400, SizeBytes: 20 * 1024}
+
+	// Entropy is unweighted by default
+	weights := getWeightsForMode(schema.HotMode, nil)
+	assert.Equal(t, ComputeScore(focused, schema.HotMode, weights, 0.1, 0.4), ComputeScore(scattered, schema.HotMode, weights, 0.1, 0.4))
+
+	weights = getWeightsForMode(schema.HotMode, map[schema.ScoringMode]map[schema.BreakdownKey]float64{
+		schema.HotMode: {schema.BreakdownEntropy: 0.3, schema.BreakdownCommits: 0.35, schema.BreakdownChurn: 0.35},
+	})
+	scatteredScore := ComputeScore(scattered, schema.HotMode, weights, 0.1, 0.4)
+	assert.Greater(t, scatteredScore, ComputeScore(focused, schema.HotMode, weights, 0.1, 0.4))
+	assert.InDelta(t, 0.3*0.5*100, scattered.ModeBreakdown[schema.BreakdownEntropy], 1e-9)
+	assert.NotContains(t, strings.Join(scattered.Reasoning, " "), "Scattered Changes")
+
+	scattered.ChangeEntropy = 4.0
+	ComputeScore(scattered, schema.HotMode, weights, 0.1, 0.4)
+	assert.Contains(t, strings.Join(scattered.Reasoning, " "), "Scattered Changes: Change entropy of 4.00")
+	assert.Equal(t, 1.0, ExpressionVariables(scattered)["entropy_norm"])
+}
+
+func TestOwnership(t *testing.T) {
+	assert.Equal(t, OwnershipStats{}, Ownership(nil))
+
+	even := Ownership(map[string]schema.Metric{"alice": 5, "bob": 5})
+	assert.Equal(t, 0, even.MinorContributors)
+	assert.InDelta(t, 0.5, even.TopOwnerShare, 1e-9)
+	assert.InDelta(t, 1.0, even.Entropy, 1e-9)
+
+	// Three drive-by contributors each made one of 43 commits
+	dominated := Ownership(map[string]schema.Metric{"alice": 40, "bob": 1, "carol": 1, "dave": 1})
+	assert.Equal(t, 3, dominated.MinorContributors)
+	assert.InDelta(t, 40.0/43.0, dominated.TopOwnerShare, 1e-9)
+	assert.Greater(t, dominated.Entropy, 0.0)
+	assert.Less(t, dominated.Entropy, 1.0)
+}
+
+func TestComputeScoreOwnershipFragmentation(t *testing.T) {
+	fragmented := &schema.FileResult{
+		Path: "fragmented.go", Commits: 20, Churn: 400, SizeBytes: 20 * 1024, UniqueContributors: 6,
+		MinorContributors: 4, TopOwnerShare: 0.3, OwnershipEntropy: 2.0,
+	}
+	owned := &schema.FileResult{Path: "owned.go", Commits: 20, Churn: 400, SizeBytes: 20 * 1024, UniqueContributors: 6}
+
+	// Ownership fragmentation is unweighted by default
+	weights := getWeightsForMode(schema.RiskMode, nil)
+	assert.Equal(t, ComputeScore(owned, schema.RiskMode, weights, 0.1, 0.4), ComputeScore(fragmented, schema.RiskMode, weights, 0.1, 0.4))
+	assert.Contains(t, strings.Join(fragmented.Reasoning, " "), "Fragmented Ownership: 4 drive-by contributors each made under 5% of the commits, and the top owner made 30%.")
+	assert.NotContains(t, strings.Join(owned.Reasoning, " "), "Fragmented Ownership")
+
+	weights = getWeightsForMode(schema.RiskMode, map[schema.ScoringMode]map[schema.BreakdownKey]float64{
+		schema.RiskMode: {schema.BreakdownMinorContrib: 0.5, schema.BreakdownOwnershipEntropy: 0.5},
+	})
+	assert.Greater(t, ComputeScore(fragmented, schema.RiskMode, weights, 0.1, 0.4), ComputeScore(owned, schema.RiskMode, weights, 0.1, 0.4))
+	assert.InDelta(t, 0.5*0.4*100, fragmented.ModeBreakdown[schema.BreakdownMinorContrib], 1e-9)
+	assert.InDelta(t, 0.5*0.5*100, fragmented.ModeBreakdown[schema.BreakdownOwnershipEntropy], 1e-9)
+
+	vars := ExpressionVariables(fragmented)
+	assert.Equal(t, 0.3, vars["owner_share_norm"])
+	assert.Equal(t, 4.0, vars["minor_contributors"])
+}
+
+func TestComputeScoreTangledReasoning(t *testing.T) {
+	tangled := &schema.FileResult{Path: "tangled.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, MedianCommitFiles: 41, LargeCommitShare: 0.8}
+	weights := getWeightsForMode(schema.HotMode, nil)
+	ComputeScore(tangled, schema.HotMode, weights, 0.1, 0.4)
+	assert.Contains(t, tangled.Reasoning, "Tangled: usually changed alongside 40+ files.")
+	assert.Equal(t, 0.8, ExpressionVariables(tangled)["large_commit_share"])
+
+	// Focused changes and too little history are not called tangled
+	focused := &schema.FileResult{Path: "focused.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, MedianCommitFiles: 3}
+	ComputeScore(focused, schema.HotMode, weights, 0.1, 0.4)
+	assert.NotContains(t, strings.Join(focused.Reasoning, " "), "Tangled")
+
+	young := &schema.FileResult{Path: "young.go", Commits: 2, Churn: 200, SizeBytes: 20 * 1024, MedianCommitFiles: 41}
+	ComputeScore(young, schema.HotMode, weights, 0.1, 0.4)
+	assert.NotContains(t, strings.Join(young.Reasoning, " "), "Tangled")
+}
+
+func TestComputeScoreOffHours(t *testing.T) {
+	nocturnal := &schema.FileResult{Path: "nocturnal.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, OffHoursCommits: 6, OffHoursRatio: 0.6}
+	daytime := &schema.FileResult{Path: "daytime.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024}
+
+	// Off-hours work is unweighted by default
+	weights := getWeightsForMode(schema.RiskMode, nil)
+	assert.Equal(t, ComputeScore(daytime, schema.RiskMode, weights, 0.1, 0.4), ComputeScore(nocturnal, schema.RiskMode, weights, 0.1, 0.4))
+	assert.NotContains(t, strings.Join(nocturnal.Reasoning, " "), "After Hours")
+
+	weights = getWeightsForMode(schema.DefectsMode, map[schema.ScoringMode]map[schema.BreakdownKey]float64{
+		schema.DefectsMode: {schema.BreakdownOffHours: 0.5, schema.BreakdownFixes: 0.5},
+	})
+	assert.Greater(t, ComputeScore(nocturnal, schema.DefectsMode, weights, 0.1, 0.4), ComputeScore(daytime, schema.DefectsMode, weights, 0.1, 0.4))
+	assert.InDelta(t, 0.5*0.6*100, nocturnal.ModeBreakdown[schema.BreakdownOffHours], 1e-9)
+	assert.Contains(t, nocturnal.Reasoning, "After Hours: 60% of commits were made outside 08:00-20:00 local time.")
+
+	// The ratio is damped for files with only a few commits
+	young := &schema.FileResult{Path: "young.go", Commits: 2, Churn: 20, SizeBytes: 1024, OffHoursCommits: 2, OffHoursRatio: 1}
+	assert.InDelta(t, 0.4, ExpressionVariables(young)["off_hours_norm"], 1e-9)
+}
+
+func TestComputeScoreTestGap(t *testing.T) {
+	lagging := &schema.FileResult{Path: "lagging.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, TestStatus: schema.TestStatusPaired, TestCoChanges: 1, TestCoChangeRatio: 0.1}
+	tested := &schema.FileResult{Path: "tested.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, TestStatus: schema.TestStatusPaired, TestCoChanges: 8, TestCoChangeRatio: 0.8}
+	orphan := &schema.FileResult{Path: "orphan.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, TestStatus: schema.TestStatusOrphan}
+
+	// The test gap is unweighted by default, but lagging and missing tests are still called out
+	weights := getWeightsForMode(schema.ComplexityMode, nil)
+	assert.Equal(t, ComputeScore(tested, schema.ComplexityMode, weights, 0.1, 0.4), ComputeScore(lagging, schema.ComplexityMode, weights, 0.1, 0.4))
+	assert.Contains(t, lagging.Reasoning, "Tests Lag: only 10% of commits also changed its tests.")
+	assert.NotContains(t, strings.Join(tested.Reasoning, " "), "Tests Lag")
+	ComputeScore(orphan, schema.ComplexityMode, weights, 0.1, 0.4)
+	assert.Contains(t, orphan.Reasoning, "Untested: no test file pairs with this file.")
+
+	weights = getWeightsForMode(schema.RiskMode, map[schema.ScoringMode]map[schema.BreakdownKey]float64{
+		schema.RiskMode: {schema.BreakdownTestGap: 0.5, schema.BreakdownGini: 0.5},
+	})
+	ComputeScore(lagging, schema.RiskMode, weights, 0.1, 0.4)
+	ComputeScore(orphan, schema.RiskMode, weights, 0.1, 0.4)
+	assert.InDelta(t, 0.5*0.9*100, lagging.ModeBreakdown[schema.BreakdownTestGap], 1e-9)
+	assert.InDelta(t, 0.5*100, orphan.ModeBreakdown[schema.BreakdownTestGap], 1e-9)
+
+	// Files no pairing rule covers have no test gap
+	docs := &schema.FileResult{Path: "README.md", Commits: 10, Churn: 200, SizeBytes: 1024}
+	assert.Zero(t, ExpressionVariables(docs)["test_gap_norm"])
+}
+
+func TestComputeScoreIndent(t *testing.T) {
+	nested := &schema.FileResult{Path: "nested.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, LinesOfCode: 1000, SLOC: 800, IndentComplexity: 4000, MaxIndent: 9}
+	commented := &schema.FileResult{Path: "commented.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, LinesOfCode: 1000, SLOC: 200, IndentComplexity: 200, MaxIndent: 2}
+
+	// Indentation is unweighted by default, so both files score by raw LOC alike
+	weights := getWeightsForMode(schema.ComplexityMode, nil)
+	assert.Equal(t, ComputeScore(commented, schema.ComplexityMode, weights, 0.1, 0.4), ComputeScore(nested, schema.ComplexityMode, weights, 0.1, 0.4))
+
+	// Weighting indent in place of LOC separates dense, nested code from comments
+	weights = getWeightsForMode(schema.ROIMode, map[schema.ScoringMode]map[schema.BreakdownKey]float64{
+		schema.ROIMode: {schema.BreakdownIndent: 0.5, schema.BreakdownChurn: 0.5},
+	})
+	assert.Greater(t, ComputeScore(nested, schema.ROIMode, weights, 0.1, 0.4), ComputeScore(commented, schema.ROIMode, weights, 0.1, 0.4))
+	assert.InDelta(t, 0.5*4000/maxIndent*100, nested.ModeBreakdown[schema.BreakdownIndent], 1e-9)
+
+	weights = getWeightsForMode(schema.ComplexityMode, map[schema.ScoringMode]map[schema.BreakdownKey]float64{
+		schema.ComplexityMode: {schema.BreakdownIndent: 1},
+	})
+	nested.IndentComplexity = maxIndent
+	ComputeScore(nested, schema.ComplexityMode, weights, 0.1, 0.4)
+	assert.Contains(t, nested.Reasoning, "Deep Nesting: 800 lines of code reach 9 levels of indentation.")
+
+	vars := ExpressionVariables(nested)
+	assert.InDelta(t, 1.0, vars["indent_norm"], 1e-9)
+	assert.InDelta(t, 800.0, vars["sloc"], 1e-9)
+}
+
+func TestComputeScoreGoComplexity(t *testing.T) {
+	tangled := &schema.FileResult{Path: "tangled.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, LinesOfCode: 1000, Functions: 20, CyclomaticTotal: 250, CyclomaticMax: 40, CognitiveTotal: 400, CognitiveMax: 65}
+	flat := &schema.FileResult{Path: "flat.go", Commits: 10, Churn: 200, SizeBytes: 20 * 1024, LinesOfCode: 1000, Functions: 20, CyclomaticTotal: 25, CyclomaticMax: 3, CognitiveTotal: 10, CognitiveMax: 2}
+
+	// Complexity is unweighted by default, but complex functions are still called out
+	weights := getWeightsForMode(schema.ComplexityMode, nil)
+	assert.Equal(t, ComputeScore(flat, schema.ComplexityMode, weights, 0.1, 0.4), ComputeScore(tangled, schema.ComplexityMode, weights, 0.1, 0.4))
+	assert.Contains(t, tangled.Reasoning, "Complex Functions: the most complex of 20 functions has cyclomatic complexity 40 and cognitive complexity up to 65.")
+	assert.NotContains(t, strings.Join(flat.Reasoning, " "), "Complex Functions")
+
+	weights = getWeightsForMode(schema.DefectsMode, map[schema.ScoringMode]map[schema.BreakdownKey]float64{
+		schema.DefectsMode: {schema.BreakdownCyclomatic: 0.25, schema.BreakdownCognitive: 0.25, schema.BreakdownFixes: 0.5},
+	})
+	assert.Greater(t, ComputeScore(tangled, schema.DefectsMode, weights, 0.1, 0.4), ComputeScore(flat, schema.DefectsMode, weights, 0.1, 0.4))
+	assert.InDelta(t, 0.25*250/maxCyclomatic*100, tangled.ModeBreakdown[schema.BreakdownCyclomatic], 1e-9)
+	assert.InDelta(t, 0.25*400/maxCognitive*100, tangled.ModeBreakdown[schema.BreakdownCognitive], 1e-9)
+
+	// Files in other languages have no function complexity
+	script := &schema.FileResult{Path: "run.py", Commits: 10, Churn: 200, SizeBytes: 1024}
+	vars := ExpressionVariables(script)
+	assert.Zero(t, vars["cyclomatic_norm"])
+	assert.Zero(t, vars["functions"])
+}
+
 // TestComputeScoreInvalidCustomWeights tests behavior with invalid custom weights.
 func TestComputeScoreInvalidCustomWeights(t *testing.T) {
 	metrics := schema.FileResult{
@@ -634,32 +887,56 @@ func TestComputeScore_EdgeCases(t *testing.T) {
 	}
 }
 
-func TestIsConfigurationFile(t *testing.T) {
+func TestApplyScoreModifiers_Defaults(t *testing.T) {
+	modifiers := schema.DefaultScoreModifiers()
+
 	tests := []struct {
-		ext      string
-		expected bool
+		path     string
+		mode     schema.ScoringMode
+		expected float64
+		applied  int
 	}{
-		{".yml", true},
-		{".yaml", true},
-		{".json", true},
-		{".xml", true},
-		{".lock", true},
-		{".sum", true},
-		{".md", true},
-		{".txt", true},
-		{".tfstate", true},
-		{".go", false},
-		{".rs", false},
-		{".java", false},
-		{".gradle", false},
-		{".csv", true},
-		{".ini", true},
-		{"", false},
+		{"core/engine.go", schema.HotMode, 80, 0},
+		{"core/engine_test.go", schema.HotMode, 40, 1},
+		{"core/engine_test.go", schema.RiskMode, 60, 1},
+		{"internal/mocks/client.go", schema.ROIMode, 40, 1},
+		{"core/engine_test.go", schema.DefectsMode, 40, 1},
+		{"core/engine.go", schema.DefectsMode, 80, 0},
+		{"config/settings.yml", schema.ComplexityMode, 40, 1},
+		{"config/settings.yml", schema.HotMode, 80, 0},
+		{"docs/example.md", schema.ComplexityMode, 20, 2},
+		{"go.sum", schema.ComplexityMode, 40, 1},
+		{"build.gradle", schema.ComplexityMode, 80, 0},
+		{"core/engine.go", schema.ActiveOwnersMode, 80, 0},
 	}
 
 	for _, tt := range tests {
-		t.Run(tt.ext, func(t *testing.T) {
-			assert.Equal(t, tt.expected, isConfigurationFile(tt.ext))
+		t.Run(tt.path+"/"+string(tt.mode), func(t *testing.T) {
+			score, applied := ApplyScoreModifiers(tt.path, tt.mode, 80, modifiers)
+			assert.InDelta(t, tt.expected, score, 1e-9)
+			assert.Len(t, applied, tt.applied)
 		})
 	}
 }
+
+func TestApplyScoreModifiers_CustomRules(t *testing.T) {
+	generated, err := schema.NewScoreModifier("generated_protos", "**/*.pb.go", "", nil, 0.1)
+	assert.NoError(t, err)
+	boost, err := schema.NewScoreModifier("payments", "", `^payments/`, []schema.ScoringMode{schema.RiskMode}, 1.5)
+	assert.NoError(t, err)
+	modifiers := []schema.ScoreModifier{generated, boost}
+
+	score, applied := ApplyScoreModifiers("contest/engine.go", schema.HotMode, 50, modifiers)
+	assert.Equal(t, 50.0, score)
+	assert.Empty(t, applied)
+
+	score, applied = ApplyScoreModifiers("api/v1/service.pb.go", schema.HotMode, 50, modifiers)
+	assert.InDelta(t, 5.0, score, 1e-9)
+	assert.Equal(t, []string{"generated_protos x0.10"}, applied)
+	assert.Equal(t, []string{"Score Modifier: generated_protos x0.10"}, ModifierReasoning(applied))
+
+	score, _ = ApplyScoreModifiers("payments/ledger.go", schema.RiskMode, 50, modifiers)
+	assert.InDelta(t, 75.0, score, 1e-9)
+	score, _ = ApplyScoreModifiers("payments/ledger.go", schema.HotMode, 50, modifiers)
+	assert.Equal(t, 50.0, score)
+}
@@ -230,6 +230,11 @@ func runFollowPass(
 			rankedFile := ranked[i]
 			followCtx := withUseFollow(ctx, true)
 			rean := analyzeFileCommon(followCtx, gitSettings, scoringSettings, client, rankedFile.Path, output)
+			if rankedFile.Normalization != nil {
+				// Keep the run's population scales so re-analyzed files rank consistently
+				rean.Normalization = rankedFile.Normalization
+				scoreFileResult(&rean, scoringSettings)
+			}
 			ranked[i] = rean
 		})
 	}
@@ -284,14 +289,50 @@ func analyzeRepo(
 		results = append(results, r)
 	}
 
+	// Rescore against scales derived from the whole population when requested
+	scales := applyNormalization(scoringSettings, results)
+
 	// 5. Record metrics and scores to database (if analysis tracking is enabled)
 	if analysisID, ok := getAnalysisID(ctx); ok && analysisID > 0 {
 		BatchRecordFileAnalysis(ctx, scoringSettings, analysisID, results)
+		RecordNormalizationScales(ctx, analysisID, scales)
 	}
 
 	return results
 }
 
+// resolveAnalysisRef returns the reference whose file contents an analysis reads. Runs at
+// a ref read that ref, and runs whose window --end pins read the last commit before it.
+// Otherwise it returns "" and files are read from the working tree.
+func resolveAnalysisRef(ctx context.Context, gitSettings config.GitSettings, client git.Client, targetRef string) string {
+	if targetRef != "" && targetRef != "HEAD" {
+		return targetRef
+	}
+	if !gitSettings.IsEndPinned() {
+		return ""
+	}
+	hash, err := client.GetCommitBefore(ctx, gitSettings.GetRepoPath(), "HEAD", gitSettings.GetEndTime())
+	if err != nil {
+		logger.Debug("Reading files from the working tree", "end", gitSettings.GetEndTime(), "error", err)
+		return ""
+	}
+	return hash
+}
+
+// applyNormalization derives the run's normalization scales from the analyzed files and
+// attaches them to every result. Files are rescored only when the strategy depends on the
+// population, since the fixed scales were already applied during the initial scoring.
+func applyNormalization(scoringSettings config.ScoringSettings, results []schema.FileResult) schema.NormalizationScales {
+	scales := algo.ComputeNormalizationScales(results, scoringSettings.GetNormalization())
+	for i := range results {
+		results[i].Normalization = &scales
+		if scales.Strategy != schema.FixedNormalization {
+			scoreFileResult(&results[i], scoringSettings)
+		}
+	}
+	return scales
+}
+
 // analyzeFileCommon computes all metrics for a single file in the repository.
 // It gathers Git history data (commits, authors, dates), file size, and calculates
 // derived metrics like churn and the Gini coefficient of author contributions.
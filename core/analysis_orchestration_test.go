@@ -24,6 +24,8 @@ func TestRunSingleAnalysisCore_Success(t *testing.T) {
 	mockClient.On("GetRemoteURL", mock.Anything, "/test/repo").Return("https://github.com/test/repo", nil).Maybe()
 	mockClient.On("ListFilesAtRef", mock.Anything, "/test/repo", "HEAD").Return([]string{"main.go", "core/agg.go"}, nil).Maybe()
 	mockClient.On("GetActivityLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte("--abc123|Alice|2024-01-01T00:00:00Z\n1\t0\tmain.go\n"), nil)
+	mockClient.On("GetRevertLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -65,6 +67,8 @@ func TestRunSingleAnalysisCore_NoFilesFound(t *testing.T) {
 	mockClient.On("GetRemoteURL", mock.Anything, "/test/repo").Return("https://github.com/test/repo", nil).Maybe()
 	mockClient.On("ListFilesAtRef", mock.Anything, "/test/repo", "HEAD").Return([]string{}, nil)
 	mockClient.On("GetActivityLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetRevertLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -140,7 +144,10 @@ func TestRunCompareAnalysisForRef(t *testing.T) {
 	mockClient.On("GetCommitTime", mock.Anything, "/test/repo", ref).Return(commitTime, nil)
 	// fileDiscoveryStage calls ListFilesAtRef(ref); aggregateActivity no longer calls HEAD since files are pre-populated
 	mockClient.On("ListFilesAtRef", mock.Anything, "/test/repo", ref).Return([]string{"main.go", "core/agg.go"}, nil)
+	mockClient.On("GetFileAtRef", mock.Anything, "/test/repo", ref, mock.AnythingOfType("string")).Return([]byte("package main\n"), nil)
 	mockClient.On("GetActivityLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte("--abc123|Alice|2024-06-01T00:00:00Z\n1\t0\tmain.go\n"), nil)
+	mockClient.On("GetRevertLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -211,7 +218,10 @@ func TestAnalyzeAllFilesAtRef(t *testing.T) {
 	mockClient.On("GetRemoteURL", mock.Anything, "/test/repo").Return("https://github.com/test/repo", nil).Maybe()
 	mockClient.On("ListFilesAtRef", mock.Anything, "/test/repo", ref).Return([]string{"main.go", "core/agg.go", "test_main.go"}, nil).Maybe()
 	mockClient.On("ListFilesAtRef", mock.Anything, "/test/repo", "HEAD").Return([]string{"main.go", "core/agg.go", "test_main.go"}, nil).Maybe()
+	mockClient.On("GetFileAtRef", mock.Anything, "/test/repo", ref, mock.AnythingOfType("string")).Return([]byte("package main\n"), nil)
 	mockClient.On("GetActivityLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte("--abc123|Alice|2024-01-01T00:00:00Z\n1\t0\tmain.go\n"), nil)
+	mockClient.On("GetRevertLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -256,6 +266,8 @@ func TestAnalyzeAllFilesAtRef_EmptyAfterFiltering(t *testing.T) {
 	mockClient.On("ListFilesAtRef", mock.Anything, "/test/repo", ref).Return([]string{"test_main.go", "test_utils.go"}, nil).Maybe()
 	mockClient.On("ListFilesAtRef", mock.Anything, "/test/repo", "HEAD").Return([]string{"test_main.go", "test_utils.go"}, nil).Maybe()
 	mockClient.On("GetActivityLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetRevertLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.Anything, "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -267,3 +267,36 @@ func TestRecordFileAnalysis(t *testing.T) {
 	// Verify mocks were called
 	mockCacheMgr.AssertExpectations(t)
 }
+
+func TestApplyNormalization(t *testing.T) {
+	newResults := func() []schema.FileResult {
+		return []schema.FileResult{
+			{Path: "a.go", SizeBytes: 2048, Commits: 4, Churn: 40, LinesOfCode: 80, UniqueContributors: 1, AgeDays: 30},
+			{Path: "b.go", SizeBytes: 4096, Commits: 8, Churn: 80, LinesOfCode: 160, UniqueContributors: 2, AgeDays: 60},
+		}
+	}
+
+	weights := map[schema.ScoringMode]map[schema.BreakdownKey]float64{schema.HotMode: schema.GetDefaultWeights(schema.HotMode)}
+	fixedSettings := config.ScoringConfig{Mode: schema.HotMode, ComputedWeights: weights, Normalization: schema.FixedNormalization}
+	fixed := newResults()
+	for i := range fixed {
+		scoreFileResult(&fixed[i], fixedSettings)
+	}
+	fixedScales := applyNormalization(fixedSettings, fixed)
+	assert.Equal(t, schema.FixedNormalization, fixedScales.Strategy)
+
+	percentileSettings := config.ScoringConfig{Mode: schema.HotMode, ComputedWeights: weights, Normalization: schema.PercentileNormalization}
+	rescored := newResults()
+	for i := range rescored {
+		scoreFileResult(&rescored[i], percentileSettings)
+	}
+	scales := applyNormalization(percentileSettings, rescored)
+	assert.Equal(t, schema.PercentileNormalization, scales.Strategy)
+	assert.Equal(t, 8.0, scales.Commits)
+
+	for i := range rescored {
+		assert.Same(t, rescored[i].Normalization, rescored[0].Normalization)
+		assert.Greater(t, rescored[i].ModeScore, fixed[i].ModeScore, "small repos spread out under percentile scales")
+	}
+	assert.Equal(t, scales, *schema.FileNormalization(rescored))
+}
@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/schema"
+)
+
+// GetHotspotArchCheckResults checks the change set between the base and target refs
+// against the allowed dependencies of the coupling modules. Component pairs that the
+// change set, or one of its commits, changed together without an allow rule are
+// violations. A violation is new when no commit changed both components within the
+// lookback window before the base ref, and the check fails when the new violations
+// exceed the tolerance.
+func GetHotspotArchCheckResults(ctx context.Context, cfg *config.Config, client git.Client) (*schema.ArchCheckResult, time.Duration, error) {
+	start := time.Now()
+	if !cfg.Compare.IsEnabled() {
+		return nil, 0, fmt.Errorf("arch check requires --base-ref and --target-ref flags. Example: hotspot arch check --base-ref main --target-ref feature")
+	}
+	components := cfg.BlastRadius.GetModules()
+	if components.Len() == 0 {
+		return nil, 0, fmt.Errorf("arch check requires components under coupling.modules in the config file")
+	}
+
+	repoPath := cfg.Git.GetRepoPath()
+	baseRef, targetRef := cfg.Compare.GetBaseRef(), cfg.Compare.GetTargetRef()
+	result := &schema.ArchCheckResult{
+		BaseRef:    baseRef,
+		TargetRef:  targetRef,
+		Lookback:   cfg.Compare.GetLookback(),
+		Components: components.Len(),
+		Violations: []schema.ArchViolation{},
+		Crossing:   []schema.ArchCommit{},
+		Tolerance:  cfg.Arch.GetTolerance(),
+	}
+	matcher := schema.NewPathMatcher(cfg.Git.GetExcludes())
+	componentsOf := func(paths []string) []string {
+		seen := make(map[string]bool)
+		for _, p := range paths {
+			for _, f := range renameCandidates(p) {
+				if c := components.ModuleOf(f); c != "" && !matcher.Match(f) {
+					seen[c] = true
+				}
+			}
+		}
+		names := make([]string, 0, len(seen))
+		for c := range seen {
+			names = append(names, c)
+		}
+		sort.Strings(names)
+		return names
+	}
+
+	// 1. The change set as a whole, as a squash merge would land it
+	changedFiles, err := client.GetChangedFilesBetweenRefs(ctx, repoPath, baseRef, targetRef)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get changed files between %q and %q: %w. Verify both refs exist in the repository", baseRef, targetRef, err)
+	}
+	result.ChangedFiles = len(filterChangedFiles(changedFiles, cfg.Git.GetExcludes()))
+	violations := make(map[[2]string]*schema.ArchViolation)
+	addViolations := func(pairs [][2]string) {
+		for _, pair := range pairs {
+			if violations[pair] == nil {
+				violations[pair] = &schema.ArchViolation{ComponentA: pair[0], ComponentB: pair[1], Commits: []string{}}
+			}
+		}
+	}
+	addViolations(disallowedPairs(components, componentsOf(changedFiles)))
+
+	// 2. The commits of the change set
+	out, err := client.GetRefActivityLog(ctx, repoPath, baseRef+".."+targetRef, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to read the commits between %q and %q: %w", baseRef, targetRef, err)
+	}
+	commits := parseActivityCommits(out)
+	result.Commits = len(commits)
+	for _, c := range commits {
+		names := componentsOf(c.paths)
+		pairs := disallowedPairs(components, names)
+		if len(pairs) == 0 {
+			continue
+		}
+		addViolations(pairs)
+		for _, pair := range pairs {
+			violations[pair].Commits = append(violations[pair].Commits, shortHash(c.hash))
+		}
+		result.Crossing = append(result.Crossing, schema.ArchCommit{
+			Hash:       shortHash(c.hash),
+			Author:     c.author,
+			Subject:    c.subject,
+			Components: names,
+		})
+	}
+
+	// 3. The baseline: how often each pair changed together before the base ref
+	if len(violations) > 0 {
+		_, baseTime, err := getAnalysisWindowForRef(ctx, client, repoPath, baseRef, result.Lookback)
+		if err != nil {
+			return nil, 0, err
+		}
+		out, err := client.GetRefActivityLog(ctx, repoPath, baseRef, baseTime.Add(-result.Lookback), baseTime)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to read the history of %q: %w", baseRef, err)
+		}
+		for _, c := range parseActivityCommits(out) {
+			for _, pair := range disallowedPairs(components, componentsOf(c.paths)) {
+				if v := violations[pair]; v != nil {
+					v.Baseline++
+				}
+			}
+		}
+	}
+
+	for _, v := range violations {
+		v.New = v.Baseline == 0
+		if v.New {
+			result.NewViolations++
+		}
+		result.Violations = append(result.Violations, *v)
+	}
+	sort.Slice(result.Violations, func(i, j int) bool {
+		a, b := result.Violations[i], result.Violations[j]
+		if a.New != b.New {
+			return a.New
+		}
+		if len(a.Commits) != len(b.Commits) {
+			return len(a.Commits) > len(b.Commits)
+		}
+		if a.ComponentA != b.ComponentA {
+			return a.ComponentA < b.ComponentA
+		}
+		return a.ComponentB < b.ComponentB
+	})
+	result.Passed = result.NewViolations <= result.Tolerance
+	return result, time.Since(start), nil
+}
+
+// disallowedPairs returns the pairs of sorted component names that no allow rule covers.
+func disallowedPairs(components *schema.CouplingModules, names []string) [][2]string {
+	var pairs [][2]string
+	for i := 0; i < len(names); i++ {
+		for j := i + 1; j < len(names); j++ {
+			if !components.Allows(names[i], names[j]) {
+				pairs = append(pairs, [2]string{names[i], names[j]})
+			}
+		}
+	}
+	return pairs
+}
+
+// shortHash abbreviates a commit hash for display.
+func shortHash(hash string) string {
+	return hash[:min(len(hash), 7)]
+}
+
+// printArchCheckResult prints the architecture check result in a concise format suitable for CI/CD.
+func printArchCheckResult(result *schema.ArchCheckResult, duration time.Duration) {
+	fmt.Fprintln(os.Stderr, "Architecture Check Results:")
+	fmt.Fprintf(os.Stderr, "  Base:       %s\n", result.BaseRef)
+	fmt.Fprintf(os.Stderr, "  Target:     %s\n", result.TargetRef)
+	fmt.Fprintf(os.Stderr, "  Lookback:   %v\n", result.Lookback)
+	fmt.Fprintf(os.Stderr, "  Tolerance:  %d new violation(s)\n", result.Tolerance)
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintf(os.Stderr, "Checked %d files and %d commits against %d components in %v\n\n", result.ChangedFiles, result.Commits, result.Components, duration)
+
+	if len(result.Violations) == 0 {
+		fmt.Fprintln(os.Stderr, "PASS: No change crosses a disallowed component boundary")
+		return
+	}
+	if result.Passed {
+		fmt.Fprintf(os.Stderr, "PASS: %d cross-boundary violation(s), %d new (tolerance: %d)\n\n", len(result.Violations), result.NewViolations, result.Tolerance)
+	} else {
+		fmt.Fprintf(os.Stderr, "FAIL: %d new cross-boundary violation(s) exceed the tolerance of %d\n\n", result.NewViolations, result.Tolerance)
+	}
+
+	_, _ = fmt.Fprintln(os.Stdout, "Violations:")
+	for _, v := range result.Violations {
+		status := fmt.Sprintf("existing, %d baseline commits", v.Baseline)
+		if v.New {
+			status = "new"
+		}
+		_, _ = fmt.Fprintf(os.Stdout, "  - %s <-> %s (%s)\n", v.ComponentA, v.ComponentB, status)
+		if len(v.Commits) > 0 {
+			_, _ = fmt.Fprintf(os.Stdout, "      commits: %s\n", joinLimited(v.Commits, 5))
+		}
+	}
+	if len(result.Crossing) > 0 {
+		_, _ = fmt.Fprintln(os.Stdout)
+		_, _ = fmt.Fprintln(os.Stdout, "Crossing commits:")
+		for _, c := range result.Crossing {
+			_, _ = fmt.Fprintf(os.Stdout, "  - %s %s (%s): %s\n", c.Hash, c.Subject, c.Author, strings.Join(c.Components, ", "))
+		}
+	}
+	_, _ = fmt.Fprintln(os.Stdout)
+}
+
+// joinLimited joins up to limit values, noting how many more there are.
+func joinLimited(values []string, limit int) string {
+	shown := strings.Join(values[:min(len(values), limit)], ", ")
+	if rest := len(values) - limit; rest > 0 {
+		shown += fmt.Sprintf(" (+%d more)", rest)
+	}
+	return shown
+}
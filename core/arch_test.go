@@ -0,0 +1,87 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHotspotArchCheckResults(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+	baseTime := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	components, err := schema.NewCouplingModules([]schema.CouplingModule{
+		{Name: "api", Paths: []string{"services/api/**"}, Allow: []string{"shared"}},
+		{Name: "billing", Paths: []string{"services/billing/**"}, Allow: []string{"shared"}},
+		{Name: "web", Paths: []string{"web/**"}},
+		{Name: "shared", Paths: []string{"libs/**"}, Allow: []string{"web"}},
+	})
+	require.NoError(t, err)
+
+	// The change set touches every component; only its first commit crosses a boundary
+	changed := []string{"services/api/handler.go", "services/billing/invoice.go", "web/app.ts", "libs/util.go", "README.md"}
+	rangeLog := "--aaaaaaaaaa|Alice|2024-06-02T10:00:00Z|Charge from the API\n" +
+		"1\t1\tservices/api/handler.go\n1\t1\tservices/billing/invoice.go\n" +
+		"--bbbbbbbbbb|Bob|2024-06-03T10:00:00Z|Share a helper\n" +
+		"1\t1\tweb/app.ts\n1\t1\tlibs/util.go\n" +
+		"--cccccccccc|Bob|2024-06-04T10:00:00Z|Use the helper\n" +
+		"1\t1\tservices/api/handler.go\n1\t1\t{libs => libs/v2}/util.go\n"
+	// Before the base ref, api and web already changed together twice
+	baseLog := "--dddddddddd|Carol|2024-05-01T10:00:00Z|Rename a field\n" +
+		"1\t1\tservices/api/types.go\n1\t1\tweb/types.ts\n" +
+		"--eeeeeeeeee|Carol|2024-05-02T10:00:00Z|Rename it back\n" +
+		"1\t1\tservices/api/types.go\n1\t1\tweb/types.ts\n"
+
+	mockClient.On("GetChangedFilesBetweenRefs", ctx, repo, "main", "feature").Return(changed, nil)
+	mockClient.On("GetRefActivityLog", ctx, repo, "main..feature", time.Time{}, time.Time{}).Return([]byte(rangeLog), nil)
+	mockClient.On("GetCommitTime", ctx, repo, "main").Return(baseTime, nil)
+	mockClient.On("GetRefActivityLog", ctx, repo, "main", mock.Anything, baseTime).Return([]byte(baseLog), nil)
+
+	cfg := &config.Config{
+		Git:         config.GitConfig{RepoPath: repo, Excludes: []string{"*.md"}},
+		Compare:     config.CompareConfig{Enabled: true, BaseRef: "main", TargetRef: "feature", Lookback: 90 * 24 * time.Hour},
+		BlastRadius: config.BlastRadiusConfig{Modules: components},
+	}
+	result, _, err := GetHotspotArchCheckResults(ctx, cfg, mockClient)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, result.Components)
+	assert.Equal(t, 4, result.ChangedFiles) // README.md is excluded
+	assert.Equal(t, 3, result.Commits)
+	assert.Equal(t, []schema.ArchViolation{
+		{ComponentA: "api", ComponentB: "billing", Commits: []string{"aaaaaaa"}, New: true},
+		{ComponentA: "billing", ComponentB: "web", Commits: []string{}, New: true},
+		{ComponentA: "api", ComponentB: "web", Commits: []string{}, Baseline: 2},
+	}, result.Violations)
+	assert.Equal(t, []schema.ArchCommit{
+		{Hash: "aaaaaaa", Author: "Alice", Subject: "Charge from the API", Components: []string{"api", "billing"}},
+	}, result.Crossing)
+	assert.Equal(t, 2, result.NewViolations)
+	assert.False(t, result.Passed)
+
+	cfg.Arch.Tolerance = 2
+	result, _, err = GetHotspotArchCheckResults(ctx, cfg, mockClient)
+	require.NoError(t, err)
+	assert.True(t, result.Passed)
+}
+
+func TestGetHotspotArchCheckResultsRequirements(t *testing.T) {
+	ctx := context.Background()
+	_, _, err := GetHotspotArchCheckResults(ctx, &config.Config{}, &git.MockGitClient{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--base-ref")
+
+	cfg := &config.Config{Compare: config.CompareConfig{Enabled: true, BaseRef: "main", TargetRef: "HEAD"}}
+	_, _, err = GetHotspotArchCheckResults(ctx, cfg, &git.MockGitClient{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "coupling.modules")
+}
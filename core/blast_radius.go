@@ -2,6 +2,8 @@ package core
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 
@@ -10,8 +12,11 @@ import (
 	"github.com/huangsam/hotspot/schema"
 )
 
-// GetHotspotBlastRadiusResults identifies files that historically change together.
-// It uses Jaccard Index to measure coupling strength.
+// GetHotspotBlastRadiusResults identifies files, folders or modules that historically
+// change together. It uses Jaccard Index to measure coupling strength, and reports the
+// support and the confidence in both directions of every pair. When a path is
+// configured, it returns the impact set of that path instead, where the threshold
+// applies to the confidence of each association rule.
 func GetHotspotBlastRadiusResults(ctx context.Context, cfg *config.Config, client git.Client, limit int, threshold float64) (schema.BlastRadiusResult, error) {
 	if limit <= 0 {
 		limit = 10
@@ -19,143 +24,392 @@ func GetHotspotBlastRadiusResults(ctx context.Context, cfg *config.Config, clien
 	if threshold <= 0 {
 		threshold = 0.3
 	}
+	level := cfg.BlastRadius.Level
+	if level == "" {
+		level = schema.CouplingLevelFile
+	}
+	unitOf := couplingUnit(level, cfg.BlastRadius)
 
 	// 1. Get the list of currently existing files to filter out deleted ones
 	currentFiles, err := client.ListFilesAtRef(ctx, cfg.Git.RepoPath, "HEAD")
 	if err != nil {
 		return schema.BlastRadiusResult{}, err
 	}
-	fileExists := make(map[string]bool, len(currentFiles))
-	for _, f := range currentFiles {
-		fileExists[f] = true
-	}
 
-	// 2. Fetch activity log
-	out, err := client.GetActivityLog(ctx, cfg.Git.RepoPath, cfg.Git.PathFilter, cfg.Git.StartTime, cfg.Git.EndTime)
+	// 2. Batch the changed files by commit
+	commits, totalCommits, err := commitBatches(ctx, cfg, client, currentFiles)
 	if err != nil {
 		return schema.BlastRadiusResult{}, err
 	}
 
-	// 3. Parse log into commit batches
-	lines := strings.Split(string(out), "\n")
-	commits := make(map[string][]string)
-	var currentCommit string
-	totalCommits := 0
+	// 3. Calculate frequencies and co-occurrences
+	counts := countCoChanges(commits, unitOf, totalCommits)
+	unitCommits := counts.commits
+	pairCoChanges := counts.pairs
 
-	matcher := schema.NewPathMatcher(cfg.Git.Excludes)
+	if root := cfg.BlastRadius.Path; root != "" {
+		return impactResult(ctx, cfg, client, currentFiles, counts, level, unitOf, root, limit, threshold), nil
+	}
 
-	for _, l := range lines {
-		l = strings.Trim(l, " \t\r\n'")
-		if strings.HasPrefix(l, "--") {
-			parts := strings.SplitN(l[2:], "|", 2)
-			if len(parts) > 0 {
-				currentCommit = parts[0]
-				totalCommits++
+	// 4. Calculate Jaccard scores
+	type rawPair struct {
+		a, b     string
+		coChange int
+		score    float64
+		relation schema.CouplingRelation
+	}
+	var pairs []rawPair
+
+	for a, targets := range pairCoChanges {
+		for b, co := range targets {
+			// J(A, B) = Co(A, B) / (C(A) + C(B) - Co(A, B))
+			score := float64(co) / float64(unitCommits[a]+unitCommits[b]-co)
+			if score >= threshold {
+				pairs = append(pairs, rawPair{a: a, b: b, coChange: co, score: score})
 			}
-			continue
 		}
-		if l == "" || currentCommit == "" {
-			continue
+	}
+
+	// 5. Sort
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].score != pairs[j].score {
+			return pairs[i].score > pairs[j].score
 		}
+		if pairs[i].coChange != pairs[j].coChange {
+			return pairs[i].coChange > pairs[j].coChange
+		}
+		if pairs[i].a != pairs[j].a {
+			return pairs[i].a < pairs[j].a
+		}
+		return pairs[i].b < pairs[j].b
+	})
 
-		// File stats line
-		parts := strings.SplitN(l, "\t", 3)
-		if len(parts) < 3 {
+	// 6. Classify Go file pairs against the import graph, in rank order until the limit is
+	// shown; hidden pairs have no static dependency. The counts cover the shown pairs.
+	var graph *importGraph
+	if level == schema.CouplingLevelFile {
+		graph = newImportGraph(ctx, client, cfg.Git.RepoPath, currentFiles)
+	}
+	explained, hidden := 0, 0
+	shown := pairs[:0]
+	for _, p := range pairs {
+		if len(shown) == limit {
+			break
+		}
+		p.relation = graph.Relation(p.a, p.b)
+		if cfg.BlastRadius.HiddenOnly && p.relation != schema.RelationHidden {
 			continue
 		}
-		path := parts[2]
+		switch {
+		case p.relation == schema.RelationHidden:
+			hidden++
+		case p.relation.IsExplained():
+			explained++
+		}
+		shown = append(shown, p)
+	}
+	pairs = shown
 
-		// Handle renames and filter
-		cleanPaths := resolvePaths(path, fileExists, matcher)
-		commits[currentCommit] = append(commits[currentCommit], cleanPaths...)
+	// 7. Format result
+	result := schema.BlastRadiusResult{
+		Summary: schema.BlastRadiusSummary{
+			TotalCommits:   totalCommits,
+			TotalPairs:     len(pairs),
+			Threshold:      threshold,
+			ExplainedPairs: explained,
+			HiddenPairs:    hidden,
+			HiddenOnly:     cfg.BlastRadius.HiddenOnly,
+			Level:          level,
+		},
+		Pairs: make([]schema.BlastRadiusPair, 0, len(pairs)),
+	}
+	if level == schema.CouplingLevelFolder {
+		result.Summary.FolderDepth = couplingFolderDepth(cfg.BlastRadius)
 	}
 
-	// 4. Calculate frequencies and co-occurrences
-	fileCommits := make(map[string]int)              // How many commits file A appears in
-	pairCoChanges := make(map[string]map[string]int) // How many commits A and B appear together in
+	for _, p := range pairs {
+		result.Pairs = append(result.Pairs, schema.BlastRadiusPair{
+			Source:            p.a,
+			Target:            p.b,
+			Score:             p.score,
+			CoChange:          p.coChange,
+			Support:           float64(p.coChange) / float64(max(totalCommits, 1)),
+			Confidence:        float64(p.coChange) / float64(unitCommits[p.a]),
+			ReverseConfidence: float64(p.coChange) / float64(unitCommits[p.b]),
+			Relation:          p.relation,
+		})
+	}
+
+	return result, nil
+}
 
+// coChanges counts how often units change, alone and together.
+type coChanges struct {
+	commits map[string]int            // How many commits unit A appears in
+	pairs   map[string]map[string]int // How many commits A and B appear together in, keyed by the lesser unit
+	total   int                       // Commits analyzed
+}
+
+// countCoChanges maps the files of each commit to units and counts their co-occurrences.
+func countCoChanges(commits map[string][]string, unitOf func(string) string, total int) coChanges {
+	counts := coChanges{
+		commits: make(map[string]int),
+		pairs:   make(map[string]map[string]int),
+		total:   total,
+	}
 	for _, files := range commits {
-		// Dedup files in same commit (rare but possible with renames)
-		uniqueFiles := make(map[string]bool)
+		// Dedup units in same commit (renames, or several files of one folder)
+		uniqueUnits := make(map[string]bool)
 		for _, f := range files {
-			uniqueFiles[f] = true
+			if unit := unitOf(f); unit != "" {
+				uniqueUnits[unit] = true
+			}
 		}
 
-		fList := make([]string, 0, len(uniqueFiles))
-		for f := range uniqueFiles {
-			fileCommits[f]++
-			fList = append(fList, f)
+		uList := make([]string, 0, len(uniqueUnits))
+		for u := range uniqueUnits {
+			counts.commits[u]++
+			uList = append(uList, u)
 		}
 
 		// Count pairs
-		for i := 0; i < len(fList); i++ {
-			for j := i + 1; j < len(fList); j++ {
-				a, b := fList[i], fList[j]
+		for i := 0; i < len(uList); i++ {
+			for j := i + 1; j < len(uList); j++ {
+				a, b := uList[i], uList[j]
 				if a > b {
 					a, b = b, a
 				}
-				if pairCoChanges[a] == nil {
-					pairCoChanges[a] = make(map[string]int)
+				if counts.pairs[a] == nil {
+					counts.pairs[a] = make(map[string]int)
 				}
-				pairCoChanges[a][b]++
+				counts.pairs[a][b]++
 			}
 		}
 	}
+	return counts
+}
 
-	// 5. Calculate Jaccard scores
-	type rawPair struct {
-		a, b     string
-		coChange int
-		score    float64
+// partners returns the units that changed together with unit and how often.
+func (c coChanges) partners(unit string) map[string]int {
+	partners := maps.Clone(c.pairs[unit])
+	if partners == nil {
+		partners = make(map[string]int)
 	}
-	var pairs []rawPair
+	for a, targets := range c.pairs {
+		if co, ok := targets[unit]; ok {
+			partners[a] = co
+		}
+	}
+	return partners
+}
 
-	for a, targets := range pairCoChanges {
-		for b, co := range targets {
-			// J(A, B) = Co(A, B) / (C(A) + C(B) - Co(A, B))
-			score := float64(co) / float64(fileCommits[a]+fileCommits[b]-co)
-			if score >= threshold {
-				pairs = append(pairs, rawPair{a, b, co, score})
+// impactResult builds the impact set of root: the units whose association rule from root
+// has a confidence of at least minConfidence, then the units reached the same way from
+// those, up to the configured depth. Each unit is reported at the fewest rules from root,
+// through the chain whose confidences have the highest product.
+func impactResult(ctx context.Context, cfg *config.Config, client git.Client, currentFiles []string, counts coChanges, level schema.CouplingLevel, unitOf func(string) string, root string, limit int, minConfidence float64) schema.BlastRadiusResult {
+	// A file at the folder or module level stands for its unit
+	if _, ok := counts.commits[root]; !ok {
+		if unit := unitOf(root); unit != "" {
+			root = unit
+		}
+	}
+	depth := cfg.BlastRadius.GetImpactDepth()
+	if depth <= 0 {
+		depth = schema.DefaultImpactDepth
+	}
+
+	reached := map[string]float64{root: 1}
+	frontier := []string{root}
+	var impact []schema.BlastRadiusImpact
+	for d := 1; d <= depth && len(frontier) > 0; d++ {
+		next := make(map[string]schema.BlastRadiusImpact)
+		for _, via := range frontier {
+			for unit, co := range counts.partners(via) {
+				if _, ok := reached[unit]; ok {
+					continue
+				}
+				confidence := float64(co) / float64(counts.commits[via])
+				if confidence < minConfidence {
+					continue
+				}
+				candidate := schema.BlastRadiusImpact{
+					Path:       unit,
+					Depth:      d,
+					Via:        via,
+					CoChange:   co,
+					Support:    float64(co) / float64(max(counts.total, 1)),
+					Confidence: confidence,
+					Lift:       confidence * float64(counts.total) / float64(counts.commits[unit]),
+					Impact:     reached[via] * confidence,
+				}
+				if prev, ok := next[unit]; !ok || candidate.Impact > prev.Impact || (candidate.Impact == prev.Impact && candidate.Via < prev.Via) {
+					next[unit] = candidate
+				}
 			}
 		}
+		frontier = frontier[:0]
+		for unit, entry := range next {
+			reached[unit] = entry.Impact
+			frontier = append(frontier, unit)
+			impact = append(impact, entry)
+		}
+		sort.Strings(frontier)
 	}
 
-	// 6. Sort and limit
-	sort.Slice(pairs, func(i, j int) bool {
-		if pairs[i].score != pairs[j].score {
-			return pairs[i].score > pairs[j].score
+	// Classify Go files against the import graph of the root file
+	explained, hidden := 0, 0
+	if level == schema.CouplingLevelFile && len(impact) > 0 {
+		graph := newImportGraph(ctx, client, cfg.Git.RepoPath, currentFiles)
+		for i := range impact {
+			impact[i].Relation = graph.Relation(root, impact[i].Path)
+			switch {
+			case impact[i].Relation == schema.RelationHidden:
+				hidden++
+			case impact[i].Relation.IsExplained():
+				explained++
+			}
 		}
-		return pairs[i].coChange > pairs[j].coChange
-	})
+	}
+	if cfg.BlastRadius.HiddenOnly {
+		impact = slices.DeleteFunc(impact, func(i schema.BlastRadiusImpact) bool { return i.Relation != schema.RelationHidden })
+	}
 
-	if len(pairs) > limit {
-		pairs = pairs[:limit]
+	sort.Slice(impact, func(i, j int) bool {
+		a, b := impact[i], impact[j]
+		if a.Impact != b.Impact {
+			return a.Impact > b.Impact
+		}
+		if a.Depth != b.Depth {
+			return a.Depth < b.Depth
+		}
+		if a.CoChange != b.CoChange {
+			return a.CoChange > b.CoChange
+		}
+		return a.Path < b.Path
+	})
+	if len(impact) > limit {
+		impact = impact[:limit]
 	}
 
-	// 7. Format result
 	result := schema.BlastRadiusResult{
 		Summary: schema.BlastRadiusSummary{
-			TotalCommits: totalCommits,
-			TotalPairs:   len(pairs),
-			Threshold:    threshold,
+			TotalCommits:   counts.total,
+			Threshold:      minConfidence,
+			ExplainedPairs: explained,
+			HiddenPairs:    hidden,
+			HiddenOnly:     cfg.BlastRadius.HiddenOnly,
+			Level:          level,
+			Path:           root,
+			PathCommits:    counts.commits[root],
+			ImpactDepth:    depth,
 		},
-		Pairs: make([]schema.BlastRadiusPair, 0, len(pairs)),
+		Pairs:  []schema.BlastRadiusPair{},
+		Impact: impact,
 	}
+	if level == schema.CouplingLevelFolder {
+		result.Summary.FolderDepth = couplingFolderDepth(cfg.BlastRadius)
+	}
+	return result
+}
 
-	for _, p := range pairs {
-		result.Pairs = append(result.Pairs, schema.BlastRadiusPair{
-			Source:   p.a,
-			Target:   p.b,
-			Score:    p.score,
-			CoChange: p.coChange,
-		})
+// commitBatches reads the activity log and returns the files each commit changed that
+// still exist and are not excluded, keyed by commit hash, along with the number of
+// commits in the log.
+func commitBatches(ctx context.Context, cfg *config.Config, client git.Client, currentFiles []string) (map[string][]string, int, error) {
+	fileExists := make(map[string]bool, len(currentFiles))
+	for _, f := range currentFiles {
+		fileExists[f] = true
 	}
 
-	return result, nil
+	out, err := client.GetActivityLog(ctx, cfg.Git.RepoPath, cfg.Git.PathFilter, cfg.Git.StartTime, cfg.Git.EndTime)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	logged := parseActivityCommits(out)
+	commits := make(map[string][]string)
+	matcher := schema.NewPathMatcher(cfg.Git.Excludes)
+	for _, c := range logged {
+		for _, path := range c.paths {
+			// Handle renames and filter
+			cleanPaths := resolvePaths(path, fileExists, matcher)
+			commits[c.hash] = append(commits[c.hash], cleanPaths...)
+		}
+	}
+	return commits, len(logged), nil
+}
+
+// activityCommit is a commit of an activity log with its raw numstat paths.
+type activityCommit struct {
+	hash, author, subject string
+	paths                 []string
+}
+
+// parseActivityCommits splits the output of GetActivityLog into commits.
+func parseActivityCommits(out []byte) []activityCommit {
+	var commits []activityCommit
+	for _, l := range strings.Split(string(out), "\n") {
+		l = strings.Trim(l, " \t\r\n'")
+		if strings.HasPrefix(l, "--") {
+			parts := strings.SplitN(l[2:], "|", 4)
+			c := activityCommit{hash: parts[0]}
+			if len(parts) == 4 {
+				c.author, c.subject = parts[1], parts[3]
+			}
+			commits = append(commits, c)
+			continue
+		}
+		if l == "" || len(commits) == 0 {
+			continue
+		}
+
+		// File stats line
+		parts := strings.SplitN(l, "\t", 3)
+		if len(parts) < 3 {
+			continue
+		}
+		last := &commits[len(commits)-1]
+		last.paths = append(last.paths, parts[2])
+	}
+	return commits
+}
+
+// couplingUnit returns the function that maps a file to its unit at a coupling level.
+// Files that belong to no unit, such as root files at the folder level, map to "".
+func couplingUnit(level schema.CouplingLevel, settings config.BlastRadiusConfig) func(string) string {
+	switch level {
+	case schema.CouplingLevelFolder:
+		depth := couplingFolderDepth(settings)
+		return func(path string) string { return schema.CouplingFolder(path, depth) }
+	case schema.CouplingLevelModule:
+		return settings.GetModules().ModuleOf
+	default:
+		return func(path string) string { return path }
+	}
+}
+
+// couplingFolderDepth returns the configured folder depth, or the default when unset.
+func couplingFolderDepth(settings config.BlastRadiusConfig) int {
+	if depth := settings.GetFolderDepth(); depth > 0 {
+		return depth
+	}
+	return schema.DefaultCouplingFolderDepth
 }
 
 // resolvePaths replicates the logic in core/agg/agg.go for consistency.
 func resolvePaths(path string, fileExists map[string]bool, matcher *schema.PathMatcher) []string {
+	var result []string
+	for _, c := range renameCandidates(path) {
+		if fileExists[c] && !matcher.Match(c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// renameCandidates expands a numstat path into its old and new paths when it is a rename.
+func renameCandidates(path string) []string {
 	var candidates []string
 	if !strings.Contains(path, " => ") {
 		candidates = append(candidates, path)
@@ -180,12 +434,5 @@ func resolvePaths(path string, fileExists map[string]bool, matcher *schema.PathM
 			}
 		}
 	}
-
-	var result []string
-	for _, c := range candidates {
-		if fileExists[c] && !matcher.Match(c) {
-			result = append(result, c)
-		}
-	}
-	return result
+	return candidates
 }
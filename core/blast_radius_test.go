@@ -6,8 +6,10 @@ import (
 
 	"github.com/huangsam/hotspot/internal/config"
 	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetHotspotBlastRadiusResults(t *testing.T) {
@@ -108,3 +110,187 @@ func TestJaccardWithHigherCoupling(t *testing.T) {
 	assert.Len(t, result.Pairs, 1)
 	assert.InEpsilon(t, 0.6666, result.Pairs[0].Score, 0.001)
 }
+
+func TestBlastRadiusImportGraph(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+
+	// Every commit changes the same four Go files and the README, so every pair is coupled
+	gitLog := ""
+	for _, hash := range []string{"hash1", "hash2"} {
+		gitLog += "--" + hash + "|author|2024-01-01 10:00:00 +0000\n" +
+			"1\t1\tapi/handler.go\n1\t1\tapi/routes.go\n1\t1\tstore/store.go\n1\t1\tbilling/invoice.go\n1\t1\tREADME.md\n"
+	}
+	files := []string{"go.mod", "README.md", "api/handler.go", "api/routes.go", "store/store.go", "billing/invoice.go", "vendor/x/go.mod"}
+
+	mockClient.On("GetActivityLog", ctx, repo, mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return([]byte(gitLog), nil)
+	mockClient.On("ListFilesAtRef", ctx, repo, "HEAD").Return(files, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "go.mod").Return([]byte("module example.com/shop\n"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "api/handler.go").Return([]byte("package api\n\nimport \"example.com/shop/store\"\n"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "api/routes.go").Return([]byte("package api\n\nimport \"net/http\"\n"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "store/store.go").Return([]byte("package store\n"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "billing/invoice.go").Return([]byte("package billing\n"), nil)
+
+	cfg := &config.Config{Git: config.GitConfig{RepoPath: repo}}
+	result, err := GetHotspotBlastRadiusResults(ctx, cfg, mockClient, 20, 0.3)
+	require.NoError(t, err)
+
+	relations := make(map[string]schema.CouplingRelation)
+	for _, p := range result.Pairs {
+		relations[p.Source+" "+p.Target] = p.Relation
+	}
+	assert.Equal(t, schema.RelationSamePackage, relations["api/handler.go api/routes.go"])
+	assert.Equal(t, schema.RelationImport, relations["api/handler.go store/store.go"])
+	assert.Equal(t, schema.RelationImport, relations["api/routes.go store/store.go"]) // Package-level import
+	assert.Equal(t, schema.RelationHidden, relations["billing/invoice.go store/store.go"])
+	assert.Equal(t, schema.CouplingRelation(""), relations["README.md api/handler.go"])
+	assert.Equal(t, 3, result.Summary.ExplainedPairs)
+	assert.Equal(t, 3, result.Summary.HiddenPairs)
+
+	// The counts cover only the pairs within the limit
+	limited, err := GetHotspotBlastRadiusResults(ctx, cfg, mockClient, 2, 0.3)
+	require.NoError(t, err)
+	assert.Equal(t, 2, limited.Summary.TotalPairs)
+	assert.LessOrEqual(t, limited.Summary.ExplainedPairs+limited.Summary.HiddenPairs, 2)
+
+	// Ranking only hidden pairs drops the explained and non-Go pairs
+	cfg.BlastRadius.HiddenOnly = true
+	result, err = GetHotspotBlastRadiusResults(ctx, cfg, mockClient, 20, 0.3)
+	require.NoError(t, err)
+	require.Len(t, result.Pairs, 3)
+	for _, p := range result.Pairs {
+		assert.Equal(t, schema.RelationHidden, p.Relation)
+		assert.Contains(t, p.Source+" "+p.Target, "billing/invoice.go")
+	}
+	assert.True(t, result.Summary.HiddenOnly)
+}
+
+func TestBlastRadiusFolderAndModuleLevels(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+
+	// api and billing change together twice, api alone once and the root README once
+	gitLog := "--hash1|author|2024-01-01 10:00:00 +0000\n" +
+		"1\t1\tservices/api/handler.go\n1\t1\tservices/api/routes.go\n1\t1\tservices/billing/invoice.go\n" +
+		"--hash2|author|2024-01-02 10:00:00 +0000\n" +
+		"1\t1\tservices/api/handler.go\n1\t1\tlibs/billing/tax.go\n" +
+		"--hash3|author|2024-01-03 10:00:00 +0000\n" +
+		"1\t1\tservices/api/routes.go\n" +
+		"--hash4|author|2024-01-04 10:00:00 +0000\n" +
+		"1\t1\tREADME.md\n1\t1\tservices/api/handler.go\n"
+	files := []string{"README.md", "services/api/handler.go", "services/api/routes.go", "services/billing/invoice.go", "libs/billing/tax.go"}
+
+	mockClient.On("GetActivityLog", ctx, repo, mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return([]byte(gitLog), nil)
+	mockClient.On("ListFilesAtRef", ctx, repo, "HEAD").Return(files, nil)
+
+	// Folders two levels deep: services/api is in all 4 commits, services/billing in 1
+	cfg := &config.Config{Git: config.GitConfig{RepoPath: repo}}
+	cfg.BlastRadius.Level = schema.CouplingLevelFolder
+	cfg.BlastRadius.FolderDepth = 2
+	result, err := GetHotspotBlastRadiusResults(ctx, cfg, mockClient, 10, 0.2)
+	require.NoError(t, err)
+	assert.Equal(t, schema.CouplingLevelFolder, result.Summary.Level)
+	assert.Equal(t, 2, result.Summary.FolderDepth)
+
+	pairs := make(map[string]schema.BlastRadiusPair)
+	for _, p := range result.Pairs {
+		pairs[p.Source+" "+p.Target] = p
+		assert.Empty(t, p.Relation)
+	}
+	require.Len(t, pairs, 2) // The root README belongs to no folder
+	apiBilling := pairs["services/api services/billing"]
+	assert.Equal(t, 1, apiBilling.CoChange)
+	assert.InEpsilon(t, 0.25, apiBilling.Score, 0.0001)
+	assert.InEpsilon(t, 0.25, apiBilling.Support, 0.0001)
+	assert.InEpsilon(t, 0.25, apiBilling.Confidence, 0.0001)
+	assert.InEpsilon(t, 1.0, apiBilling.ReverseConfidence, 0.0001)
+
+	// Modules group both billing folders
+	modules, err := schema.NewCouplingModules([]schema.CouplingModule{
+		{Name: "api", Paths: []string{"services/api/**"}},
+		{Name: "billing", Paths: []string{"services/billing/**", "libs/billing/**"}},
+	})
+	require.NoError(t, err)
+	cfg.BlastRadius = config.BlastRadiusConfig{Level: schema.CouplingLevelModule, Modules: modules}
+	result, err = GetHotspotBlastRadiusResults(ctx, cfg, mockClient, 10, 0.2)
+	require.NoError(t, err)
+	require.Len(t, result.Pairs, 1)
+	pair := result.Pairs[0]
+	assert.Equal(t, "api", pair.Source)
+	assert.Equal(t, "billing", pair.Target)
+	assert.Equal(t, 2, pair.CoChange)
+	assert.InEpsilon(t, 0.5, pair.Score, 0.0001) // 2 / (4 + 2 - 2)
+	assert.InEpsilon(t, 0.5, pair.Confidence, 0.0001)
+	assert.InEpsilon(t, 1.0, pair.ReverseConfidence, 0.0001)
+}
+
+func TestBlastRadiusImpactSet(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+
+	// a.go changes 4 times: with b.go 3 times and with d.go once. b.go changes with c.go
+	// twice, and e.go changes alone.
+	gitLog := "--hash1|author|2024-01-01 10:00:00 +0000\n" +
+		"1\t1\ta.go\n1\t1\tb.go\n" +
+		"--hash2|author|2024-01-02 10:00:00 +0000\n" +
+		"1\t1\ta.go\n1\t1\tb.go\n" +
+		"--hash3|author|2024-01-03 10:00:00 +0000\n" +
+		"1\t1\ta.go\n1\t1\tb.go\n1\t1\tc.go\n" +
+		"--hash4|author|2024-01-04 10:00:00 +0000\n" +
+		"1\t1\ta.go\n1\t1\td.go\n" +
+		"--hash5|author|2024-01-05 10:00:00 +0000\n" +
+		"1\t1\tb.go\n1\t1\tc.go\n" +
+		"--hash6|author|2024-01-06 10:00:00 +0000\n" +
+		"1\t1\te.go\n"
+	files := []string{"a.go", "b.go", "c.go", "d.go", "e.go"}
+
+	mockClient.On("GetActivityLog", ctx, repo, mock.AnythingOfType("string"), mock.Anything, mock.Anything).Return([]byte(gitLog), nil)
+	mockClient.On("ListFilesAtRef", ctx, repo, "HEAD").Return(files, nil)
+
+	cfg := &config.Config{Git: config.GitConfig{RepoPath: repo}}
+	cfg.BlastRadius.Path = "a.go"
+	result, err := GetHotspotBlastRadiusResults(ctx, cfg, mockClient, 10, 0.3)
+	require.NoError(t, err)
+	assert.Empty(t, result.Pairs)
+	assert.Equal(t, "a.go", result.Summary.Path)
+	assert.Equal(t, 4, result.Summary.PathCommits)
+	assert.Equal(t, schema.DefaultImpactDepth, result.Summary.ImpactDepth)
+
+	// d.go is below the confidence threshold (1/4), so only b.go and c.go through b.go remain
+	require.Len(t, result.Impact, 2)
+	b := result.Impact[0]
+	assert.Equal(t, "b.go", b.Path)
+	assert.Equal(t, 1, b.Depth)
+	assert.Equal(t, "a.go", b.Via)
+	assert.Equal(t, 3, b.CoChange)
+	assert.InEpsilon(t, 0.5, b.Support, 0.0001)     // 3 of 6 commits
+	assert.InEpsilon(t, 0.75, b.Confidence, 0.0001) // 3 of a.go's 4 commits
+	assert.InEpsilon(t, 1.125, b.Lift, 0.0001)      // 0.75 / (4 / 6)
+	assert.InEpsilon(t, 0.75, b.Impact, 0.0001)
+
+	c := result.Impact[1]
+	assert.Equal(t, "c.go", c.Path)
+	assert.Equal(t, 2, c.Depth)
+	assert.Equal(t, "b.go", c.Via)
+	assert.Equal(t, 2, c.CoChange)
+	assert.InEpsilon(t, 0.5, c.Confidence, 0.0001) // 2 of b.go's 4 commits
+	assert.InEpsilon(t, 1.5, c.Lift, 0.0001)       // 0.5 / (2 / 6)
+	assert.InEpsilon(t, 0.375, c.Impact, 0.0001)   // 0.75 * 0.5
+
+	// One step out, a lower threshold lets in c.go and d.go, which changed once with a.go
+	cfg.BlastRadius.ImpactDepth = 1
+	result, err = GetHotspotBlastRadiusResults(ctx, cfg, mockClient, 10, 0.2)
+	require.NoError(t, err)
+	require.Len(t, result.Impact, 3)
+	assert.Equal(t, "b.go", result.Impact[0].Path)
+	for _, entry := range result.Impact[1:] {
+		assert.Equal(t, 1, entry.Depth)
+		assert.Equal(t, "a.go", entry.Via)
+		assert.InEpsilon(t, 0.25, entry.Impact, 0.0001)
+	}
+	assert.Equal(t, "c.go", result.Impact[1].Path)
+	assert.Equal(t, "d.go", result.Impact[2].Path)
+}
@@ -23,6 +23,7 @@ type CheckResultBuilder struct {
 	filesToAnalyze  []string
 	cfgTarget       *config.Config
 	fileResults     []schema.FileResult
+	checkedModes    []schema.ScoringMode
 	maxScores       map[schema.ScoringMode]float64
 	failedFiles     []schema.CheckFailedFile
 	maxScoreFiles   map[schema.ScoringMode][]schema.CheckMaxScoreFile
@@ -121,7 +122,8 @@ func (b *CheckResultBuilder) RunAnalysis() (*CheckResultBuilder, error) {
 	}
 
 	// Analyze files once (all scores are computed upfront in FileResult)
-	b.fileResults = analyzeRepo(b.ctx, b.cfgTarget.Git, b.cfgTarget.Scoring, b.cfgTarget.Runtime, b.client, output, b.filesToAnalyze)
+	ctx := withAnalysisRef(b.ctx, resolveAnalysisRef(b.ctx, b.cfgTarget.Git, b.client, b.compareSettings.GetTargetRef()))
+	b.fileResults = analyzeRepo(ctx, b.cfgTarget.Git, b.cfgTarget.Scoring, b.cfgTarget.Runtime, b.client, output, b.filesToAnalyze)
 
 	return b, nil
 }
@@ -132,8 +134,9 @@ func (b *CheckResultBuilder) ComputeMetrics() *CheckResultBuilder {
 	b.maxScores = make(map[schema.ScoringMode]float64)
 	b.maxScoreFiles = make(map[schema.ScoringMode][]schema.CheckMaxScoreFile)
 	b.avgScores = make(map[schema.ScoringMode]float64)
+	b.checkedModes = schema.ScoringModes()
 
-	for _, mode := range schema.AllScoringModes {
+	for _, mode := range b.checkedModes {
 		maxScore := 0.0
 		var filesWithMax []schema.CheckMaxScoreFile
 		sumScore := 0.0
@@ -168,7 +171,7 @@ func (b *CheckResultBuilder) ComputeMetrics() *CheckResultBuilder {
 	b.failedFiles = []schema.CheckFailedFile{}
 	thresholds := b.scoringSettings.GetRiskThresholds()
 	for _, file := range b.fileResults {
-		for _, mode := range schema.AllScoringModes {
+		for _, mode := range b.checkedModes {
 			score := file.AllScores[mode]
 			threshold := thresholds[mode]
 			if score > threshold {
@@ -192,7 +195,7 @@ func (b *CheckResultBuilder) BuildResult() *CheckResultBuilder {
 		Passed:        len(b.failedFiles) == 0,
 		FailedFiles:   b.failedFiles,
 		TotalFiles:    len(b.filesToAnalyze),
-		CheckedModes:  schema.AllScoringModes,
+		CheckedModes:  b.checkedModes,
 		BaseRef:       b.compareSettings.GetBaseRef(),
 		TargetRef:     b.compareSettings.GetTargetRef(),
 		Thresholds:    b.scoringSettings.GetRiskThresholds(),
@@ -11,12 +11,17 @@ import (
 	"strings"
 	"time"
 
+	"github.com/huangsam/hotspot/core/agg"
 	"github.com/huangsam/hotspot/core/algo"
 	"github.com/huangsam/hotspot/internal/config"
 	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/internal/logger"
 	"github.com/huangsam/hotspot/schema"
 )
 
+// maxTicketPrefixes is the number of ticket prefixes reported per file.
+const maxTicketPrefixes = 3
+
 // FileResultBuilder builds the file metric from Git output.
 type FileResultBuilder struct {
 	gitSettings     config.GitSettings
@@ -45,7 +50,7 @@ func NewFileMetricsBuilder(
 		gitSettings:     gitSettings,
 		scoringSettings: scoringSettings,
 		git:             client,
-		result:          &schema.FileResult{Path: path, Mode: scoringSettings.GetMode()},
+		result:          &schema.FileResult{Path: path, Mode: scoringSettings.GetMode(), WorkPatterns: gitSettings.IsWorkPatterns()},
 		output:          output,
 		path:            path,
 		ctx:             ctx,
@@ -69,6 +74,19 @@ func (b *FileResultBuilder) FetchAllGitMetrics() *FileResultBuilder {
 			b.result.DecayedCommits = stat.DecayedCommits
 			b.result.DecayedChurn = stat.DecayedChurn
 			b.result.FirstCommit = stat.FirstCommit
+			b.result.FixCommits = stat.FixCommits
+			b.result.FixChurn = stat.FixChurn
+			b.result.FixRatio = stat.FixRatio()
+			b.result.Reverts = stat.Reverts
+			b.result.RevertWindowDays = revertWindowDays(stat.FirstRevert, b.output.EndTime)
+			b.result.ReworkLines = stat.ReworkLines
+			b.result.ReworkRatio = stat.ReworkRatio()
+			b.result.OffHoursCommits = stat.OffHoursCommits
+			b.result.OffHoursRatio = stat.OffHoursRatio()
+			b.result.WeekendCommits = stat.WeekendCommits
+			b.result.WeekendRatio = stat.WeekendRatio()
+			b.result.TicketCount = schema.Metric(len(stat.Tickets))
+			b.result.TopTicketPrefixes = schema.TopTicketPrefixes(stat.Tickets, maxTicketPrefixes)
 
 			if len(stat.Contributors) > 0 {
 				b.contribCount = make(map[string]schema.Metric)
@@ -93,23 +111,63 @@ func (b *FileResultBuilder) FetchAllGitMetrics() *FileResultBuilder {
 			totalAdd := schema.Metric(0)
 			totalDel := schema.Metric(0)
 			authorCommits := make(map[string]schema.Metric)
+			classifier := b.gitSettings.GetFixClassifier()
+			if classifier == nil {
+				classifier = schema.DefaultCommitClassifier()
+			}
+			isFix := false
+			extractor := b.gitSettings.GetTicketExtractor()
+			if extractor == nil {
+				extractor = schema.DefaultTicketExtractor()
+			}
+			tickets := make(map[string]schema.Metric)
+			rework := agg.NewReworkTracker(b.gitSettings.GetTemporalModel().ReworkWindowDays)
+			var commitDate, firstRevert time.Time
 
 			for _, line := range lines {
 				line = strings.Trim(line, " \t\r\n'")
 				if strings.HasPrefix(line, "DELIMITER_COMMIT_START") {
-					// Commit line: DELIMITER_COMMIT_STARTauthor|date
+					// Commit line: DELIMITER_COMMIT_STARTauthor|date|subject
 					metadata := line[len("DELIMITER_COMMIT_START"):]
-					parts := strings.SplitN(metadata, "|", 2)
-					if len(parts) == 2 {
+					parts := strings.SplitN(metadata, "|", 3)
+					isFix = len(parts) == 3 && classifier.IsFix(parts[2])
+					// Without commit hashes, follow mode only detects reverts by their subject
+					isRevert := len(parts) == 3 && schema.IsRevertSubject(parts[2])
+					commitDate = time.Time{}
+					// Likewise, tickets are only read from the subject
+					if len(parts) == 3 {
+						for _, ticket := range extractor.Extract(parts[2]) {
+							tickets[ticket]++
+						}
+					}
+					if len(parts) >= 2 {
 						author := strings.TrimSpace(parts[0])
 						dateStr := strings.TrimSpace(parts[1])
 						authorCommits[author]++
 						b.totalCommits++
+						if isFix {
+							b.result.FixCommits++
+						}
 						if date, err := time.Parse(time.RFC3339, dateStr); err == nil {
+							commitDate = date
+							if b.gitSettings.IsWorkPatterns() {
+								if schema.IsOffHours(date) {
+									b.result.OffHoursCommits++
+								}
+								if schema.IsWeekend(date) {
+									b.result.WeekendCommits++
+								}
+							}
 							if firstCommit.IsZero() || date.Before(firstCommit) {
 								firstCommit = date
 							}
 						}
+						if isRevert {
+							b.result.Reverts++
+							if !commitDate.IsZero() && (firstRevert.IsZero() || commitDate.Before(firstRevert)) {
+								firstRevert = commitDate
+							}
+						}
 					}
 				} else if parts := strings.Split(line, "\t"); len(parts) >= 3 {
 					// Numstat line
@@ -117,6 +175,10 @@ func (b *FileResultBuilder) FetchAllGitMetrics() *FileResultBuilder {
 						if del, errD := strconv.Atoi(strings.TrimSpace(parts[1])); errD == nil {
 							totalAdd += schema.Metric(add)
 							totalDel += schema.Metric(del)
+							if isFix {
+								b.result.FixChurn += schema.Metric(add + del)
+							}
+							b.result.ReworkLines += rework.Observe(b.path, commitDate, schema.Metric(add), schema.Metric(del))
 						}
 					}
 				}
@@ -129,6 +191,35 @@ func (b *FileResultBuilder) FetchAllGitMetrics() *FileResultBuilder {
 			b.result.LinesDeleted = schema.Metric(totalDel)
 			b.result.Churn = schema.Metric(totalAdd + totalDel)
 			b.result.FirstCommit = firstCommit
+			var endTime time.Time
+			if b.output != nil {
+				endTime = b.output.EndTime
+			}
+			b.result.RevertWindowDays = revertWindowDays(firstRevert, endTime)
+			if totalAdd > 0 {
+				b.result.ReworkRatio = min(1, b.result.ReworkLines.Float64()/totalAdd.Float64())
+			}
+			b.result.TicketCount = schema.Metric(len(tickets))
+			b.result.TopTicketPrefixes = schema.TopTicketPrefixes(tickets, maxTicketPrefixes)
+			if b.totalCommits > 0 {
+				b.result.FixRatio = b.result.FixCommits.Float64() / b.totalCommits.Float64()
+				b.result.OffHoursRatio = b.result.OffHoursCommits.Float64() / b.totalCommits.Float64()
+				b.result.WeekendRatio = b.result.WeekendCommits.Float64() / b.totalCommits.Float64()
+			}
+		}
+	}
+
+	// Change entropy, commit sizes and test co-changes depend on every file changed alongside
+	// this one, so follow mode also takes them from the repository-wide aggregation
+	if b.output != nil {
+		if stat, ok := b.output.FileStats[path]; ok {
+			b.result.ChangeEntropy = stat.ChangeEntropy
+			b.result.MedianCommitFiles = stat.MedianCommitFiles
+			b.result.MedianCommitChurn = stat.MedianCommitChurn
+			b.result.LargeCommitShare = stat.LargeCommitShare()
+			b.result.TestStatus = stat.TestStatus
+			b.result.TestCoChanges = stat.TestCoChanges
+			b.result.TestCoChangeRatio = stat.TestCoChangeRatio()
 		}
 	}
 
@@ -142,10 +233,23 @@ func (b *FileResultBuilder) FetchAllGitMetrics() *FileResultBuilder {
 	return b
 }
 
-// FetchFileStats reads the file to populate SizeBytes and LinesOfCode (PLOC).
+// revertWindowDays returns the number of days from the first revert to the end of the
+// analysis (at least 1), or 0 if the file was never reverted.
+func revertWindowDays(firstRevert, end time.Time) int {
+	if firstRevert.IsZero() {
+		return 0
+	}
+	if end.IsZero() {
+		end = time.Now()
+	}
+	return max(1, schema.CalculateDaysBetween(firstRevert, end))
+}
+
+// FetchFileStats reads the file to populate SizeBytes, LinesOfCode (PLOC), the
+// language-aware line counts and indentation complexity, and for Go files the
+// function complexity. Go files that do not parse keep zero complexity.
 func (b *FileResultBuilder) FetchFileStats() *FileResultBuilder {
-	fullPath := filepath.Join(b.gitSettings.GetRepoPath(), b.path)
-	content, err := os.ReadFile(fullPath)
+	content, err := b.readContent()
 	if err != nil {
 		return b
 	}
@@ -162,9 +266,39 @@ func (b *FileResultBuilder) FetchFileStats() *FileResultBuilder {
 	b.result.SizeBytes = size
 	b.result.LinesOfCode = schema.Metric(lines)
 
+	stats := schema.ClassifySource(b.path, content)
+	b.result.SLOC = schema.Metric(stats.SLOC)
+	b.result.CommentLines = schema.Metric(stats.CommentLines)
+	b.result.CommentRatio = stats.CommentRatio()
+	b.result.IndentComplexity = schema.Metric(stats.IndentTotal)
+	b.result.MaxIndent = schema.Metric(stats.IndentMax)
+
+	if algo.IsGoSource(b.path) {
+		functions, err := algo.GoFunctions(b.path, content)
+		if err != nil {
+			logger.Debug("Skipping complexity of unparsable Go file", "path", b.path, "error", err)
+			return b
+		}
+		complexity := schema.SummarizeComplexity(functions)
+		b.result.Functions = schema.Metric(complexity.Functions)
+		b.result.CyclomaticTotal = schema.Metric(complexity.CyclomaticTotal)
+		b.result.CyclomaticMax = schema.Metric(complexity.CyclomaticMax)
+		b.result.CognitiveTotal = schema.Metric(complexity.CognitiveTotal)
+		b.result.CognitiveMax = schema.Metric(complexity.CognitiveMax)
+	}
+
 	return b
 }
 
+// readContent reads the file at the analyzed ref, or from the working tree when the
+// analysis has no ref.
+func (b *FileResultBuilder) readContent() ([]byte, error) {
+	if ref := getAnalysisRef(b.ctx); ref != "" {
+		return b.git.GetFileAtRef(b.ctx, b.gitSettings.GetRepoPath(), ref, b.path)
+	}
+	return os.ReadFile(filepath.Join(b.gitSettings.GetRepoPath(), b.path))
+}
+
 // CalculateDerivedMetrics computes metrics that depend on previously collected data.
 func (b *FileResultBuilder) CalculateDerivedMetrics() *FileResultBuilder {
 	// AgeDays
@@ -186,12 +320,20 @@ func (b *FileResultBuilder) CalculateDerivedMetrics() *FileResultBuilder {
 	}
 	b.result.Gini = algo.Gini(values) // Assuming gini() is a helper function
 
+	// Ownership fragmentation: drive-by contributors, top owner share and entropy
+	ownership := algo.Ownership(b.contribCount)
+	b.result.MinorContributors = schema.Metric(ownership.MinorContributors)
+	b.result.TopOwnerShare = ownership.TopOwnerShare
+	b.result.OwnershipEntropy = ownership.Entropy
+	b.result.Contributors = b.contribCount
+
 	return b
 }
 
 // FetchRecentInfo populates recent metrics from recent info if available.
 func (b *FileResultBuilder) FetchRecentInfo() *FileResultBuilder {
-	b.result.RecentWindowDays = 30 // Fixed default window
+	b.result.RecentWindowDays = b.gitSettings.GetTemporalModel().RecentWindowDays
+	b.result.ReworkWindowDays = b.gitSettings.GetTemporalModel().ReworkWindowDays
 
 	if b.output == nil {
 		return b
@@ -248,59 +390,80 @@ func (b *FileResultBuilder) CalculateOwner() *FileResultBuilder {
 
 // CalculateScore computes the final composite score.
 func (b *FileResultBuilder) CalculateScore() *FileResultBuilder {
-	mode := b.scoringSettings.GetMode()
-	thresholdLow := b.scoringSettings.GetRecencyThresholdLow()
-	thresholdHigh := b.scoringSettings.GetRecencyThresholdHigh()
+	scoreFileResult(b.result, b.scoringSettings)
+	return b
+}
 
-	// Compute scores and breakdowns for all base modes first
-	b.result.AllScores = make(map[schema.ScoringMode]float64)
-	b.result.AllBreakdowns = make(map[schema.ScoringMode]map[schema.BreakdownKey]float64)
-	b.result.AllReasoning = make(map[schema.ScoringMode][]string)
+// scoreFileResult computes the scores, breakdowns and reasoning of every mode for a file
+// whose metrics are already populated, and selects the active mode. It can be called
+// again to rescore a file, for example once population-based normalization scales are known.
+func scoreFileResult(result *schema.FileResult, scoringSettings config.ScoringSettings) {
+	mode := scoringSettings.GetMode()
+	thresholdLow := scoringSettings.GetRecencyThresholdLow()
+	thresholdHigh := scoringSettings.GetRecencyThresholdHigh()
 
-	computedWeights := b.scoringSettings.GetComputedWeights()
-	for _, m := range []schema.ScoringMode{schema.HotMode, schema.RiskMode, schema.ComplexityMode, schema.ROIMode} {
-		mCopy := *b.result // Shallow copy of top-level fields
+	// Compute scores and breakdowns for all base modes first
+	result.AllScores = make(map[schema.ScoringMode]float64)
+	result.AllBreakdowns = make(map[schema.ScoringMode]map[schema.BreakdownKey]float64)
+	result.AllReasoning = make(map[schema.ScoringMode][]string)
+
+	computedWeights := scoringSettings.GetComputedWeights()
+	modifiers := scoringSettings.GetScoreModifiers()
+	if modifiers == nil {
+		modifiers = schema.DefaultScoreModifiers()
+	}
+	appliedModifiers := make(map[schema.ScoringMode][]string)
+	for _, m := range schema.BaseScoringModes {
+		mCopy := *result // Shallow copy of top-level fields
 		// Crucially re-initialize the breakdown map to avoid stomping on the original
 		mCopy.ModeBreakdown = make(map[schema.BreakdownKey]float64, 8)
 		mCopy.Mode = m
 		score := algo.ComputeScore(&mCopy, m, computedWeights[m], thresholdLow, thresholdHigh)
-		b.result.AllScores[m] = score
-		b.result.AllBreakdowns[m] = mCopy.ModeBreakdown
-		b.result.AllReasoning[m] = mCopy.Reasoning
+		score, applied := algo.ApplyScoreModifiers(mCopy.Path, m, score, modifiers)
+		appliedModifiers[m] = applied
+		result.AllScores[m] = score
+		result.AllBreakdowns[m] = mCopy.ModeBreakdown
+		result.AllReasoning[m] = append(mCopy.Reasoning, algo.ModifierReasoning(applied)...)
 		if m == schema.HotMode {
-			b.result.RecencySignal = mCopy.RecencySignal
-			b.result.RecencyThresholdLow = mCopy.RecencyThresholdLow
-			b.result.RecencyThresholdHigh = mCopy.RecencyThresholdHigh
+			result.RecencySignal = mCopy.RecencySignal
+			result.RecencyThresholdLow = mCopy.RecencyThresholdLow
+			result.RecencyThresholdHigh = mCopy.RecencyThresholdHigh
 		}
 	}
 
-	// Compute scores and reasoning for all composite modes
-	for _, m := range []schema.ScoringMode{schema.ActiveOwnersMode, schema.RefactorNowMode, schema.LegacyDebtMode} {
+	// Compute scores and reasoning for all composite modes (built-in and custom)
+	for _, m := range schema.CompositeScoringModes() {
 		compositeConfig := schema.GetCompositeConfig(m)
 		if compositeConfig != nil {
-			score, breakdown := algo.ComputeCompositeScore(b.result, compositeConfig)
-			b.result.AllScores[m] = score
-			b.result.AllBreakdowns[m] = breakdown
-			b.result.AllReasoning[m] = algo.GenerateCompositeReasoning(b.result, compositeConfig)
+			score, breakdown := algo.ComputeCompositeScore(result, compositeConfig)
+			result.AllScores[m] = score
+			result.AllBreakdowns[m] = breakdown
+			result.AllReasoning[m] = algo.GenerateCompositeReasoning(result, compositeConfig)
 		}
 	}
 
-	// Now set the active mode score, breakdown, and reasoning.
-	b.result.ModeScore = b.result.AllScores[mode]
-	if breakdown, ok := b.result.AllBreakdowns[mode]; ok {
-		b.result.ModeBreakdown = breakdown
-	} else {
-		b.result.ModeBreakdown = map[schema.BreakdownKey]float64{}
+	// Compute scores and reasoning for user-defined expression modes
+	for _, m := range schema.ExpressionScoringModes() {
+		score, breakdown := algo.ComputeExpressionScore(result, schema.GetExpressionModeConfig(m))
+		score, applied := algo.ApplyScoreModifiers(result.Path, m, score, modifiers)
+		appliedModifiers[m] = applied
+		result.AllScores[m] = score
+		result.AllBreakdowns[m] = breakdown
+		result.AllReasoning[m] = append(algo.ExpressionReasoning(m, breakdown), algo.ModifierReasoning(applied)...)
 	}
-	b.result.Reasoning = b.result.AllReasoning[mode]
 
-	b.result.Mode = mode
-	if schema.IsCompositeMode(mode) {
-		b.result.ModeType = "composite"
+	// Now set the active mode score, breakdown, and reasoning.
+	result.ModeScore = result.AllScores[mode]
+	if breakdown, ok := result.AllBreakdowns[mode]; ok {
+		result.ModeBreakdown = breakdown
 	} else {
-		b.result.ModeType = "base"
+		result.ModeBreakdown = map[schema.BreakdownKey]float64{}
 	}
-	return b
+	result.Reasoning = result.AllReasoning[mode]
+	result.Modifiers = appliedModifiers[mode]
+
+	result.Mode = mode
+	result.ModeType = schema.GetModeType(mode)
 }
 
 // Build finalizes the construction and returns the completed metrics object.
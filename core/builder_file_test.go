@@ -8,8 +8,10 @@ import (
 	"time"
 
 	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
 	"github.com/huangsam/hotspot/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestFileResultBuilder_BasicChaining(t *testing.T) {
@@ -178,3 +180,134 @@ func TestFileResultBuilder_CompositeModeUsesSelectedBreakdown(t *testing.T) {
 	assert.Equal(t, 0.1, result.RecencyThresholdLow)
 	assert.Equal(t, 0.3, result.RecencyThresholdHigh)
 }
+
+func TestFileResultBuilder_ExpressionModeScoresAndBreakdown(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() { _ = schema.RegisterExpressionModes(nil) })
+	assert.NoError(t, schema.RegisterExpressionModes(map[schema.ScoringMode]*schema.ExpressionModeConfig{
+		"churny_silos": {Expression: "0.5*churn_norm + 0.5*(1-contrib_norm)"},
+	}))
+
+	repoPath := t.TempDir()
+	err := os.WriteFile(filepath.Join(repoPath, "engine.go"), []byte("package main\n"), 0o644)
+	assert.NoError(t, err)
+
+	cfg := &config.Config{
+		Git:     config.GitConfig{RepoPath: repoPath},
+		Scoring: config.ScoringConfig{Mode: "churny_silos"},
+	}
+	output := &schema.AggregateOutput{
+		FileStats: map[string]*schema.FileAggregation{
+			"engine.go": {
+				Commits:      40,
+				Churn:        2500,
+				FirstCommit:  time.Now().Add(-90 * 24 * time.Hour),
+				Contributors: map[string]schema.Metric{"alice": 38, "bob": 2},
+			},
+		},
+	}
+
+	result := NewFileMetricsBuilder(ctx, cfg.Git, cfg.Scoring, nil, "engine.go", output).
+		FetchAllGitMetrics().
+		FetchFileStats().
+		CalculateDerivedMetrics().
+		FetchRecentInfo().
+		CalculateOwner().
+		CalculateScore().
+		Build()
+
+	assert.Equal(t, "expression", result.ModeType)
+	assert.InDelta(t, 70.0, result.ModeScore, 1e-9)
+	assert.InDelta(t, 25.0, result.ModeBreakdown["0.5*churn_norm"], 1e-9)
+	assert.InDelta(t, 45.0, result.ModeBreakdown["0.5*(1-contrib_norm)"], 1e-9)
+	assert.NotEmpty(t, result.Reasoning)
+	assert.Contains(t, result.AllScores, schema.HotMode)
+}
+
+func TestFileResultBuilder_ScoreModifiersInReasoning(t *testing.T) {
+	ctx := context.Background()
+	repoPath := t.TempDir()
+	err := os.WriteFile(filepath.Join(repoPath, "engine_test.go"), []byte("package main\n"), 0o644)
+	assert.NoError(t, err)
+
+	scoring := config.ScoringConfig{Mode: schema.HotMode}
+	output := &schema.AggregateOutput{
+		FileStats: map[string]*schema.FileAggregation{
+			"engine_test.go": {
+				Commits:      40,
+				Churn:        2500,
+				FirstCommit:  time.Now().Add(-90 * 24 * time.Hour),
+				Contributors: map[string]schema.Metric{"alice": 40},
+			},
+		},
+	}
+	build := func(scoring config.ScoringConfig) schema.FileResult {
+		return NewFileMetricsBuilder(ctx, config.GitConfig{RepoPath: repoPath}, scoring, nil, "engine_test.go", output).
+			FetchAllGitMetrics().
+			FetchFileStats().
+			CalculateDerivedMetrics().
+			FetchRecentInfo().
+			CalculateOwner().
+			CalculateScore().
+			Build()
+	}
+
+	// Without configured modifiers the default rules apply.
+	debuffed := build(scoring)
+	assert.Equal(t, []string{"test_and_generated x0.50"}, debuffed.Modifiers)
+	assert.Contains(t, debuffed.Reasoning, "Score Modifier: test_and_generated x0.50")
+
+	// An empty rule set disables them.
+	scoring.ScoreModifiers = []schema.ScoreModifier{}
+	plain := build(scoring)
+	assert.Empty(t, plain.Modifiers)
+	assert.InDelta(t, plain.ModeScore*0.5, debuffed.ModeScore, 1e-9)
+}
+
+func TestFileResultBuilder_FetchFileStatsAtRef(t *testing.T) {
+	ctx := context.Background()
+	repoPath := t.TempDir()
+	working := "package main\n\nfunc run() {}\n"
+	err := os.WriteFile(filepath.Join(repoPath, "engine.go"), []byte(working), 0o644)
+	assert.NoError(t, err)
+	atRef := "package main\n\nfunc run(a, b bool) {\n\tif a && b {\n\t\treturn\n\t}\n}\n\nfunc stop() {}\n"
+
+	mockClient := &git.MockGitClient{}
+	mockClient.On("GetFileAtRef", mock.Anything, repoPath, "feature", "engine.go").Return([]byte(atRef), nil)
+	mockClient.On("GetFileAtRef", mock.Anything, repoPath, "feature", "gone.go").Return(nil, os.ErrNotExist)
+	gitSettings := config.GitConfig{RepoPath: repoPath}
+	scoring := config.ScoringConfig{Mode: schema.ComplexityMode}
+
+	// Without a ref the working tree is read
+	local := NewFileMetricsBuilder(ctx, gitSettings, scoring, mockClient, "engine.go", nil).FetchFileStats().Build()
+	assert.Equal(t, schema.Metric(1), local.Functions)
+	assert.Equal(t, schema.Metric(1), local.CyclomaticMax)
+
+	// At a ref the blob of that ref is read instead
+	refCtx := withAnalysisRef(ctx, "feature")
+	result := NewFileMetricsBuilder(refCtx, gitSettings, scoring, mockClient, "engine.go", nil).FetchFileStats().Build()
+	assert.Equal(t, schema.Metric(2), result.Functions)
+	assert.Equal(t, schema.Metric(3), result.CyclomaticMax)
+	assert.Equal(t, int64(len(atRef)), result.SizeBytes)
+	assert.Equal(t, schema.Metric(9), result.LinesOfCode)
+
+	// Files missing at the ref keep zero stats
+	missing := NewFileMetricsBuilder(refCtx, gitSettings, scoring, mockClient, "gone.go", nil).FetchFileStats().Build()
+	assert.Zero(t, missing.SizeBytes)
+	mockClient.AssertExpectations(t)
+}
+
+func TestResolveAnalysisRef(t *testing.T) {
+	ctx := context.Background()
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockClient := &git.MockGitClient{}
+	mockClient.On("GetCommitBefore", ctx, "/test/repo", "HEAD", end).Return("abc123", nil)
+
+	unpinned := config.GitConfig{RepoPath: "/test/repo", EndTime: end}
+	pinned := config.GitConfig{RepoPath: "/test/repo", EndTime: end, EndPinned: true}
+
+	assert.Equal(t, "feature", resolveAnalysisRef(ctx, unpinned, mockClient, "feature"))
+	assert.Empty(t, resolveAnalysisRef(ctx, unpinned, mockClient, "HEAD"))
+	assert.Equal(t, "abc123", resolveAnalysisRef(ctx, pinned, mockClient, "HEAD"))
+	mockClient.AssertExpectations(t)
+}
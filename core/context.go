@@ -13,6 +13,7 @@ const (
 	suppressHeaderKey contextKey = "suppressHeader"
 	useFollowKey      contextKey = "useFollow"
 	analysisIDKey     contextKey = "analysisID"
+	analysisRefKey    contextKey = "analysisRef"
 )
 
 // WithSuppressHeader sets whether headers should be suppressed in the context.
@@ -60,6 +61,18 @@ func getAnalysisID(ctx context.Context) (int64, bool) {
 	return id, ok
 }
 
+// withAnalysisRef sets the Git reference whose file contents are analyzed in the context.
+func withAnalysisRef(ctx context.Context, ref string) context.Context {
+	return context.WithValue(ctx, analysisRefKey, ref)
+}
+
+// getAnalysisRef returns the Git reference whose file contents are analyzed, or "" to
+// read the working tree.
+func getAnalysisRef(ctx context.Context) string {
+	ref, _ := ctx.Value(analysisRefKey).(string)
+	return ref
+}
+
 // cacheManagerKey is the context key for the cache manager.
 type cacheManagerKeyType struct{}
 
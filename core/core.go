@@ -8,6 +8,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/huangsam/hotspot/core/agg"
 	"github.com/huangsam/hotspot/core/algo"
 
 	"github.com/huangsam/hotspot/internal"
@@ -43,7 +44,8 @@ func GetHotspotFilesResults(ctx context.Context, cfg *config.Config, client git.
 	resultsToRank := output.FileResults
 	if cfg.Git.Follow && len(resultsToRank) > 0 {
 		rankedForFollow := algo.RankFiles(resultsToRank, cfg.Output.ResultLimit)
-		resultsToRank = runFollowPass(ctx, cfg.Git, cfg.Scoring, cfg.Output, client, rankedForFollow, output.AggregateOutput)
+		followCtx := withAnalysisRef(ctx, resolveAnalysisRef(ctx, cfg.Git, client, "HEAD"))
+		resultsToRank = runFollowPass(followCtx, cfg.Git, cfg.Scoring, cfg.Output, client, rankedForFollow, output.AggregateOutput)
 	}
 	ranked := algo.RankFiles(resultsToRank, cfg.Output.ResultLimit)
 	return ranked, time.Since(start), nil
@@ -52,6 +54,15 @@ func GetHotspotFilesResults(ctx context.Context, cfg *config.Config, client git.
 // ExecuteHotspotFolders runs the folder-level analysis and prints results to stdout.
 // It serves as the main entry point for the 'folders' mode.
 func ExecuteHotspotFolders(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager, writer outwriter.FormatProvider) error {
+	if cfg.Folders.IsTree() {
+		tree, duration, err := GetHotspotFolderTreeResults(ctx, cfg, client, mgr)
+		if err != nil {
+			return err
+		}
+		return outwriter.WriteWithOutputFile(cfg.Output, func(w io.Writer) error {
+			return writer.WriteFolderTree(w, tree, cfg.Output, cfg.Runtime, duration)
+		}, "Wrote folder tree")
+	}
 	ranked, duration, err := GetHotspotFoldersResults(ctx, cfg, client, mgr)
 	if err != nil {
 		return err
@@ -62,16 +73,36 @@ func ExecuteHotspotFolders(ctx context.Context, cfg *config.Config, client git.C
 }
 
 // GetHotspotFoldersResults runs the folder-level analysis and returns the ranked results.
+// Hierarchical settings rank the rolled-up folders, limited to one depth when it is set.
 func GetHotspotFoldersResults(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager) ([]schema.FolderResult, time.Duration, error) {
 	start := time.Now()
 	output, err := runFolderAnalysisCore(ctx, cfg.Git, cfg.Scoring, cfg.Runtime, cfg.Output, cfg.Compare, client, mgr, nil)
 	if err != nil {
 		return nil, 0, err
 	}
-	ranked := algo.RankFolders(output.FolderResults, cfg.Output.ResultLimit)
+	folders := output.FolderResults
+	if cfg.Folders.IsHierarchical() {
+		folders = agg.AggregateFolderTree(cfg.Scoring, output.FileResults)
+		if depth := cfg.Folders.GetDepth(); depth > 0 {
+			folders = foldersAtDepth(folders, depth)
+		}
+	}
+	ranked := algo.RankFolders(folders, cfg.Output.ResultLimit)
 	return ranked, time.Since(start), nil
 }
 
+// GetHotspotFolderTreeResults runs the folder-level analysis and returns the hierarchical
+// roll-up of every folder from the repository root down.
+func GetHotspotFolderTreeResults(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager) (schema.FolderTreeNode, time.Duration, error) {
+	start := time.Now()
+	output, err := runFolderAnalysisCore(ctx, cfg.Git, cfg.Scoring, cfg.Runtime, cfg.Output, cfg.Compare, client, mgr, nil)
+	if err != nil {
+		return schema.FolderTreeNode{}, 0, err
+	}
+	tree := buildFolderTree(agg.AggregateFolderTree(cfg.Scoring, output.FileResults), cfg.Folders.GetDepth(), cfg.Output.ResultLimit)
+	return tree, time.Since(start), nil
+}
+
 // ExecuteHotspotCompare runs two file-level analyses (Base and Target)
 // based on Git references and computes the delta results.
 func ExecuteHotspotCompare(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager, writer outwriter.FormatProvider) error {
@@ -214,12 +245,22 @@ func GetHotspotTimeseriesResults(ctx context.Context, cfg *config.Config, client
 }
 
 // ExecuteHotspotBlastRadius runs the blast radius analysis and prints results to stdout.
-func ExecuteHotspotBlastRadius(ctx context.Context, cfg *config.Config, client git.Client, writer outwriter.FormatProvider) error {
+// With a graph format, it exports the coupling as a graph scored by a file analysis instead.
+func ExecuteHotspotBlastRadius(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager, writer outwriter.FormatProvider) error {
 	start := time.Now()
 	result, err := GetHotspotBlastRadiusResults(ctx, cfg, client, cfg.Output.ResultLimit, 0.3) // Default threshold
 	if err != nil {
 		return err
 	}
+	if format := cfg.BlastRadius.GetGraphFormat(); format != "" {
+		graph, err := GetCouplingGraph(ctx, cfg, client, mgr, result)
+		if err != nil {
+			return err
+		}
+		return outwriter.WriteWithOutputFile(cfg.Output, func(w io.Writer) error {
+			return outwriter.WriteCouplingGraph(w, graph, format)
+		}, "Wrote coupling graph")
+	}
 	duration := time.Since(start)
 
 	return outwriter.WriteWithOutputFile(cfg.Output, func(w io.Writer) error {
@@ -227,6 +268,59 @@ func ExecuteHotspotBlastRadius(ctx context.Context, cfg *config.Config, client g
 	}, "Wrote blast radius table")
 }
 
+// ExecuteHotspotTickets lists the tickets referenced by commits that touched the configured path.
+func ExecuteHotspotTickets(ctx context.Context, cfg *config.Config, client git.Client, writer outwriter.FormatProvider) error {
+	start := time.Now()
+	result, err := GetHotspotTicketsResults(ctx, cfg, client, cfg.Output.ResultLimit)
+	if err != nil {
+		return err
+	}
+	duration := time.Since(start)
+
+	return outwriter.WriteWithOutputFile(cfg.Output, func(w io.Writer) error {
+		return writer.WriteTickets(w, result, cfg.Output, cfg.Runtime, duration)
+	}, "Wrote tickets table")
+}
+
+// ExecuteHotspotDeps analyzes the history of the dependency manifests and prints results to stdout.
+func ExecuteHotspotDeps(ctx context.Context, cfg *config.Config, client git.Client, writer outwriter.FormatProvider) error {
+	start := time.Now()
+	result, err := GetHotspotDepsResults(ctx, cfg, client, cfg.Output.ResultLimit)
+	if err != nil {
+		return err
+	}
+	duration := time.Since(start)
+
+	return outwriter.WriteWithOutputFile(cfg.Output, func(w io.Writer) error {
+		return writer.WriteDeps(w, result, cfg.Output, cfg.Runtime, duration)
+	}, "Wrote dependencies table")
+}
+
+// ExecuteHotspotFunctions ranks the functions of Go files and prints results to stdout.
+func ExecuteHotspotFunctions(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager, writer outwriter.FormatProvider) error {
+	start := time.Now()
+	result, err := GetHotspotFunctionsResults(ctx, cfg, client, mgr)
+	if err != nil {
+		return err
+	}
+	duration := time.Since(start)
+
+	return outwriter.WriteWithOutputFile(cfg.Output, func(w io.Writer) error {
+		return writer.WriteFunctions(w, result, cfg.Output, cfg.Runtime, duration)
+	}, "Wrote functions table")
+}
+
+// ExecuteHotspotDocsDrift ranks documented folders by how stale their docs are and prints results to stdout.
+func ExecuteHotspotDocsDrift(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager, writer outwriter.FormatProvider) error {
+	result, duration, err := GetHotspotDocsDriftResults(ctx, cfg, client, mgr)
+	if err != nil {
+		return err
+	}
+	return outwriter.WriteWithOutputFile(cfg.Output, func(w io.Writer) error {
+		return writer.WriteDocDrift(w, result, cfg.Output, cfg.Runtime, duration)
+	}, "Wrote documentation drift table")
+}
+
 // ExecuteHotspotMetrics displays the formal definitions of all scoring modes.
 // This is a static display that does not require Git analysis.
 func ExecuteHotspotMetrics(_ context.Context, cfg *config.Config, _ git.Client, _ iocache.CacheManager, writer outwriter.FormatProvider) error {
@@ -255,6 +349,20 @@ func ExecuteHotspotCheck(ctx context.Context, cfg *config.Config, client git.Cli
 	return nil
 }
 
+// ExecuteHotspotArchCheck checks a change set against the allowed component dependencies
+// and fails when it adds more new cross-boundary coupling than the tolerance.
+func ExecuteHotspotArchCheck(ctx context.Context, cfg *config.Config, client git.Client) error {
+	result, duration, err := GetHotspotArchCheckResults(ctx, cfg, client)
+	if err != nil {
+		return err
+	}
+	printArchCheckResult(result, duration)
+	if !result.Passed {
+		return fmt.Errorf("%d new violation(s) found, tolerance is %d", result.NewViolations, result.Tolerance)
+	}
+	return nil
+}
+
 // GetHotspotCheckResults runs the policy check and returns the result object.
 func GetHotspotCheckResults(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager) (*schema.CheckResult, time.Duration, error) {
 	start := time.Now()
@@ -0,0 +1,114 @@
+package core
+
+import (
+	"context"
+	"path"
+
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/internal/iocache"
+	"github.com/huangsam/hotspot/schema"
+)
+
+// GetCouplingGraph turns a blast radius result into a coupling graph. Its nodes carry the
+// hotspot scores and owners from a file analysis of the same window.
+func GetCouplingGraph(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager, result schema.BlastRadiusResult) (schema.CouplingGraph, error) {
+	output, err := runSingleAnalysisCore(ctx, cfg.Git, cfg.Scoring, cfg.Runtime, cfg.Output, cfg.Compare, client, mgr, nil)
+	if err != nil {
+		return schema.CouplingGraph{}, err
+	}
+	level := result.Summary.Level
+	if level == "" {
+		level = schema.CouplingLevelFile
+	}
+	return buildCouplingGraph(result, output.FileResults, couplingUnit(level, cfg.BlastRadius), cfg.Scoring.GetMode()), nil
+}
+
+// buildCouplingGraph connects the pairs, or the impact set, of a blast radius result.
+// Nodes are scored with the top file result that unitOf maps to them.
+func buildCouplingGraph(result schema.BlastRadiusResult, files []schema.FileResult, unitOf func(string) string, mode schema.ScoringMode) schema.CouplingGraph {
+	level := result.Summary.Level
+	if level == "" {
+		level = schema.CouplingLevelFile
+	}
+	graph := schema.CouplingGraph{
+		Level: level,
+		Mode:  mode,
+		Nodes: []schema.CouplingGraphNode{},
+		Edges: []schema.CouplingGraphEdge{},
+	}
+
+	var ids []string
+	seen := make(map[string]bool)
+	addNode := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	if root := result.Summary.Path; root != "" {
+		// Impact rules point from the changed unit to what changes with it
+		graph.Directed = true
+		addNode(root)
+		for _, entry := range result.Impact {
+			edge := schema.CouplingGraphEdge{
+				Source:     entry.Via,
+				Target:     entry.Path,
+				Weight:     entry.Confidence,
+				CoChange:   entry.CoChange,
+				Support:    entry.Support,
+				Confidence: entry.Confidence,
+			}
+			// Relations are classified against the root, so they only describe its own edges
+			if entry.Via == root {
+				edge.Relation = entry.Relation
+			}
+			graph.Edges = append(graph.Edges, edge)
+			addNode(entry.Via)
+			addNode(entry.Path)
+		}
+	} else {
+		for _, pair := range result.Pairs {
+			graph.Edges = append(graph.Edges, schema.CouplingGraphEdge{
+				Source:     pair.Source,
+				Target:     pair.Target,
+				Weight:     pair.Score,
+				CoChange:   pair.CoChange,
+				Support:    pair.Support,
+				Confidence: pair.Confidence,
+				Relation:   pair.Relation,
+			})
+			addNode(pair.Source)
+			addNode(pair.Target)
+		}
+	}
+
+	// Score every unit with its top file
+	counts := make(map[string]int)
+	top := make(map[string]schema.FileResult)
+	for _, f := range files {
+		unit := unitOf(f.Path)
+		if !seen[unit] {
+			continue
+		}
+		counts[unit]++
+		if best, ok := top[unit]; !ok || f.ModeScore > best.ModeScore {
+			top[unit] = f
+		}
+	}
+
+	for _, id := range ids {
+		node := schema.CouplingGraphNode{ID: id, Files: counts[id]}
+		if level == schema.CouplingLevelFile {
+			node.Folder = path.Dir(id)
+		}
+		if best, ok := top[id]; ok {
+			node.Score = best.ModeScore
+			node.Label = schema.GetPlainLabel(best.ModeScore)
+			node.Owners = best.Owners
+		}
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	return graph
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCouplingGraph(t *testing.T) {
+	files := []schema.FileResult{
+		{Path: "api/handler.go", ModeScore: 82, Owners: []string{"Alice", "Bob"}},
+		{Path: "api/routes.go", ModeScore: 40, Owners: []string{"Bob"}},
+		{Path: "billing/invoice.go", ModeScore: 55, Owners: []string{"Carol"}},
+		{Path: "README.md", ModeScore: 90},
+	}
+
+	// Pairs make an undirected graph of their files
+	pairs := schema.BlastRadiusResult{
+		Summary: schema.BlastRadiusSummary{Level: schema.CouplingLevelFile},
+		Pairs: []schema.BlastRadiusPair{
+			{Source: "api/handler.go", Target: "billing/invoice.go", Score: 0.5, CoChange: 2, Support: 0.25, Confidence: 0.4, Relation: schema.RelationHidden},
+		},
+	}
+	graph := buildCouplingGraph(pairs, files, func(p string) string { return p }, schema.HotMode)
+	assert.False(t, graph.Directed)
+	assert.Equal(t, schema.HotMode, graph.Mode)
+	require.Len(t, graph.Nodes, 2)
+	assert.Equal(t, schema.CouplingGraphNode{ID: "api/handler.go", Folder: "api", Files: 1, Score: 82, Label: schema.CriticalValue, Owners: []string{"Alice", "Bob"}}, graph.Nodes[0])
+	assert.Equal(t, "billing/invoice.go", graph.Nodes[1].ID)
+	assert.Equal(t, []schema.CouplingGraphEdge{
+		{Source: "api/handler.go", Target: "billing/invoice.go", Weight: 0.5, CoChange: 2, Support: 0.25, Confidence: 0.4, Relation: schema.RelationHidden},
+	}, graph.Edges)
+
+	// An impact set points outward from its path, and folders take their top file's score
+	impact := schema.BlastRadiusResult{
+		Summary: schema.BlastRadiusSummary{Level: schema.CouplingLevelFolder, Path: "api"},
+		Impact: []schema.BlastRadiusImpact{
+			{Path: "billing", Depth: 1, Via: "api", CoChange: 2, Support: 0.25, Confidence: 0.5},
+			{Path: "docs", Depth: 2, Via: "billing", CoChange: 1, Support: 0.125, Confidence: 0.5},
+		},
+	}
+	graph = buildCouplingGraph(impact, files, func(p string) string { return schema.CouplingFolder(p, 1) }, schema.HotMode)
+	assert.True(t, graph.Directed)
+	require.Len(t, graph.Nodes, 3)
+	assert.Equal(t, schema.CouplingGraphNode{ID: "api", Files: 2, Score: 82, Label: schema.CriticalValue, Owners: []string{"Alice", "Bob"}}, graph.Nodes[0])
+	assert.Equal(t, "billing", graph.Nodes[1].ID)
+	assert.Equal(t, schema.CouplingGraphNode{ID: "docs"}, graph.Nodes[2]) // No scored files
+	require.Len(t, graph.Edges, 2)
+	assert.Equal(t, "api", graph.Edges[0].Source)
+	assert.Equal(t, "billing", graph.Edges[1].Source)
+	assert.Equal(t, "docs", graph.Edges[1].Target)
+	assert.InEpsilon(t, 0.5, graph.Edges[1].Weight, 0.0001)
+}
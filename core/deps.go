@@ -0,0 +1,281 @@
+package core
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/huangsam/hotspot/core/algo"
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/internal/logger"
+	"github.com/huangsam/hotspot/schema"
+)
+
+// manifestCommit is one commit that changed a manifest.
+type manifestCommit struct {
+	hash   string
+	author string
+	date   time.Time
+}
+
+// dependencyHistory accumulates the changes of one dependency.
+type dependencyHistory struct {
+	record    schema.DependencyRecord
+	manifests map[string]bool
+	commits   map[string]bool   // Commits that changed it, to count each commit once
+	authors   map[string]int    // Author -> commits that changed it
+	versions  map[string]string // Manifest -> version at HEAD
+}
+
+// GetHotspotDepsResults walks the history of the dependency manifests and lockfiles under
+// the configured path. It parses the dependency list of each manifest at every commit
+// that changed it and reports which dependencies change most often, who changes them,
+// when each was last changed and which manifests are knowledge silos. Excludes are not
+// applied, since the default excludes drop the very lockfiles this analysis reads.
+func GetHotspotDepsResults(ctx context.Context, cfg *config.Config, client git.Client, limit int) (schema.DepsResult, error) {
+	files, err := client.ListFilesAtRef(ctx, cfg.Git.RepoPath, "HEAD")
+	if err != nil {
+		return schema.DepsResult{}, err
+	}
+
+	deps := make(map[string]*dependencyHistory)
+	authors := make(map[string]*schema.DepsAuthor)
+	authorCommits := make(map[string]bool) // Hashes already counted toward their author
+	result := schema.DepsResult{
+		Summary:      schema.DepsSummary{Path: cfg.Git.PathFilter},
+		Dependencies: []schema.DependencyRecord{},
+		Manifests:    []schema.ManifestRecord{},
+		Authors:      []schema.DepsAuthor{},
+	}
+
+	for _, path := range files {
+		ecosystem, ok := schema.ManifestEcosystem(path)
+		if !ok || !schema.IsPathInFilter(path, cfg.Git.PathFilter) {
+			continue
+		}
+		out, err := client.GetActivityLog(ctx, cfg.Git.RepoPath, path, cfg.Git.StartTime, cfg.Git.EndTime)
+		if err != nil {
+			return schema.DepsResult{}, err
+		}
+		commits := parseManifestCommits(out)
+
+		manifest := schema.ManifestRecord{Path: path, Ecosystem: ecosystem, Commits: len(commits)}
+		current := readManifestAt(ctx, client, cfg.Git.RepoPath, "HEAD", path)
+		manifest.Dependencies = len(current)
+		for name, version := range current {
+			history(deps, ecosystem, name).versions[path] = version
+		}
+
+		// Commits arrive newest first; each is diffed against the manifest at its parent
+		manifestAuthors := make(map[string]schema.Metric)
+		var after map[string]string
+		if len(commits) > 0 {
+			after = readManifestAt(ctx, client, cfg.Git.RepoPath, commits[0].hash, path)
+		}
+		for i, commit := range commits {
+			manifestAuthors[commit.author]++
+			author, ok := authors[commit.author]
+			if !ok {
+				author = &schema.DepsAuthor{Author: commit.author}
+				authors[commit.author] = author
+			}
+			if !authorCommits[commit.hash] {
+				authorCommits[commit.hash] = true // A commit may change several manifests
+				author.Commits++
+			}
+
+			parent := commit.hash + "^"
+			if i+1 < len(commits) {
+				parent = commits[i+1].hash
+			}
+			before := readManifestAt(ctx, client, cfg.Git.RepoPath, parent, path)
+			if before == nil || after == nil {
+				after = before
+				continue // One side could not be parsed, so its changes are unknown
+			}
+			for _, name := range changedDependencies(before, after) {
+				h := history(deps, ecosystem, name)
+				h.manifests[path] = true
+				if h.commits[commit.hash] {
+					continue // Already counted for another manifest of the same commit
+				}
+				h.commits[commit.hash] = true
+				h.authors[commit.author]++
+				author.Changes++
+				if commit.date.After(h.record.LastChanged) {
+					h.record.LastChanged = commit.date
+				}
+			}
+			after = before
+		}
+
+		if len(commits) > 0 {
+			manifest.LastChanged = commits[0].date
+			manifest.TopAuthor = topAuthor(manifestAuthors)
+			manifest.Authors = len(manifestAuthors)
+			manifest.TopAuthorShare = algo.Ownership(manifestAuthors).TopOwnerShare
+			manifest.Silo = manifest.Commits >= schema.DepsSiloMinCommits && manifest.TopAuthorShare >= schema.DepsSiloShare
+		}
+		if manifest.Silo {
+			result.Summary.SiloManifests++
+		}
+		result.Manifests = append(result.Manifests, manifest)
+	}
+
+	for _, h := range deps {
+		h.record.Changes = len(h.commits)
+		h.record.Authors = len(h.authors)
+		h.record.TopAuthor = topAuthor(h.authors)
+		for path := range h.versions {
+			h.manifests[path] = true
+		}
+		h.record.Manifests = len(h.manifests)
+		h.record.Version = headVersion(h.versions)
+		result.Summary.Changes += h.record.Changes
+		result.Dependencies = append(result.Dependencies, h.record)
+	}
+	for _, author := range authors {
+		result.Authors = append(result.Authors, *author)
+	}
+	result.Summary.Manifests = len(result.Manifests)
+	result.Summary.Dependencies = len(result.Dependencies)
+
+	sort.Slice(result.Dependencies, func(i, j int) bool {
+		a, b := result.Dependencies[i], result.Dependencies[j]
+		if a.Changes != b.Changes {
+			return a.Changes > b.Changes
+		}
+		if !a.LastChanged.Equal(b.LastChanged) {
+			return a.LastChanged.After(b.LastChanged)
+		}
+		if a.Ecosystem != b.Ecosystem {
+			return a.Ecosystem < b.Ecosystem
+		}
+		return a.Name < b.Name
+	})
+	sort.Slice(result.Manifests, func(i, j int) bool {
+		a, b := result.Manifests[i], result.Manifests[j]
+		if a.Commits != b.Commits {
+			return a.Commits > b.Commits
+		}
+		return a.Path < b.Path
+	})
+	sort.Slice(result.Authors, func(i, j int) bool {
+		a, b := result.Authors[i], result.Authors[j]
+		if a.Changes != b.Changes {
+			return a.Changes > b.Changes
+		}
+		if a.Commits != b.Commits {
+			return a.Commits > b.Commits
+		}
+		return a.Author < b.Author
+	})
+	if limit > 0 && len(result.Dependencies) > limit {
+		result.Dependencies = result.Dependencies[:limit]
+	}
+
+	return result, nil
+}
+
+// history returns the history of a dependency, creating it if needed.
+func history(deps map[string]*dependencyHistory, ecosystem, name string) *dependencyHistory {
+	key := ecosystem + ":" + name
+	h, ok := deps[key]
+	if !ok {
+		h = &dependencyHistory{
+			record:    schema.DependencyRecord{Name: name, Ecosystem: ecosystem},
+			manifests: make(map[string]bool),
+			commits:   make(map[string]bool),
+			authors:   make(map[string]int),
+			versions:  make(map[string]string),
+		}
+		deps[key] = h
+	}
+	return h
+}
+
+// parseManifestCommits reads the commit headers of an activity log, newest first.
+func parseManifestCommits(out []byte) []manifestCommit {
+	var commits []manifestCommit
+	for _, l := range strings.Split(string(out), "\n") {
+		l = strings.Trim(l, " \t\r\n'")
+		if !strings.HasPrefix(l, "--") {
+			continue
+		}
+		// Commit header: --hash|author|date|subject
+		parts := strings.SplitN(l[2:], "|", 4)
+		if len(parts) < 3 {
+			continue
+		}
+		date, _ := time.Parse(time.RFC3339, parts[2])
+		commits = append(commits, manifestCommit{hash: parts[0], author: parts[1], date: date})
+	}
+	return commits
+}
+
+// readManifestAt parses a manifest at ref. A manifest that does not exist at ref lists
+// no dependencies, and one that cannot be parsed returns nil.
+func readManifestAt(ctx context.Context, client git.Client, repoPath, ref, path string) map[string]string {
+	data, err := client.GetFileAtRef(ctx, repoPath, ref, path)
+	if err != nil {
+		return map[string]string{}
+	}
+	deps, err := schema.ParseManifest(path, data)
+	if err != nil {
+		logger.Debug("Skipping unparsable manifest", "path", path, "ref", ref, "error", err)
+		return nil
+	}
+	return deps
+}
+
+// changedDependencies returns the dependencies added, removed or re-versioned between two
+// states of a manifest.
+func changedDependencies(before, after map[string]string) []string {
+	var changed []string
+	for name, version := range after {
+		if old, ok := before[name]; !ok || old != version {
+			changed = append(changed, name)
+		}
+	}
+	for name := range before {
+		if _, ok := after[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	return changed
+}
+
+// topAuthor returns the author with the most commits, breaking ties by name.
+func topAuthor[T int | schema.Metric](counts map[string]T) string {
+	top := ""
+	for author, n := range counts {
+		if top == "" || n > counts[top] || (n == counts[top] && author < top) {
+			top = author
+		}
+	}
+	return top
+}
+
+// headVersion returns the version of a dependency at HEAD. When manifests disagree, a
+// lockfile's pinned version is preferred over a manifest's constraint.
+func headVersion(versions map[string]string) string {
+	paths := make([]string, 0, len(versions))
+	for path := range versions {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		li, lj := schema.IsLockfile(paths[i]), schema.IsLockfile(paths[j])
+		if li != lj {
+			return li
+		}
+		return paths[i] < paths[j]
+	})
+	for _, path := range paths {
+		if v := versions[path]; v != "" {
+			return v
+		}
+	}
+	return ""
+}
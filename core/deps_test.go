@@ -0,0 +1,130 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHotspotDepsResults(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+
+	goMod := func(ab, cd string) []byte {
+		mod := "module x\n\nrequire github.com/a/b " + ab + "\n"
+		if cd != "" {
+			mod += "require github.com/c/d " + cd + "\n"
+		}
+		return []byte(mod)
+	}
+	missing := errors.New("path does not exist")
+
+	mockClient.On("ListFilesAtRef", ctx, repo, "HEAD").Return([]string{"main.go", "go.mod", "vendor/x/go.mod", "web/package.json"}, nil)
+
+	// go.mod: alice adds a/b, bob adds c/d, alice bumps a/b, alice edits a comment
+	mockClient.On("GetActivityLog", ctx, repo, "go.mod", mock.Anything, mock.Anything).Return([]byte(
+		"'--h4|alice|2024-03-04T10:00:00Z|comment'\n1\t1\tgo.mod\n"+
+			"'--h3|alice|2024-03-03T10:00:00Z|bump a/b'\n1\t1\tgo.mod\n"+
+			"'--h2|bob|2024-03-02T10:00:00Z|add c/d'\n1\t0\tgo.mod\n"+
+			"'--h1|alice|2024-03-01T10:00:00Z|init'\n3\t0\tgo.mod\n"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "go.mod").Return(goMod("v1.1.0", "v0.1.0"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "h4", "go.mod").Return(goMod("v1.1.0", "v0.1.0"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "h3", "go.mod").Return(goMod("v1.1.0", "v0.1.0"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "h2", "go.mod").Return(goMod("v1.0.0", "v0.1.0"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "h1", "go.mod").Return(goMod("v1.0.0", ""), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "h1^", "go.mod").Return(nil, missing)
+
+	// package.json: carol makes every change and one revision could not be parsed,
+	// so its changes are unknown
+	mockClient.On("GetActivityLog", ctx, repo, "web/package.json", mock.Anything, mock.Anything).Return([]byte(
+		"'--w3|carol|2024-02-03T10:00:00Z|format'\n1\t1\tweb/package.json\n"+
+			"'--w2|carol|2024-02-02T10:00:00Z|fix json'\n1\t1\tweb/package.json\n"+
+			"'--w1|carol|2024-02-01T10:00:00Z|broken'\n1\t0\tweb/package.json\n"), nil)
+	pkg := []byte(`{"dependencies": {"react": "18"}}`)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "web/package.json").Return(pkg, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "w3", "web/package.json").Return(pkg, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "w2", "web/package.json").Return(pkg, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "w1", "web/package.json").Return([]byte("{"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "w1^", "web/package.json").Return(nil, missing)
+
+	cfg := &config.Config{Git: config.GitConfig{RepoPath: repo}}
+	result, err := GetHotspotDepsResults(ctx, cfg, mockClient, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.Summary.Manifests)
+	assert.Equal(t, 3, result.Summary.Dependencies)
+	assert.Equal(t, 3, result.Summary.Changes)
+	assert.Equal(t, 1, result.Summary.SiloManifests)
+
+	require.Len(t, result.Dependencies, 3)
+	ab := result.Dependencies[0]
+	assert.Equal(t, "github.com/a/b", ab.Name)
+	assert.Equal(t, "go", ab.Ecosystem)
+	assert.Equal(t, "v1.1.0", ab.Version)
+	assert.Equal(t, 2, ab.Changes)
+	assert.Equal(t, "alice", ab.TopAuthor)
+	assert.Equal(t, "2024-03-03", ab.LastChanged.Format("2006-01-02"))
+
+	cd := result.Dependencies[1]
+	assert.Equal(t, "github.com/c/d", cd.Name)
+	assert.Equal(t, "bob", cd.TopAuthor)
+
+	react := result.Dependencies[2]
+	assert.Equal(t, "react", react.Name)
+	assert.Zero(t, react.Changes)
+	assert.True(t, react.LastChanged.IsZero())
+	assert.Equal(t, "18", react.Version)
+
+	require.Len(t, result.Manifests, 2)
+	mod := result.Manifests[0]
+	assert.Equal(t, "go.mod", mod.Path)
+	assert.Equal(t, 4, mod.Commits)
+	assert.Equal(t, 2, mod.Authors)
+	assert.Equal(t, "alice", mod.TopAuthor)
+	assert.InDelta(t, 0.75, mod.TopAuthorShare, 1e-9)
+	assert.False(t, mod.Silo)
+	assert.Equal(t, 2, mod.Dependencies)
+
+	assert.Equal(t, "web/package.json", result.Manifests[1].Path)
+	assert.True(t, result.Manifests[1].Silo)
+	assert.Equal(t, "carol", result.Manifests[1].TopAuthor)
+
+	require.Len(t, result.Authors, 3)
+	assert.Equal(t, "alice", result.Authors[0].Author)
+	assert.Equal(t, 2, result.Authors[0].Changes)
+	assert.Equal(t, 3, result.Authors[0].Commits)
+}
+
+func TestGetHotspotDepsResults_SharedCommit(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+	missing := errors.New("path does not exist")
+
+	// One commit by alice adds both manifests
+	mod := []byte("module x\n\nrequire github.com/a/b v1.0.0\n")
+	pkg := []byte(`{"dependencies": {"react": "18"}}`)
+	mockClient.On("ListFilesAtRef", ctx, repo, "HEAD").Return([]string{"go.mod", "web/package.json"}, nil)
+	for path, data := range map[string][]byte{"go.mod": mod, "web/package.json": pkg} {
+		mockClient.On("GetActivityLog", ctx, repo, path, mock.Anything, mock.Anything).Return([]byte(
+			"'--s1|alice|2024-03-01T10:00:00Z|init'\n1\t0\t"+path+"\n"), nil)
+		mockClient.On("GetFileAtRef", ctx, repo, "HEAD", path).Return(data, nil)
+		mockClient.On("GetFileAtRef", ctx, repo, "s1", path).Return(data, nil)
+		mockClient.On("GetFileAtRef", ctx, repo, "s1^", path).Return(nil, missing)
+	}
+
+	cfg := &config.Config{Git: config.GitConfig{RepoPath: repo}}
+	result, err := GetHotspotDepsResults(ctx, cfg, mockClient, 10)
+	require.NoError(t, err)
+
+	require.Len(t, result.Authors, 1)
+	assert.Equal(t, 1, result.Authors[0].Commits)
+	assert.Equal(t, 2, result.Authors[0].Changes)
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/internal/iocache"
+	"github.com/huangsam/hotspot/schema"
+)
+
+// GetHotspotDocsDriftResults runs the folder-level analysis and ranks the documented
+// folders by how much their code changed since their docs were last modified.
+func GetHotspotDocsDriftResults(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager) (schema.DocDriftResult, time.Duration, error) {
+	start := time.Now()
+	output, err := runFolderAnalysisCore(ctx, cfg.Git, cfg.Scoring, cfg.Runtime, cfg.Output, cfg.Compare, client, mgr, nil)
+	if err != nil {
+		return schema.DocDriftResult{}, 0, err
+	}
+	result := buildDocDriftResult(output.FolderResults, output.AggregateOutput.DocDrift, cfg.Scoring.Mode, cfg.Output.ResultLimit)
+	return result, time.Since(start), nil
+}
+
+// buildDocDriftResult joins the folder results with their documentation drift. Folders
+// without code commits in the window are not active and are left out.
+func buildDocDriftResult(folders []schema.FolderResult, drift map[string]schema.DocDrift, mode schema.ScoringMode, limit int) schema.DocDriftResult {
+	result := schema.DocDriftResult{
+		Summary: schema.DocDriftSummary{Mode: mode},
+		Folders: []schema.DocDriftRecord{},
+	}
+	for _, folder := range folders {
+		d := drift[folder.Path]
+		if d.CodeCommits == 0 {
+			continue
+		}
+		if d.DocFiles == 0 {
+			result.Summary.UndocumentedFolders++
+			continue
+		}
+		result.Summary.DocumentedFolders++
+		if d.CommitsSinceDocs > 0 {
+			result.Summary.StaleFolders++
+		}
+		result.Folders = append(result.Folders, schema.DocDriftRecord{
+			Path:             folder.Path,
+			DocFiles:         d.DocFiles,
+			DocsLastModified: d.DocsLastModified,
+			CodeCommits:      d.CodeCommits,
+			CommitsSinceDocs: d.CommitsSinceDocs,
+			ChurnSinceDocs:   d.ChurnSinceDocs,
+			DriftRatio:       d.DriftRatio(),
+			Score:            folder.Score,
+		})
+	}
+
+	sort.Slice(result.Folders, func(i, j int) bool {
+		a, b := result.Folders[i], result.Folders[j]
+		if a.CommitsSinceDocs != b.CommitsSinceDocs {
+			return a.CommitsSinceDocs > b.CommitsSinceDocs
+		}
+		if a.ChurnSinceDocs != b.ChurnSinceDocs {
+			return a.ChurnSinceDocs > b.ChurnSinceDocs
+		}
+		return a.Path < b.Path
+	})
+	if limit > 0 && len(result.Folders) > limit {
+		result.Folders = result.Folders[:limit]
+	}
+	return result
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildDocDriftResult(t *testing.T) {
+	docsDate := time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)
+	folders := []schema.FolderResult{
+		{Path: "pay", Score: 60},
+		{Path: "auth", Score: 40},
+		{Path: "billing", Score: 30},
+		{Path: "cli", Score: 20},
+		{Path: "pay/docs", Score: 5},
+	}
+	drift := map[string]schema.DocDrift{
+		"pay":     {DocFiles: 2, DocsLastModified: docsDate, CodeCommits: 4, CommitsSinceDocs: 3, ChurnSinceDocs: 40},
+		"auth":    {DocFiles: 1, DocsLastModified: docsDate, CodeCommits: 2},
+		"billing": {DocFiles: 1, CodeCommits: 3, CommitsSinceDocs: 3, ChurnSinceDocs: 90},
+		"cli":     {CodeCommits: 5, CommitsSinceDocs: 5, ChurnSinceDocs: 100},
+	}
+
+	result := buildDocDriftResult(folders, drift, schema.HotMode, 0)
+
+	assert.Equal(t, schema.HotMode, result.Summary.Mode)
+	assert.Equal(t, 3, result.Summary.DocumentedFolders)
+	assert.Equal(t, 1, result.Summary.UndocumentedFolders)
+	assert.Equal(t, 2, result.Summary.StaleFolders)
+
+	// Equal commits since docs are ranked by churn since docs
+	require.Len(t, result.Folders, 3)
+	assert.Equal(t, "billing", result.Folders[0].Path)
+	assert.True(t, result.Folders[0].DocsLastModified.IsZero())
+	assert.Equal(t, 1.0, result.Folders[0].DriftRatio)
+	assert.Equal(t, "pay", result.Folders[1].Path)
+	assert.InDelta(t, 0.75, result.Folders[1].DriftRatio, 1e-9)
+	assert.Equal(t, 60.0, result.Folders[1].Score)
+	assert.Equal(t, "auth", result.Folders[2].Path)
+
+	limited := buildDocDriftResult(folders, drift, schema.HotMode, 1)
+	require.Len(t, limited.Folders, 1)
+	assert.Equal(t, 3, limited.Summary.DocumentedFolders)
+}
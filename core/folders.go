@@ -0,0 +1,58 @@
+package core
+
+import (
+	"path/filepath"
+
+	"github.com/huangsam/hotspot/core/agg"
+	"github.com/huangsam/hotspot/core/algo"
+	"github.com/huangsam/hotspot/schema"
+)
+
+// foldersAtDepth keeps the rolled-up folders that are exactly depth directories deep.
+func foldersAtDepth(folders []schema.FolderResult, depth int) []schema.FolderResult {
+	var kept []schema.FolderResult
+	for _, f := range folders {
+		if agg.FolderDepth(f.Path) == depth {
+			kept = append(kept, f)
+		}
+	}
+	return kept
+}
+
+// buildFolderTree nests rolled-up folders under the repository root. Every folder keeps
+// its top limit subfolders by score, and folders deeper than maxDepth are left out
+// unless maxDepth is 0.
+func buildFolderTree(folders []schema.FolderResult, maxDepth, limit int) schema.FolderTreeNode {
+	byPath := make(map[string]schema.FolderResult, len(folders))
+	children := make(map[string][]schema.FolderResult)
+	for _, f := range folders {
+		byPath[f.Path] = f
+		if f.Path != "." {
+			parent := filepath.Dir(f.Path)
+			children[parent] = append(children[parent], f)
+		}
+	}
+
+	var build func(f schema.FolderResult) schema.FolderTreeNode
+	build = func(f schema.FolderResult) schema.FolderTreeNode {
+		depth := agg.FolderDepth(f.Path)
+		node := schema.FolderTreeNode{
+			Label:        schema.GetPlainLabel(f.Score),
+			Depth:        depth,
+			FolderResult: f,
+		}
+		if maxDepth > 0 && depth >= maxDepth {
+			return node
+		}
+		for _, child := range algo.RankFolders(children[f.Path], limit) {
+			node.Children = append(node.Children, build(child))
+		}
+		return node
+	}
+
+	root, ok := byPath["."]
+	if !ok {
+		root = schema.FolderResult{Path: "."}
+	}
+	return build(root)
+}
@@ -219,6 +219,43 @@ func TestAggregateAndScoreFolders_OwnerCalculation(t *testing.T) {
 	assert.Equal(t, schema.Metric(260), folder.TotalLOC) // 80 + 120 + 60
 }
 
+func TestAggregateAndScoreFolders_OwnershipFragmentation(t *testing.T) {
+	// Full contributor maps are summed across files before measuring fragmentation
+	fileResults := []schema.FileResult{
+		{
+			Path:         "src/a.go",
+			Commits:      schema.Metric(20),
+			LinesOfCode:  schema.Metric(100),
+			Owners:       []string{"Alice"},
+			Contributors: map[string]schema.Metric{"Alice": 18, "Bob": 1, "Carol": 1},
+		},
+		{
+			Path:         "src/b.go",
+			Commits:      schema.Metric(20),
+			LinesOfCode:  schema.Metric(100),
+			Owners:       []string{"Bob"},
+			Contributors: map[string]schema.Metric{"Bob": 19, "Dave": 1},
+		},
+	}
+
+	cfg := &config.Config{
+		Scoring: config.ScoringConfig{
+			Mode: schema.RiskMode,
+		},
+	}
+
+	result := agg.AggregateAndScoreFolders(cfg.Git, cfg.Scoring, fileResults)
+
+	require.Len(t, result, 1)
+	folder := result[0]
+
+	// Carol and Dave each made 1 of 40 commits; Bob made 20
+	expected := algo.Ownership(map[string]schema.Metric{"Alice": 18, "Bob": 20, "Carol": 1, "Dave": 1})
+	assert.Equal(t, schema.Metric(2), folder.MinorContributors)
+	assert.InDelta(t, 0.5, folder.TopOwnerShare, 1e-9)
+	assert.InDelta(t, expected.Entropy, folder.OwnershipEntropy, 1e-9)
+}
+
 func TestAggregateAndScoreFolders_NoOwners(t *testing.T) {
 	fileResults := []schema.FileResult{
 		{
@@ -247,6 +284,88 @@ func TestAggregateAndScoreFolders_NoOwners(t *testing.T) {
 	assert.True(t, folder.Score >= 0)
 }
 
+func TestAggregateFolderTree(t *testing.T) {
+	fileResults := []schema.FileResult{
+		{Path: "services/api/main.go", Commits: 10, Churn: 100, LinesOfCode: 100, ModeScore: 40.0, Owners: []string{"Alice"}},
+		{Path: "services/api/handlers/user.go", Commits: 6, Churn: 60, LinesOfCode: 50, ModeScore: 70.0, Owners: []string{"Bob"}},
+		{Path: "services/web/app.go", Commits: 2, Churn: 20, LinesOfCode: 50, ModeScore: 10.0, Owners: []string{"Carol"}},
+		{Path: "README.md", Commits: 1, Churn: 5, LinesOfCode: 10, ModeScore: 5.0, Owners: []string{"Carol"}},
+	}
+	scoring := config.ScoringConfig{Mode: schema.HotMode}
+
+	byPath := make(map[string]schema.FolderResult)
+	for _, f := range agg.AggregateFolderTree(scoring, fileResults) {
+		byPath[f.Path] = f
+	}
+	require.Len(t, byPath, 5)
+
+	// Every ancestor accumulates its descendants
+	assert.Equal(t, schema.Metric(19), byPath["."].Commits)
+	assert.Equal(t, schema.Metric(18), byPath["services"].Commits)
+	assert.Equal(t, schema.Metric(16), byPath["services/api"].Commits)
+	assert.Equal(t, schema.Metric(6), byPath["services/api/handlers"].Commits)
+	assert.Equal(t, schema.Metric(150), byPath["services/api"].TotalLOC)
+	assert.Equal(t, []string{"Alice", "Bob"}, byPath["services/api"].Owners)
+	assert.Equal(t, []string{"Bob"}, byPath["services/api/handlers"].Owners)
+	assert.InDelta(t, (40.0*100+70.0*50)/150, byPath["services/api"].Score, 0.01)
+
+	// The flat aggregation still credits each file to its parent folder only
+	flat := agg.AggregateAndScoreFolders(config.GitConfig{}, scoring, fileResults)
+	assert.Len(t, flat, 3)
+}
+
+func TestFolderDepth(t *testing.T) {
+	assert.Equal(t, 0, agg.FolderDepth("."))
+	assert.Equal(t, 1, agg.FolderDepth("services"))
+	assert.Equal(t, 3, agg.FolderDepth("services/api/handlers"))
+}
+
+func TestFoldersAtDepth(t *testing.T) {
+	folders := []schema.FolderResult{
+		{Path: "."}, {Path: "services"}, {Path: "services/api"}, {Path: "services/web"}, {Path: "services/api/handlers"},
+	}
+	var paths []string
+	for _, f := range foldersAtDepth(folders, 2) {
+		paths = append(paths, f.Path)
+	}
+	assert.Equal(t, []string{"services/api", "services/web"}, paths)
+}
+
+func TestBuildFolderTree(t *testing.T) {
+	folders := []schema.FolderResult{
+		{Path: ".", Score: 30},
+		{Path: "services", Score: 40},
+		{Path: "docs", Score: 5},
+		{Path: "services/api", Score: 60},
+		{Path: "services/web", Score: 20},
+		{Path: "services/jobs", Score: 35},
+		{Path: "services/api/handlers", Score: 80},
+	}
+
+	tree := buildFolderTree(folders, 0, 10)
+	assert.Equal(t, ".", tree.Path)
+	assert.Equal(t, 0, tree.Depth)
+	require.Len(t, tree.Children, 2)
+	assert.Equal(t, "services", tree.Children[0].Path)
+	assert.Equal(t, "docs", tree.Children[1].Path)
+
+	services := tree.Children[0]
+	require.Len(t, services.Children, 3)
+	assert.Equal(t, "services/api", services.Children[0].Path)
+	assert.Equal(t, "services/jobs", services.Children[1].Path)
+	assert.Equal(t, "services/web", services.Children[2].Path)
+	require.Len(t, services.Children[0].Children, 1)
+	assert.Equal(t, 3, services.Children[0].Children[0].Depth)
+	assert.Equal(t, schema.CriticalValue, services.Children[0].Children[0].Label)
+
+	// The limit caps subfolders per folder and the depth caps the tree
+	tree = buildFolderTree(folders, 2, 2)
+	services = tree.Children[0]
+	require.Len(t, services.Children, 2)
+	assert.Equal(t, "services/jobs", services.Children[1].Path)
+	assert.Empty(t, services.Children[0].Children)
+}
+
 func TestRankFolders(t *testing.T) {
 	folders := []schema.FolderResult{
 		{Path: "src", Score: 15.0, Commits: 50},
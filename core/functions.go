@@ -0,0 +1,459 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/huangsam/hotspot/core/algo"
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/internal/iocache"
+	"github.com/huangsam/hotspot/internal/logger"
+	"github.com/huangsam/hotspot/schema"
+)
+
+// patchCommit is one commit from a file's patch log.
+type patchCommit struct {
+	hash, author, subject string
+	date                  time.Time
+	hunks                 []patchHunk
+}
+
+// patchHunk is the range of lines one diff hunk replaced.
+type patchHunk struct {
+	oldStart, oldLines int
+	newStart, newLines int
+}
+
+// functionHistory accumulates the changes made to one function.
+type functionHistory struct {
+	commits, churn, added, deleted schema.Metric
+	decayedCommits, decayedChurn   schema.Metric
+	recentCommits, recentChurn     schema.Metric
+	recentAdded, recentDeleted     schema.Metric
+	fixCommits, fixChurn           schema.Metric
+	authors, recentAuthors         map[string]schema.Metric
+	firstChanged, lastChanged      time.Time
+}
+
+// GetHotspotFunctionsResults ranks the functions of Go files by their change history and
+// complexity. When the path filter names a Go file, that file is analyzed; otherwise the
+// functions of the top-ranked Go files in the current mode are. Each hunk in a file's
+// history is mapped to the function that encloses it at that revision, or at the parent
+// revision for deleted lines, and every function declared at the analyzed revision is
+// scored with the file scoring pipeline. That revision is HEAD, or the last commit before
+// --end when it is set, so the functions match the history window.
+func GetHotspotFunctionsResults(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager) (schema.FunctionsResult, error) {
+	paths, err := functionTargets(ctx, cfg, client, mgr)
+	if err != nil {
+		return schema.FunctionsResult{}, err
+	}
+
+	result := schema.FunctionsResult{
+		Summary:   schema.FunctionsSummary{Mode: cfg.Scoring.GetMode(), Files: paths},
+		Functions: []schema.FunctionResult{},
+	}
+	var carriers []schema.FileResult
+	for _, path := range paths {
+		functions, fileCarriers, commits, err := analyzeFileFunctions(ctx, cfg, client, path)
+		if err != nil {
+			return schema.FunctionsResult{}, err
+		}
+		result.Functions = append(result.Functions, functions...)
+		carriers = append(carriers, fileCarriers...)
+		result.Summary.Commits += commits
+	}
+	result.Summary.Functions = len(result.Functions)
+
+	// Functions are far smaller than files, so they are always normalized against each other
+	strategy := cfg.Scoring.GetNormalization()
+	if strategy == schema.FixedNormalization {
+		strategy = schema.PercentileNormalization
+	}
+	scales := algo.ComputeNormalizationScales(carriers, strategy)
+	for i := range carriers {
+		carriers[i].Normalization = &scales
+		scoreFileResult(&carriers[i], cfg.Scoring)
+		fn := &result.Functions[i]
+		fn.Mode = carriers[i].Mode
+		fn.Score = carriers[i].ModeScore
+		fn.Reasoning = carriers[i].Reasoning
+		fn.Scores = make(map[schema.ScoringMode]float64, len(schema.BaseScoringModes))
+		for _, mode := range schema.BaseScoringModes {
+			fn.Scores[mode] = carriers[i].AllScores[mode]
+		}
+	}
+
+	sort.SliceStable(result.Functions, func(i, j int) bool {
+		a, b := result.Functions[i], result.Functions[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		if a.Commits != b.Commits {
+			return a.Commits > b.Commits
+		}
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Line < b.Line
+	})
+	if limit := cfg.Output.ResultLimit; limit > 0 && len(result.Functions) > limit {
+		result.Functions = result.Functions[:limit]
+	}
+	return result, nil
+}
+
+// functionTargets returns the Go files to analyze: the filtered file itself, or the top
+// Go files of a file analysis in the current mode.
+func functionTargets(ctx context.Context, cfg *config.Config, client git.Client, mgr iocache.CacheManager) ([]string, error) {
+	if filter := cfg.Git.PathFilter; algo.IsGoSource(filter) {
+		return []string{filter}, nil
+	}
+	output, err := runSingleAnalysisCore(ctx, cfg.Git, cfg.Scoring, cfg.Runtime, cfg.Output, cfg.Compare, client, mgr, nil)
+	if err != nil {
+		return nil, err
+	}
+	files := cfg.Functions.Files
+	if files <= 0 {
+		files = schema.DefaultFunctionFiles
+	}
+	paths := []string{}
+	for _, f := range algo.RankFiles(output.FileResults, math.MaxInt) {
+		if len(paths) == files {
+			break
+		}
+		if algo.IsGoSource(f.Path) {
+			paths = append(paths, f.Path)
+		}
+	}
+	return paths, nil
+}
+
+// analyzeFileFunctions maps the history of one Go file to its functions. It returns a
+// record per function declared at the analyzed revision, the file metrics used to score each record (in
+// the same order) and the number of commits in the file's history.
+func analyzeFileFunctions(ctx context.Context, cfg *config.Config, client git.Client, path string) ([]schema.FunctionResult, []schema.FileResult, int, error) {
+	repoPath := cfg.Git.GetRepoPath()
+	ref := getAnalysisRef(ctx)
+	if ref == "" {
+		ref = resolveAnalysisRef(ctx, cfg.Git, client, "HEAD")
+	}
+	if ref == "" {
+		ref = "HEAD"
+	}
+	content, err := client.GetFileAtRef(ctx, repoPath, ref, path)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("cannot read %s at %s: %w", path, ref, err)
+	}
+	current, err := algo.GoFunctions(path, content)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("cannot parse %s: %w", path, err)
+	}
+
+	out, err := client.GetFilePatchLog(ctx, repoPath, path, cfg.Git.GetStartTime(), cfg.Git.GetEndTime())
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	commits := parsePatchLog(out)
+
+	end := cfg.Git.GetEndTime()
+	if end.IsZero() {
+		end = time.Now()
+	}
+	temporal := cfg.Git.GetTemporalModel()
+	recentThreshold := end.AddDate(0, 0, -temporal.RecentWindowDays)
+	classifier := cfg.Git.GetFixClassifier()
+	if classifier == nil {
+		classifier = schema.DefaultCommitClassifier()
+	}
+
+	// Commits arrive newest first; each is compared with the file at its parent
+	histories := make(map[string]*functionHistory)
+	after := current
+	if len(commits) > 0 {
+		after = functionsAt(ctx, client, repoPath, commits[0].hash, path)
+	}
+	for i, commit := range commits {
+		parent := commit.hash + "^"
+		if i+1 < len(commits) {
+			parent = commits[i+1].hash
+		}
+		before := functionsAt(ctx, client, repoPath, parent, path)
+
+		added := make(map[string]schema.Metric)
+		deleted := make(map[string]schema.Metric)
+		for _, h := range commit.hunks {
+			for line := h.newStart; line < h.newStart+h.newLines; line++ {
+				if name := enclosingFunction(after, line); name != "" {
+					added[name]++
+				}
+			}
+			for line := h.oldStart; line < h.oldStart+h.oldLines; line++ {
+				if name := enclosingFunction(before, line); name != "" {
+					deleted[name]++
+				}
+			}
+		}
+
+		decay := schema.Metric(temporal.DecayFactor(end.Sub(commit.date).Hours() / 24.0))
+		isFix := classifier.IsFix(commit.subject)
+		for _, name := range touchedFunctions(added, deleted) {
+			h := functionHistoryFor(histories, name)
+			churn := added[name] + deleted[name]
+			h.commits++
+			h.churn += churn
+			h.added += added[name]
+			h.deleted += deleted[name]
+			h.decayedCommits += decay
+			h.decayedChurn += churn * decay
+			h.authors[commit.author]++
+			if commit.date.After(recentThreshold) {
+				h.recentCommits++
+				h.recentChurn += churn
+				h.recentAdded += added[name]
+				h.recentDeleted += deleted[name]
+				h.recentAuthors[commit.author]++
+			}
+			if isFix {
+				h.fixCommits++
+				h.fixChurn += churn
+			}
+			if h.firstChanged.IsZero() || commit.date.Before(h.firstChanged) {
+				h.firstChanged = commit.date
+			}
+			if commit.date.After(h.lastChanged) {
+				h.lastChanged = commit.date
+			}
+		}
+		after = before
+	}
+
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	functions := make([]schema.FunctionResult, 0, len(current))
+	carriers := make([]schema.FileResult, 0, len(current))
+	for i, fn := range current {
+		h := functionHistoryFor(histories, functionKey(current, i))
+		source := bytes.Join(lines[fn.Line-1:min(fn.EndLine, len(lines))], nil)
+		carrier := functionCarrier(path, fn, source, h, end, temporal.RecentWindowDays)
+		carriers = append(carriers, carrier)
+		functions = append(functions, schema.FunctionResult{
+			Path:         path,
+			Name:         fn.Name,
+			Line:         fn.Line,
+			EndLine:      fn.EndLine,
+			Cyclomatic:   fn.Cyclomatic,
+			Cognitive:    fn.Cognitive,
+			Commits:      h.commits,
+			Churn:        h.churn,
+			Contributors: carrier.UniqueContributors,
+			Owners:       carrier.Owners,
+			LastChanged:  h.lastChanged,
+		})
+	}
+	return functions, carriers, len(commits), nil
+}
+
+// functionCarrier packs the history and complexity of a function into file metrics, so
+// it can be scored like a file.
+func functionCarrier(path string, fn schema.FunctionComplexity, source []byte, h *functionHistory, end time.Time, recentWindowDays int) schema.FileResult {
+	stats := schema.ClassifySource(path, source)
+	carrier := schema.FileResult{
+		Path:               path,
+		UniqueContributors: schema.Metric(len(h.authors)),
+		Commits:            h.commits,
+		RecentContributors: schema.Metric(len(h.recentAuthors)),
+		RecentCommits:      h.recentCommits,
+		RecentChurn:        h.recentChurn,
+		RecentLinesAdded:   h.recentAdded,
+		RecentLinesDeleted: h.recentDeleted,
+		DecayedCommits:     h.decayedCommits,
+		DecayedChurn:       h.decayedChurn,
+		RecentWindowDays:   recentWindowDays,
+		SizeBytes:          int64(len(source)),
+		LinesOfCode:        schema.Metric(fn.EndLine - fn.Line + 1),
+		SLOC:               schema.Metric(stats.SLOC),
+		CommentLines:       schema.Metric(stats.CommentLines),
+		CommentRatio:       stats.CommentRatio(),
+		IndentComplexity:   schema.Metric(stats.IndentTotal),
+		MaxIndent:          schema.Metric(stats.IndentMax),
+		Functions:          1,
+		CyclomaticTotal:    schema.Metric(fn.Cyclomatic),
+		CyclomaticMax:      schema.Metric(fn.Cyclomatic),
+		CognitiveTotal:     schema.Metric(fn.Cognitive),
+		CognitiveMax:       schema.Metric(fn.Cognitive),
+		Churn:              h.churn,
+		LinesAdded:         h.added,
+		LinesDeleted:       h.deleted,
+		FixCommits:         h.fixCommits,
+		FixChurn:           h.fixChurn,
+		FirstCommit:        h.firstChanged,
+		Owners:             topOwners(h.authors, 2),
+	}
+	if h.commits > 0 {
+		carrier.FixRatio = h.fixCommits.Float64() / h.commits.Float64()
+	}
+	if !h.firstChanged.IsZero() {
+		carrier.AgeDays = schema.Metric(schema.CalculateDaysBetween(h.firstChanged, end))
+	}
+	values := make([]float64, 0, len(h.authors))
+	for _, c := range h.authors {
+		values = append(values, c.Float64())
+	}
+	carrier.Gini = algo.Gini(values)
+	ownership := algo.Ownership(h.authors)
+	carrier.MinorContributors = schema.Metric(ownership.MinorContributors)
+	carrier.TopOwnerShare = ownership.TopOwnerShare
+	carrier.OwnershipEntropy = ownership.Entropy
+	return carrier
+}
+
+// functionHistoryFor returns the history of a function, creating it if needed.
+func functionHistoryFor(histories map[string]*functionHistory, name string) *functionHistory {
+	h, ok := histories[name]
+	if !ok {
+		h = &functionHistory{
+			authors:       make(map[string]schema.Metric),
+			recentAuthors: make(map[string]schema.Metric),
+		}
+		histories[name] = h
+	}
+	return h
+}
+
+// functionsAt parses the functions of a Go file at ref. A file that does not exist or
+// does not parse at ref has none.
+func functionsAt(ctx context.Context, client git.Client, repoPath, ref, path string) []schema.FunctionComplexity {
+	data, err := client.GetFileAtRef(ctx, repoPath, ref, path)
+	if err != nil {
+		return nil
+	}
+	functions, err := algo.GoFunctions(path, data)
+	if err != nil {
+		logger.Debug("Skipping unparsable Go revision", "path", path, "ref", ref, "error", err)
+		return nil
+	}
+	return functions
+}
+
+// enclosingFunction returns the key of the function whose declaration spans line, or ""
+// if none does.
+func enclosingFunction(functions []schema.FunctionComplexity, line int) string {
+	for i, fn := range functions {
+		if line >= fn.Line && line <= fn.EndLine {
+			return functionKey(functions, i)
+		}
+	}
+	return ""
+}
+
+// functionKey identifies the i-th function of a file by its name and, when the name is
+// declared more than once (init and _ may be), by how many same-named functions come
+// before it, so their histories are kept apart.
+func functionKey(functions []schema.FunctionComplexity, i int) string {
+	name := functions[i].Name
+	n := 0
+	for _, fn := range functions[:i] {
+		if fn.Name == name {
+			n++
+		}
+	}
+	if n == 0 {
+		return name
+	}
+	return name + "#" + strconv.Itoa(n)
+}
+
+// touchedFunctions returns the sorted keys of the functions a commit added or deleted lines in.
+func touchedFunctions(added, deleted map[string]schema.Metric) []string {
+	seen := make(map[string]bool, len(added)+len(deleted))
+	for name := range added {
+		seen[name] = true
+	}
+	for name := range deleted {
+		seen[name] = true
+	}
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// topOwners returns up to n authors by commit count, breaking ties by name.
+func topOwners(authors map[string]schema.Metric, n int) []string {
+	names := make([]string, 0, len(authors))
+	for author := range authors {
+		names = append(names, author)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if authors[names[i]] != authors[names[j]] {
+			return authors[names[i]] > authors[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	return names[:min(n, len(names))]
+}
+
+// parsePatchLog reads the commits and hunk headers of a zero-context patch log, newest first.
+func parsePatchLog(out []byte) []patchCommit {
+	var commits []patchCommit
+	for _, l := range strings.Split(string(out), "\n") {
+		switch {
+		case strings.HasPrefix(l, "DELIMITER_COMMIT_START"):
+			// Commit header: DELIMITER_COMMIT_STARThash|author|date|subject
+			parts := strings.SplitN(strings.TrimPrefix(l, "DELIMITER_COMMIT_START"), "|", 4)
+			if len(parts) < 3 {
+				continue
+			}
+			date, _ := time.Parse(time.RFC3339, parts[2])
+			commit := patchCommit{hash: parts[0], author: parts[1], date: date}
+			if len(parts) == 4 {
+				commit.subject = parts[3]
+			}
+			commits = append(commits, commit)
+		case strings.HasPrefix(l, "@@ ") && len(commits) > 0:
+			if h, ok := parseHunkHeader(l); ok {
+				last := &commits[len(commits)-1]
+				last.hunks = append(last.hunks, h)
+			}
+		}
+	}
+	return commits
+}
+
+// parseHunkHeader parses a hunk header such as "@@ -12,3 +12,5 @@ func Foo() {".
+func parseHunkHeader(l string) (patchHunk, bool) {
+	fields := strings.Fields(l)
+	if len(fields) < 3 || !strings.HasPrefix(fields[1], "-") || !strings.HasPrefix(fields[2], "+") {
+		return patchHunk{}, false
+	}
+	oldStart, oldLines, ok1 := parseHunkRange(fields[1][1:])
+	newStart, newLines, ok2 := parseHunkRange(fields[2][1:])
+	if !ok1 || !ok2 {
+		return patchHunk{}, false
+	}
+	return patchHunk{oldStart: oldStart, oldLines: oldLines, newStart: newStart, newLines: newLines}, true
+}
+
+// parseHunkRange parses "start,count" or "start", where a missing count means one line.
+func parseHunkRange(s string) (int, int, bool) {
+	startStr, countStr, hasCount := strings.Cut(s, ",")
+	start, err := strconv.Atoi(startStr)
+	if err != nil {
+		return 0, 0, false
+	}
+	count := 1
+	if hasCount {
+		if count, err = strconv.Atoi(countStr); err != nil {
+			return 0, 0, false
+		}
+	}
+	return start, count, true
+}
@@ -0,0 +1,188 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHotspotFunctionsResults(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+
+	v1 := []byte("package x\n\nfunc A() {\n\ta := 0\n\t_ = a\n}\n\nfunc B() int {\n\treturn 2\n}\n")
+	v2 := []byte("package x\n\nfunc A() {\n\ta := 0\n\t_ = a\n}\n\nfunc B() int {\n\tif true {\n\t\treturn 1\n\t}\n\treturn 3\n}\n")
+
+	// alice adds the file, then bob guards B and changes its return value
+	mockClient.On("GetFilePatchLog", ctx, repo, "x.go", mock.Anything, mock.Anything).Return([]byte(
+		"DELIMITER_COMMIT_STARTc2|bob|2024-03-02T10:00:00Z|fix: guard B\n\n"+
+			"diff --git a/x.go b/x.go\n--- a/x.go\n+++ b/x.go\n"+
+			"@@ -8,0 +9,3 @@ func B() int {\n+\tif true {\n+\t\treturn 1\n+\t}\n"+
+			"@@ -9 +12 @@ func B() int {\n-\treturn 2\n+\treturn 3\n"+
+			"DELIMITER_COMMIT_STARTc1|alice|2024-03-01T10:00:00Z|add x\n\n"+
+			"diff --git a/x.go b/x.go\n--- /dev/null\n+++ b/x.go\n"+
+			"@@ -0,0 +1,10 @@\n+package x\n"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "x.go").Return(v2, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "c2", "x.go").Return(v2, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "c1", "x.go").Return(v1, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "c1^", "x.go").Return(nil, errors.New("path does not exist"))
+
+	cfg := &config.Config{
+		Git: config.GitConfig{
+			RepoPath:   repo,
+			PathFilter: "x.go",
+			EndTime:    time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		Scoring: config.ScoringConfig{
+			Mode:            schema.HotMode,
+			ComputedWeights: map[schema.ScoringMode]map[schema.BreakdownKey]float64{schema.HotMode: schema.GetDefaultWeights(schema.HotMode)},
+		},
+		Output: config.OutputConfig{ResultLimit: 10},
+	}
+	result, err := GetHotspotFunctionsResults(ctx, cfg, mockClient, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"x.go"}, result.Summary.Files)
+	assert.Equal(t, 2, result.Summary.Functions)
+	assert.Equal(t, 2, result.Summary.Commits)
+
+	require.Len(t, result.Functions, 2)
+	b := result.Functions[0]
+	assert.Equal(t, "B", b.Name)
+	assert.Equal(t, 8, b.Line)
+	assert.Equal(t, 13, b.EndLine)
+	assert.Equal(t, 2, b.Cyclomatic)
+	assert.Equal(t, schema.Metric(2), b.Commits)
+	assert.Equal(t, schema.Metric(8), b.Churn) // 3 lines by alice, 3 added and 1 replaced by bob
+	assert.Equal(t, schema.Metric(2), b.Contributors)
+	assert.Equal(t, "2024-03-02", b.LastChanged.Format("2006-01-02"))
+	assert.Equal(t, schema.HotMode, b.Mode)
+	assert.Contains(t, b.Scores, schema.RiskMode)
+
+	a := result.Functions[1]
+	assert.Equal(t, "A", a.Name)
+	assert.Equal(t, schema.Metric(1), a.Commits)
+	assert.Equal(t, schema.Metric(4), a.Churn)
+	assert.Equal(t, []string{"alice"}, a.Owners)
+	assert.Greater(t, b.Score, a.Score)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetHotspotFunctionsResults_DuplicateNames(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+
+	v1 := []byte("package x\n\nfunc init() {\n\ta := 0\n\t_ = a\n}\n\nfunc init() {\n\tb := 0\n\t_ = b\n}\n")
+	v2 := []byte("package x\n\nfunc init() {\n\ta := 0\n\t_ = a\n}\n\nfunc init() {\n\tb := 1\n\t_ = b\n}\n")
+
+	// alice adds both init functions, then bob edits only the second one
+	mockClient.On("GetFilePatchLog", ctx, repo, "x.go", mock.Anything, mock.Anything).Return([]byte(
+		"DELIMITER_COMMIT_STARTc2|bob|2024-03-02T10:00:00Z|tweak b\n\n"+
+			"diff --git a/x.go b/x.go\n--- a/x.go\n+++ b/x.go\n"+
+			"@@ -9 +9 @@ func init() {\n-\tb := 0\n+\tb := 1\n"+
+			"DELIMITER_COMMIT_STARTc1|alice|2024-03-01T10:00:00Z|add x\n\n"+
+			"diff --git a/x.go b/x.go\n--- /dev/null\n+++ b/x.go\n"+
+			"@@ -0,0 +1,11 @@\n+package x\n"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "HEAD", "x.go").Return(v2, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "c2", "x.go").Return(v2, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "c1", "x.go").Return(v1, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "c1^", "x.go").Return(nil, errors.New("path does not exist"))
+
+	cfg := &config.Config{
+		Git: config.GitConfig{
+			RepoPath:   repo,
+			PathFilter: "x.go",
+			EndTime:    time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+		},
+		Scoring: config.ScoringConfig{
+			Mode:            schema.HotMode,
+			ComputedWeights: map[schema.ScoringMode]map[schema.BreakdownKey]float64{schema.HotMode: schema.GetDefaultWeights(schema.HotMode)},
+		},
+		Output: config.OutputConfig{ResultLimit: 10},
+	}
+	result, err := GetHotspotFunctionsResults(ctx, cfg, mockClient, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Functions, 2)
+
+	byLine := map[int]schema.FunctionResult{}
+	for _, fn := range result.Functions {
+		assert.Equal(t, "init", fn.Name)
+		byLine[fn.Line] = fn
+	}
+	first, second := byLine[3], byLine[8]
+	assert.Equal(t, schema.Metric(1), first.Commits)
+	assert.Equal(t, schema.Metric(4), first.Churn)
+	assert.Equal(t, []string{"alice"}, first.Owners)
+	assert.Equal(t, schema.Metric(2), second.Commits)
+	assert.Equal(t, schema.Metric(6), second.Churn) // 4 lines by alice, 1 replaced by bob
+	assert.Equal(t, schema.Metric(2), second.Contributors)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestGetHotspotFunctionsResults_PinnedEnd(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+	repo := "/test/repo"
+	end := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	// HEAD has since gained more functions, but --end stops the window at c1
+	v1 := []byte("package x\n\nfunc A() {\n\ta := 0\n\t_ = a\n}\n")
+	mockClient.On("GetCommitBefore", ctx, repo, "HEAD", end).Return("c1", nil)
+	mockClient.On("GetFilePatchLog", ctx, repo, "x.go", mock.Anything, end).Return([]byte(
+		"DELIMITER_COMMIT_STARTc1|alice|2024-03-01T10:00:00Z|add x\n\n"+
+			"diff --git a/x.go b/x.go\n--- /dev/null\n+++ b/x.go\n"+
+			"@@ -0,0 +1,6 @@\n+package x\n"), nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "c1", "x.go").Return(v1, nil)
+	mockClient.On("GetFileAtRef", ctx, repo, "c1^", "x.go").Return(nil, errors.New("path does not exist"))
+
+	cfg := &config.Config{
+		Git: config.GitConfig{
+			RepoPath:   repo,
+			PathFilter: "x.go",
+			EndTime:    end,
+			EndPinned:  true,
+		},
+		Scoring: config.ScoringConfig{
+			Mode:            schema.HotMode,
+			ComputedWeights: map[schema.ScoringMode]map[schema.BreakdownKey]float64{schema.HotMode: schema.GetDefaultWeights(schema.HotMode)},
+		},
+		Output: config.OutputConfig{ResultLimit: 10},
+	}
+	result, err := GetHotspotFunctionsResults(ctx, cfg, mockClient, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Functions, 1)
+	assert.Equal(t, "A", result.Functions[0].Name)
+	assert.Equal(t, schema.Metric(1), result.Functions[0].Commits)
+
+	mockClient.AssertExpectations(t)
+}
+
+func TestParsePatchLog(t *testing.T) {
+	commits := parsePatchLog([]byte(
+		"DELIMITER_COMMIT_STARTh2|bob|2024-03-02T10:00:00Z|subject with | pipe\n" +
+			"@@ -5 +5,0 @@\n-gone\n@@ -10,2 +9,3 @@ func F() {\n" +
+			"DELIMITER_COMMIT_STARTh1|alice|2024-03-01T10:00:00Z|init\n" +
+			"@@ bogus @@\n"))
+
+	require.Len(t, commits, 2)
+	assert.Equal(t, "h2", commits[0].hash)
+	assert.Equal(t, "bob", commits[0].author)
+	assert.Equal(t, "subject with | pipe", commits[0].subject)
+	assert.Equal(t, []patchHunk{
+		{oldStart: 5, oldLines: 1, newStart: 5, newLines: 0},
+		{oldStart: 10, oldLines: 2, newStart: 9, newLines: 3},
+	}, commits[0].hunks)
+	assert.Empty(t, commits[1].hunks)
+}
@@ -0,0 +1,146 @@
+package core
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/huangsam/hotspot/core/algo"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/internal/logger"
+	"github.com/huangsam/hotspot/schema"
+)
+
+// goModule is a Go module declared by a go.mod file in the repository.
+type goModule struct {
+	dir  string // Directory of the go.mod file ("." for the root)
+	path string // Module path
+}
+
+// importGraph is the package import graph of the Go modules in a repository at HEAD.
+// Packages are parsed on first use, so only the packages of the files being classified
+// are read.
+type importGraph struct {
+	ctx      context.Context
+	client   git.Client
+	repoPath string
+	modules  []goModule                 // Longest module path first
+	packages map[string][]string        // Package directory -> Go files
+	imports  map[string]map[string]bool // Package directory -> imported package directories
+}
+
+// newImportGraph prepares the import graph of the Go modules among files. It returns nil
+// when the repository has no go.mod outside vendor and testdata directories.
+func newImportGraph(ctx context.Context, client git.Client, repoPath string, files []string) *importGraph {
+	g := &importGraph{
+		ctx:      ctx,
+		client:   client,
+		repoPath: repoPath,
+		packages: make(map[string][]string),
+		imports:  make(map[string]map[string]bool),
+	}
+	for _, f := range files {
+		if isVendoredGo(f) {
+			continue
+		}
+		switch {
+		case path.Base(f) == "go.mod":
+			data, err := client.GetFileAtRef(ctx, repoPath, "HEAD", f)
+			if err != nil {
+				continue
+			}
+			if modulePath := schema.GoModulePath(data); modulePath != "" {
+				g.modules = append(g.modules, goModule{dir: path.Dir(f), path: modulePath})
+			}
+		case algo.IsGoSource(f):
+			dir := path.Dir(f)
+			g.packages[dir] = append(g.packages[dir], f)
+		}
+	}
+	if len(g.modules) == 0 {
+		return nil
+	}
+	sort.Slice(g.modules, func(i, j int) bool {
+		return len(g.modules[i].path) > len(g.modules[j].path)
+	})
+	return g
+}
+
+// Relation classifies the static dependency between two files. Files in one package
+// are related, and so are files whose packages import one another. Pairs that are not
+// both Go files inside a module have no relation.
+func (g *importGraph) Relation(a, b string) schema.CouplingRelation {
+	if g == nil || !g.inModule(a) || !g.inModule(b) {
+		return ""
+	}
+	dirA, dirB := path.Dir(a), path.Dir(b)
+	switch {
+	case dirA == dirB:
+		return schema.RelationSamePackage
+	case g.packageImports(dirA)[dirB] || g.packageImports(dirB)[dirA]:
+		return schema.RelationImport
+	default:
+		return schema.RelationHidden
+	}
+}
+
+// inModule reports whether a file is Go source of one of the modules.
+func (g *importGraph) inModule(file string) bool {
+	if !algo.IsGoSource(file) || isVendoredGo(file) {
+		return false
+	}
+	for _, m := range g.modules {
+		if m.dir == "." || file == m.dir || strings.HasPrefix(file, m.dir+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// packageImports returns the repository packages that the package in dir imports.
+func (g *importGraph) packageImports(dir string) map[string]bool {
+	if imports, ok := g.imports[dir]; ok {
+		return imports
+	}
+	imports := make(map[string]bool)
+	for _, f := range g.packages[dir] {
+		data, err := g.client.GetFileAtRef(g.ctx, g.repoPath, "HEAD", f)
+		if err != nil {
+			continue
+		}
+		paths, err := algo.GoImports(f, data)
+		if err != nil {
+			logger.Debug("Skipping unparsable Go file", "path", f, "error", err)
+			continue
+		}
+		for _, p := range paths {
+			if target, ok := g.resolve(p); ok {
+				imports[target] = true
+			}
+		}
+	}
+	g.imports[dir] = imports
+	return imports
+}
+
+// resolve maps an import path to the repository directory of its package.
+func (g *importGraph) resolve(importPath string) (string, bool) {
+	for _, m := range g.modules {
+		if rest, ok := strings.CutPrefix(importPath, m.path); ok && (rest == "" || rest[0] == '/') {
+			return path.Join(m.dir, rest), true
+		}
+	}
+	return "", false
+}
+
+// isVendoredGo reports whether a file belongs to vendored or test fixture code, which
+// is not part of the module's own packages.
+func isVendoredGo(file string) bool {
+	for _, part := range strings.Split(path.Dir(file), "/") {
+		if part == "vendor" || part == "testdata" {
+			return true
+		}
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/huangsam/hotspot/core/agg"
@@ -15,6 +16,27 @@ import (
 	"github.com/huangsam/hotspot/schema"
 )
 
+// maxShapeAuthors is the number of most active authors whose commit focus is reported.
+const maxShapeAuthors = 10
+
+// topAuthorFocus returns the commit focus of the most active authors, most commits first.
+func topAuthorFocus(focus map[string]schema.AuthorFocus, limit int) []schema.AuthorFocus {
+	authors := make([]schema.AuthorFocus, 0, len(focus))
+	for _, f := range focus {
+		authors = append(authors, f)
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].Commits != authors[j].Commits {
+			return authors[i].Commits > authors[j].Commits
+		}
+		return authors[i].Author < authors[j].Author
+	})
+	if len(authors) > limit {
+		authors = authors[:limit]
+	}
+	return authors
+}
+
 // recommendPreset selects the best preset based on weighted signal strengths.
 func recommendPreset(fileCount, uniqueContributors int, iacFileRatio float64) (schema.PresetName, []string) {
 	var reasons []string
@@ -111,6 +133,8 @@ func ComputeRepoShape(urn string, files []string, output *schema.AggregateOutput
 		IaCFileRatio:       iacFileRatio,
 		RecommendedPreset:  preset,
 		Reasoning:          reasons,
+		AuthorFocus:        topAuthorFocus(output.AuthorFocus, maxShapeAuthors),
+		WorkHeatmap:        output.WorkHeatmap,
 		Preset:             schema.GetPreset(preset),
 		AnalyzedAt:         time.Now().UTC(),
 	}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/huangsam/hotspot/schema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsIaCFile(t *testing.T) {
@@ -172,6 +173,21 @@ func TestComputeRepoShape(t *testing.T) {
 	assert.InDelta(t, 0.4, shape.IaCFileRatio, 0.001) // infra/main.tf and app.cf.yaml are IaC. 2/5 = 0.4
 	assert.Equal(t, schema.PresetInfra, shape.RecommendedPreset)
 	assert.NotEmpty(t, shape.Reasoning)
+	assert.Empty(t, shape.AuthorFocus)
+}
+
+func TestTopAuthorFocus(t *testing.T) {
+	focus := map[string]schema.AuthorFocus{
+		"alice": {Author: "alice", Commits: 3, Focus: 0.9},
+		"bob":   {Author: "bob", Commits: 7, Focus: 0.2},
+		"carol": {Author: "carol", Commits: 3, Focus: 0.5},
+	}
+
+	top := topAuthorFocus(focus, 2)
+	require.Len(t, top, 2)
+	assert.Equal(t, "bob", top[0].Author)
+	assert.Equal(t, "alice", top[1].Author) // Ties are broken by name
+	assert.Len(t, topAuthorFocus(focus, maxShapeAuthors), 3)
 }
 
 func BenchmarkIsIaCFile(b *testing.B) {
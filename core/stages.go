@@ -123,7 +123,8 @@ func (s *scoringStage) Execute(ac *AnalysisContext) error {
 		ac.FileResults = []schema.FileResult{}
 		return nil
 	}
-	ac.FileResults = analyzeRepo(ac.Context, ac.Git, ac.Scoring, ac.Runtime, ac.Client, ac.AggregateOutput, ac.Files)
+	ctx := withAnalysisRef(ac.Context, resolveAnalysisRef(ac.Context, ac.Git, ac.Client, ac.TargetRef))
+	ac.FileResults = analyzeRepo(ctx, ac.Git, ac.Scoring, ac.Runtime, ac.Client, ac.AggregateOutput, ac.Files)
 	return nil
 }
 
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/huangsam/hotspot/core/agg"
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/schema"
+)
+
+// GetHotspotTicketsResults lists the issue tracker tickets referenced by commits that
+// touched the configured path, ordered by the number of referencing commits. Both the
+// subject and the body of each commit are scanned.
+func GetHotspotTicketsResults(ctx context.Context, cfg *config.Config, client git.Client, limit int) (schema.TicketsResult, error) {
+	out, err := client.GetActivityLog(ctx, cfg.Git.RepoPath, cfg.Git.PathFilter, cfg.Git.StartTime, cfg.Git.EndTime)
+	if err != nil {
+		return schema.TicketsResult{}, err
+	}
+	bodyLog, err := client.GetCommitBodyLog(ctx, cfg.Git.RepoPath, cfg.Git.PathFilter, cfg.Git.StartTime, cfg.Git.EndTime)
+	if err != nil {
+		return schema.TicketsResult{}, err
+	}
+	bodies := agg.ParseCommitBodyLog(bodyLog)
+
+	classifier := cfg.Git.GetFixClassifier()
+	if classifier == nil {
+		classifier = schema.DefaultCommitClassifier()
+	}
+	extractor := cfg.Git.GetTicketExtractor()
+	if extractor == nil {
+		extractor = schema.DefaultTicketExtractor()
+	}
+	matcher := schema.NewPathMatcher(cfg.Git.Excludes)
+
+	records := make(map[string]*schema.TicketRecord)
+	ticketFiles := make(map[string]map[string]bool)
+	var currentTickets []string
+	totalCommits, linkedCommits := 0, 0
+
+	for _, l := range strings.Split(string(out), "\n") {
+		l = strings.Trim(l, " \t\r\n'")
+		if strings.HasPrefix(l, "--") {
+			// Commit header: --hash|author|date|subject
+			currentTickets = nil
+			parts := strings.SplitN(l[2:], "|", 4)
+			if len(parts) < 3 {
+				continue
+			}
+			totalCommits++
+			if len(parts) < 4 {
+				continue
+			}
+			subject := parts[3]
+			currentTickets = extractor.ExtractMessage(subject, bodies[parts[0]])
+			if len(currentTickets) == 0 {
+				continue
+			}
+			linkedCommits++
+			date, _ := time.Parse(time.RFC3339, parts[2])
+			isFix := classifier.IsFix(subject)
+			for _, key := range currentTickets {
+				record, ok := records[key]
+				if !ok {
+					record = &schema.TicketRecord{Key: key, Prefix: schema.TicketPrefix(key)}
+					records[key] = record
+					ticketFiles[key] = make(map[string]bool)
+				}
+				record.Commits++
+				if isFix {
+					record.FixCommits++
+				}
+				// Keep the subject of the latest referencing commit
+				if record.LastSeen.IsZero() || date.After(record.LastSeen) {
+					record.LastSeen = date
+					record.Subject = subject
+				}
+				if record.FirstSeen.IsZero() || date.Before(record.FirstSeen) {
+					record.FirstSeen = date
+				}
+			}
+			continue
+		}
+		if len(currentTickets) == 0 {
+			continue
+		}
+
+		// File stats line: added, deleted, path
+		parts := strings.SplitN(l, "\t", 3)
+		if len(parts) < 3 || matcher.Match(parts[2]) {
+			continue
+		}
+		add, _ := strconv.Atoi(parts[0])
+		del, _ := strconv.Atoi(parts[1])
+		for _, key := range currentTickets {
+			records[key].Churn += add + del
+			ticketFiles[key][parts[2]] = true
+		}
+	}
+
+	result := schema.TicketsResult{
+		Summary: schema.TicketsSummary{
+			Path:          cfg.Git.PathFilter,
+			TotalCommits:  totalCommits,
+			LinkedCommits: linkedCommits,
+			TotalTickets:  len(records),
+		},
+		Tickets: make([]schema.TicketRecord, 0, len(records)),
+	}
+
+	prefixes := make(map[string]*schema.TicketPrefixCount)
+	for key, record := range records {
+		record.Files = len(ticketFiles[key])
+		result.Tickets = append(result.Tickets, *record)
+
+		prefix, ok := prefixes[record.Prefix]
+		if !ok {
+			prefix = &schema.TicketPrefixCount{Prefix: record.Prefix}
+			prefixes[record.Prefix] = prefix
+		}
+		prefix.Tickets++
+		prefix.Commits += record.Commits
+	}
+	for _, prefix := range prefixes {
+		result.Summary.Prefixes = append(result.Summary.Prefixes, *prefix)
+	}
+	sort.Slice(result.Summary.Prefixes, func(i, j int) bool {
+		a, b := result.Summary.Prefixes[i], result.Summary.Prefixes[j]
+		if a.Tickets != b.Tickets {
+			return a.Tickets > b.Tickets
+		}
+		return a.Prefix < b.Prefix
+	})
+
+	sort.Slice(result.Tickets, func(i, j int) bool {
+		a, b := result.Tickets[i], result.Tickets[j]
+		if a.Commits != b.Commits {
+			return a.Commits > b.Commits
+		}
+		if !a.LastSeen.Equal(b.LastSeen) {
+			return a.LastSeen.After(b.LastSeen)
+		}
+		return a.Key < b.Key
+	})
+	if limit > 0 && len(result.Tickets) > limit {
+		result.Tickets = result.Tickets[:limit]
+	}
+
+	return result, nil
+}
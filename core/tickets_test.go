@@ -0,0 +1,95 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/huangsam/hotspot/internal/config"
+	"github.com/huangsam/hotspot/internal/git"
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetHotspotTicketsResults(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+
+	gitLog := "'--hash4|alice|2024-03-04T10:00:00Z|fix(pay): PAY-12 rounding again'\n" +
+		"3\t1\tpay/round.go\n" +
+		"'--hash3|bob|2024-03-03T10:00:00Z|docs: explain rounding'\n" +
+		"5\t0\tpay/round.go\n" +
+		"'--hash2|bob|2024-03-02T10:00:00Z|feat: PAY-7 currency support (#41)'\n" +
+		"10\t2\tpay/round.go\n" +
+		"4\t0\tpay/currency.go\n" +
+		"'--hash1|alice|2024-03-01T10:00:00Z|PAY-12 first rounding fix'\n" +
+		"2\t2\tpay/round.go\n"
+
+	cfg := &config.Config{
+		Git: config.GitConfig{
+			RepoPath:   "/test/repo",
+			PathFilter: "pay/",
+		},
+	}
+	mockClient.On("GetActivityLog", ctx, "/test/repo", "pay/", mock.Anything, mock.Anything).Return([]byte(gitLog), nil)
+	mockClient.On("GetCommitBodyLog", ctx, "/test/repo", "pay/", mock.Anything, mock.Anything).Return([]byte(""), nil)
+
+	result, err := GetHotspotTicketsResults(ctx, cfg, mockClient, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, "pay/", result.Summary.Path)
+	assert.Equal(t, 4, result.Summary.TotalCommits)
+	assert.Equal(t, 3, result.Summary.LinkedCommits)
+	assert.Equal(t, 3, result.Summary.TotalTickets)
+	assert.Equal(t, []schema.TicketPrefixCount{
+		{Prefix: "PAY", Tickets: 2, Commits: 3},
+		{Prefix: "#", Tickets: 1, Commits: 1},
+	}, result.Summary.Prefixes)
+
+	require.Len(t, result.Tickets, 3)
+	top := result.Tickets[0]
+	assert.Equal(t, "PAY-12", top.Key)
+	assert.Equal(t, 2, top.Commits)
+	assert.Equal(t, 2, top.FixCommits)
+	assert.Equal(t, 8, top.Churn)
+	assert.Equal(t, 1, top.Files)
+	assert.Equal(t, "fix(pay): PAY-12 rounding again", top.Subject)
+	assert.Equal(t, "2024-03-01", top.FirstSeen.Format("2006-01-02"))
+
+	// Ties on commits are ordered by the latest activity, then key
+	assert.Equal(t, "#41", result.Tickets[1].Key)
+	assert.Equal(t, "PAY-7", result.Tickets[2].Key)
+	assert.Equal(t, 2, result.Tickets[2].Files)
+
+	limited, err := GetHotspotTicketsResults(ctx, cfg, mockClient, 1)
+	require.NoError(t, err)
+	assert.Len(t, limited.Tickets, 1)
+	assert.Equal(t, 3, limited.Summary.TotalTickets)
+}
+
+func TestGetHotspotTicketsResults_Bodies(t *testing.T) {
+	ctx := context.Background()
+	mockClient := &git.MockGitClient{}
+
+	gitLog := "'--hash2|alice|2024-03-02T10:00:00Z|fix: guard nil payer'\n" +
+		"3\t1\tpay/payer.go\n" +
+		"'--hash1|bob|2024-03-01T10:00:00Z|PAY-3 add payer'\n" +
+		"9\t0\tpay/payer.go\n"
+	bodyLog := "\x1ehash2\x1fThe payer can be missing on refunds.\n\nFixes #567\nRefs PAY-3\n" +
+		"\x1ehash1\x1fRefs PAY-3\n"
+
+	cfg := &config.Config{Git: config.GitConfig{RepoPath: "/test/repo"}}
+	mockClient.On("GetActivityLog", ctx, "/test/repo", "", mock.Anything, mock.Anything).Return([]byte(gitLog), nil)
+	mockClient.On("GetCommitBodyLog", ctx, "/test/repo", "", mock.Anything, mock.Anything).Return([]byte(bodyLog), nil)
+
+	result, err := GetHotspotTicketsResults(ctx, cfg, mockClient, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, result.Summary.LinkedCommits)
+	require.Len(t, result.Tickets, 2)
+	assert.Equal(t, "PAY-3", result.Tickets[0].Key)
+	assert.Equal(t, 2, result.Tickets[0].Commits) // Once in a subject and once in a body
+	assert.Equal(t, "#567", result.Tickets[1].Key)
+	assert.Equal(t, 1, result.Tickets[1].FixCommits)
+}
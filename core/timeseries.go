@@ -73,7 +73,7 @@ func runTimeseriesAnalysis(
 		}
 
 		// --- Execute Analysis Core ---
-		score, owners := analyzeTimeseriesPoint(ctx, gitWin, scoringSettings, client, normalizedPath, isFolder, mgr)
+		score, owners, entropy := analyzeTimeseriesPoint(ctx, gitWin, scoringSettings, client, normalizedPath, isFolder, mgr)
 		// --- End Execute Analysis Core ---
 
 		// 4. Generate period label
@@ -88,14 +88,15 @@ func runTimeseriesAnalysis(
 		}
 
 		timeseriesPoints = append(timeseriesPoints, schema.TimeseriesPoint{
-			Period:   period,
-			Start:    startTime,
-			End:      currentEnd,
-			Score:    score,
-			Path:     normalizedPath,
-			Owners:   owners,
-			Mode:     scoringSettings.GetMode(),
-			Lookback: lookbackDuration,
+			Period:        period,
+			Start:         startTime,
+			End:           currentEnd,
+			Score:         score,
+			Path:          normalizedPath,
+			Owners:        owners,
+			Mode:          scoringSettings.GetMode(),
+			Lookback:      lookbackDuration,
+			ChangeEntropy: entropy,
 		})
 	}
 
@@ -103,6 +104,7 @@ func runTimeseriesAnalysis(
 }
 
 // analyzeTimeseriesPoint performs the analysis for a single timeseries point.
+// It returns the path's score and owners, and the repository-wide change entropy of the window.
 func analyzeTimeseriesPoint(
 	ctx context.Context,
 	gitSettings config.GitSettings,
@@ -111,7 +113,7 @@ func analyzeTimeseriesPoint(
 	path string,
 	isFolder bool,
 	mgr iocache.CacheManager,
-) (float64, []string) {
+) (float64, []string, float64) {
 	ac := &AnalysisContext{
 		Context: WithSuppressHeader(ctx), Git: gitSettings, Scoring: scoringSettings,
 		Runtime: config.RuntimeConfig{Workers: 1}, Output: config.OutputConfig{ResultLimit: 10},
@@ -122,22 +124,23 @@ func analyzeTimeseriesPoint(
 	pCfg := pipelineConfig{withTrackedAnalysis: true, withFolderAggregation: isFolder}
 	if err := executePipeline(ac, pCfg); err != nil {
 		// If no data in this window (e.g. no commits), score is 0
-		return 0, []string{}
+		return 0, []string{}, 0
 	}
+	entropy := ac.AggregateOutput.ChangeEntropy()
 
 	// Extract result
 	if isFolder {
 		for _, fr := range ac.FolderResults {
 			if fr.Path == path {
-				return fr.Score, fr.Owners
+				return fr.Score, fr.Owners, entropy
 			}
 		}
 	} else {
 		for _, fr := range ac.FileResults {
 			if fr.Path == path {
-				return fr.ModeScore, fr.Owners
+				return fr.ModeScore, fr.Owners, entropy
 			}
 		}
 	}
-	return 0, []string{}
+	return 0, []string{}, entropy
 }
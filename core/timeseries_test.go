@@ -46,6 +46,8 @@ func TestRunTimeseriesAnalysis_Success(t *testing.T) {
 	mockClient.On("ListFilesAtRef", mock.AnythingOfType("*context.valueCtx"), "/test/repo", "HEAD").Return([]string{path}, nil).Maybe()
 	mockClient.On("GetActivityLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
 		Return([]byte("--abc123|Alice|2024-01-01T00:00:00Z\n1\t0\tmain.go\n"), nil).Maybe()
+	mockClient.On("GetRevertLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil).Maybe()
+	mockClient.On("GetCommitBodyLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil).Maybe()
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -102,6 +104,8 @@ func TestRunTimeseriesAnalysis_GetOldestCommitError(t *testing.T) {
 	mockClient.On("ListFilesAtRef", mock.AnythingOfType("*context.valueCtx"), "/test/repo", "HEAD").Return([]string{path}, nil).Maybe()
 	mockClient.On("GetActivityLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
 		Return([]byte(""), nil).Maybe() // Empty log for fallback case
+	mockClient.On("GetRevertLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil).Maybe()
+	mockClient.On("GetCommitBodyLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil).Maybe()
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -146,6 +150,8 @@ func TestAnalyzeTimeseriesPoint_File(t *testing.T) {
 	mockClient.On("ListFilesAtRef", mock.AnythingOfType("*context.valueCtx"), "/test/repo", "HEAD").Return([]string{path}, nil)
 	mockClient.On("GetActivityLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
 		Return([]byte("--abc123|Alice|2024-01-01T00:00:00Z\n1\t0\tmain.go\n"), nil)
+	mockClient.On("GetRevertLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -164,10 +170,11 @@ func TestAnalyzeTimeseriesPoint_File(t *testing.T) {
 		},
 	}
 
-	score, owners := analyzeTimeseriesPoint(ctx, cfg.Git, cfg.Scoring, mockClient, path, false, mockMgr)
+	score, owners, entropy := analyzeTimeseriesPoint(ctx, cfg.Git, cfg.Scoring, mockClient, path, false, mockMgr)
 
 	assert.True(t, score >= 0 && score <= 100)
 	assert.NotNil(t, owners)
+	assert.Zero(t, entropy, "Changes to a single file have no entropy")
 
 	mockClient.AssertExpectations(t)
 	mockMgr.AssertExpectations(t)
@@ -187,6 +194,8 @@ func TestAnalyzeTimeseriesPoint_Folder(t *testing.T) {
 	mockClient.On("ListFilesAtRef", mock.AnythingOfType("*context.valueCtx"), "/test/repo", "HEAD").Return([]string{"src/main.go", "src/utils.go"}, nil)
 	mockClient.On("GetActivityLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
 		Return([]byte("--abc123|Alice|2024-01-01T00:00:00Z\n1\t0\tsrc/main.go\n2\t1\tsrc/utils.go\n"), nil)
+	mockClient.On("GetRevertLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -206,10 +215,11 @@ func TestAnalyzeTimeseriesPoint_Folder(t *testing.T) {
 		},
 	}
 
-	score, owners := analyzeTimeseriesPoint(ctx, cfg.Git, cfg.Scoring, mockClient, path, true, mockMgr)
+	score, owners, entropy := analyzeTimeseriesPoint(ctx, cfg.Git, cfg.Scoring, mockClient, path, true, mockMgr)
 
 	assert.True(t, score >= 0 && score <= 100)
 	assert.NotNil(t, owners)
+	assert.InDelta(t, 1.0, entropy, 1e-9, "One commit spread evenly over two files has maximal entropy")
 
 	mockClient.AssertExpectations(t)
 	mockMgr.AssertExpectations(t)
@@ -229,6 +239,8 @@ func TestAnalyzeTimeseriesPoint_NoData(t *testing.T) {
 	mockClient.On("ListFilesAtRef", mock.AnythingOfType("*context.valueCtx"), "/test/repo", "HEAD").Return([]string{}, nil)
 	mockClient.On("GetActivityLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
 		Return([]byte(""), nil)
+	mockClient.On("GetRevertLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -244,7 +256,7 @@ func TestAnalyzeTimeseriesPoint_NoData(t *testing.T) {
 		},
 	}
 
-	score, owners := analyzeTimeseriesPoint(ctx, cfg.Git, cfg.Scoring, mockClient, path, false, mockMgr)
+	score, owners, _ := analyzeTimeseriesPoint(ctx, cfg.Git, cfg.Scoring, mockClient, path, false, mockMgr)
 
 	assert.Equal(t, 0.0, score)
 	assert.Empty(t, owners)
@@ -267,6 +279,8 @@ func TestAnalyzeTimeseriesPoint_PathNotFound(t *testing.T) {
 	mockClient.On("ListFilesAtRef", mock.AnythingOfType("*context.valueCtx"), "/test/repo", "HEAD").Return([]string{"other.go"}, nil)
 	mockClient.On("GetActivityLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
 		Return([]byte("--abc123|Alice|2024-01-01T00:00:00Z\n1\t0\tother.go\n"), nil)
+	mockClient.On("GetRevertLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
+	mockClient.On("GetCommitBodyLog", mock.AnythingOfType("*context.valueCtx"), "/test/repo", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).Return([]byte(""), nil)
 
 	cfg := &config.Config{
 		Git: config.GitConfig{
@@ -282,7 +296,7 @@ func TestAnalyzeTimeseriesPoint_PathNotFound(t *testing.T) {
 		},
 	}
 
-	score, owners := analyzeTimeseriesPoint(ctx, cfg.Git, cfg.Scoring, mockClient, path, false, mockMgr)
+	score, owners, _ := analyzeTimeseriesPoint(ctx, cfg.Git, cfg.Scoring, mockClient, path, false, mockMgr)
 
 	assert.Equal(t, 0.0, score)
 	assert.Empty(t, owners)
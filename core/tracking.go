@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/huangsam/hotspot/internal/config"
@@ -45,6 +46,9 @@ func BatchRecordFileAnalysis(ctx context.Context, scoringSettings config.Scoring
 			RecentContributorCount: result.RecentContributors,
 			AgeDays:                result.AgeDays,
 			GiniCoefficient:        result.Gini,
+			MinorContributorCount:  result.MinorContributors,
+			TopOwnerShare:          result.TopOwnerShare,
+			OwnershipEntropy:       result.OwnershipEntropy,
 			FileOwner:              getOwnerString(result.Owners),
 			RecencySignal:          result.RecencySignal,
 			RecencyThresholdLow:    result.RecencyThresholdLow,
@@ -63,6 +67,7 @@ func BatchRecordFileAnalysis(ctx context.Context, scoringSettings config.Scoring
 			ROIScore:        allScores[schema.ROIMode],
 			ScoreLabel:      string(scoringSettings.GetMode()),
 			Reasoning:       result.Reasoning,
+			CustomScores:    customModeScores(allScores),
 		}
 
 		batchResults[i] = schema.BatchFileResult{
@@ -78,6 +83,23 @@ func BatchRecordFileAnalysis(ctx context.Context, scoringSettings config.Scoring
 	}
 }
 
+// RecordNormalizationScales records the normalization scales used by an analysis run.
+func RecordNormalizationScales(ctx context.Context, analysisID int64, scales schema.NormalizationScales) {
+	mgr := cacheManagerFromContext(ctx)
+	if mgr == nil {
+		return
+	}
+
+	analysisStore := mgr.GetAnalysisStore()
+	if analysisStore == nil {
+		return
+	}
+
+	if err := analysisStore.RecordNormalizationScales(analysisID, scales); err != nil {
+		logger.Warn("Failed to record normalization scales", err)
+	}
+}
+
 // getOwnerString converts the owners slice to a string.
 func getOwnerString(owners []string) string {
 	if len(owners) == 0 {
@@ -85,3 +107,18 @@ func getOwnerString(owners []string) string {
 	}
 	return owners[0] // Return the primary owner
 }
+
+// columnScoreModes are the modes whose scores have a dedicated column in the analysis store.
+var columnScoreModes = []schema.ScoringMode{schema.HotMode, schema.RiskMode, schema.ComplexityMode, schema.ROIMode}
+
+// customModeScores collects scores for modes that have no dedicated column in the
+// analysis store, such as defects, composites and user-defined expression modes.
+func customModeScores(allScores map[schema.ScoringMode]float64) map[schema.ScoringMode]float64 {
+	scores := make(map[schema.ScoringMode]float64)
+	for mode, score := range allScores {
+		if !slices.Contains(columnScoreModes, mode) {
+			scores[mode] = score
+		}
+	}
+	return scores
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -28,6 +29,12 @@ type GitSettings interface {
 	GetExcludes() []string
 	IsFollow() bool
 	GetRepoURN() string
+	GetTemporalModel() schema.TemporalModel
+	GetFixClassifier() *schema.CommitClassifier
+	GetTicketExtractor() *schema.TicketExtractor
+	GetTestPairing() *schema.TestPairing
+	IsWorkPatterns() bool
+	IsEndPinned() bool
 }
 
 // ScoringSettings defines requirements for algorithm and weight configuration.
@@ -38,6 +45,10 @@ type ScoringSettings interface {
 	GetRiskThresholds() map[schema.ScoringMode]float64
 	GetRecencyThresholdLow() float64
 	GetRecencyThresholdHigh() float64
+	GetScoreModifiers() []schema.ScoreModifier
+	GetNormalization() schema.NormalizationStrategy
+	GetFolderAggregation() schema.FolderAggregation
+	GetFolderThreshold() float64
 }
 
 // OutputSettings defines requirements for presentation and export configuration.
@@ -102,6 +113,13 @@ type GitConfig struct {
 	Excludes   []string
 	Follow     bool
 	RepoURN    string // Unique Identifier for the repository
+	Temporal   schema.TemporalModel
+	Fixes      *schema.CommitClassifier // Fix commit rules (nil = defaults)
+	Tickets    *schema.TicketExtractor  // Ticket key patterns (nil = defaults)
+	Tests      *schema.TestPairing      // Test pairing rules (nil = defaults)
+
+	NoWorkPatterns bool // Skip collecting the local hour and weekday of commits
+	EndPinned      bool // Whether --end set the end time, so files are read as of then
 }
 
 // GetRepoPath returns the repository path.
@@ -125,6 +143,24 @@ func (c GitConfig) IsFollow() bool { return c.Follow }
 // GetRepoURN returns the repository URN.
 func (c GitConfig) GetRepoURN() string { return c.RepoURN }
 
+// GetTemporalModel returns the recent window and decay model, filling unset fields with defaults.
+func (c GitConfig) GetTemporalModel() schema.TemporalModel { return c.Temporal.WithDefaults() }
+
+// GetFixClassifier returns the rules that classify fix commits, or nil for the defaults.
+func (c GitConfig) GetFixClassifier() *schema.CommitClassifier { return c.Fixes }
+
+// GetTicketExtractor returns the patterns that extract ticket keys, or nil for the defaults.
+func (c GitConfig) GetTicketExtractor() *schema.TicketExtractor { return c.Tickets }
+
+// GetTestPairing returns the rules that pair production files with tests, or nil for the defaults.
+func (c GitConfig) GetTestPairing() *schema.TestPairing { return c.Tests }
+
+// IsWorkPatterns returns whether to collect the local hour and weekday of commits.
+func (c GitConfig) IsWorkPatterns() bool { return !c.NoWorkPatterns }
+
+// IsEndPinned returns whether --end set the end time, rather than it defaulting to now.
+func (c GitConfig) IsEndPinned() bool { return c.EndPinned }
+
 // ScoringConfig holds algorithm and weight settings.
 type ScoringConfig struct {
 	Mode                 schema.ScoringMode
@@ -133,6 +169,10 @@ type ScoringConfig struct {
 	RiskThresholds       map[schema.ScoringMode]float64
 	RecencyThresholdLow  float64
 	RecencyThresholdHigh float64
+	ScoreModifiers       []schema.ScoreModifier
+	Normalization        schema.NormalizationStrategy
+	FolderAggregation    schema.FolderAggregation
+	FolderThreshold      float64
 }
 
 // GetMode returns the current scoring mode.
@@ -157,6 +197,19 @@ func (c ScoringConfig) GetRecencyThresholdLow() float64 { return c.RecencyThresh
 // GetRecencyThresholdHigh returns the upper threshold for recency signaling.
 func (c ScoringConfig) GetRecencyThresholdHigh() float64 { return c.RecencyThresholdHigh }
 
+// GetScoreModifiers returns the path-based score modifier rules.
+func (c ScoringConfig) GetScoreModifiers() []schema.ScoreModifier { return c.ScoreModifiers }
+
+// GetNormalization returns the strategy used to normalize metrics before weighting.
+func (c ScoringConfig) GetNormalization() schema.NormalizationStrategy { return c.Normalization }
+
+// GetFolderAggregation returns the strategy that combines file scores into folder scores.
+func (c ScoringConfig) GetFolderAggregation() schema.FolderAggregation { return c.FolderAggregation }
+
+// GetFolderThreshold returns the file score above which the excess and count aggregations
+// treat a file as a hotspot.
+func (c ScoringConfig) GetFolderThreshold() float64 { return c.FolderThreshold }
+
 // OutputConfig holds presentation and export settings.
 type OutputConfig struct {
 	ResultLimit int
@@ -260,15 +313,83 @@ func (c TimeseriesConfig) GetInterval() time.Duration { return c.Interval }
 // GetPoints returns the number of time points to analyze.
 func (c TimeseriesConfig) GetPoints() int { return c.Points }
 
+// BlastRadiusConfig holds settings for co-change coupling analysis.
+type BlastRadiusConfig struct {
+	HiddenOnly  bool
+	Level       schema.CouplingLevel
+	FolderDepth int
+	Modules     *schema.CouplingModules
+	Path        string
+	ImpactDepth int
+	GraphFormat schema.GraphFormat
+}
+
+// IsHiddenOnly returns whether to rank only pairs that no import explains.
+func (c BlastRadiusConfig) IsHiddenOnly() bool { return c.HiddenOnly }
+
+// GetLevel returns the unit whose co-changes are counted.
+func (c BlastRadiusConfig) GetLevel() schema.CouplingLevel { return c.Level }
+
+// GetFolderDepth returns how many leading directories name a folder at the folder level.
+func (c BlastRadiusConfig) GetFolderDepth() int { return c.FolderDepth }
+
+// GetModules returns the user-defined modules for the module level.
+func (c BlastRadiusConfig) GetModules() *schema.CouplingModules { return c.Modules }
+
+// GetPath returns the file, folder or module whose impact set to compute, if any.
+func (c BlastRadiusConfig) GetPath() string { return c.Path }
+
+// GetImpactDepth returns how many association rules an impact set follows.
+func (c BlastRadiusConfig) GetImpactDepth() int { return c.ImpactDepth }
+
+// GetGraphFormat returns the format to export the coupling graph in, if any.
+func (c BlastRadiusConfig) GetGraphFormat() schema.GraphFormat { return c.GraphFormat }
+
+// ArchConfig holds settings for architecture checks. The components are the coupling
+// modules of BlastRadiusConfig.
+type ArchConfig struct {
+	Tolerance int
+}
+
+// GetTolerance returns how many new disallowed component pairs a change set may add.
+func (c ArchConfig) GetTolerance() int { return c.Tolerance }
+
+// FoldersConfig holds settings for folder-level analysis.
+type FoldersConfig struct {
+	Depth int
+	Tree  bool
+}
+
+// GetDepth returns the folder depth to rank, or 0 for the immediate parent folders of files.
+func (c FoldersConfig) GetDepth() int { return c.Depth }
+
+// IsTree returns whether to print the folders as a nested tree.
+func (c FoldersConfig) IsTree() bool { return c.Tree }
+
+// IsHierarchical returns whether folders roll up the files of all their descendants.
+func (c FoldersConfig) IsHierarchical() bool { return c.Depth > 0 || c.Tree }
+
+// FunctionsConfig holds settings for function-level analysis.
+type FunctionsConfig struct {
+	Files int
+}
+
+// GetFiles returns how many top Go files to analyze when no file is given.
+func (c FunctionsConfig) GetFiles() int { return c.Files }
+
 // Config holds the runtime configuration for the analysis.
 // This struct remains the "final, validated" config.
 type Config struct {
-	Git        GitConfig
-	Scoring    ScoringConfig
-	Output     OutputConfig
-	Runtime    RuntimeConfig
-	Compare    CompareConfig
-	Timeseries TimeseriesConfig
+	Git         GitConfig
+	Scoring     ScoringConfig
+	Output      OutputConfig
+	Runtime     RuntimeConfig
+	Compare     CompareConfig
+	Timeseries  TimeseriesConfig
+	Functions   FunctionsConfig
+	Folders     FoldersConfig
+	BlastRadius BlastRadiusConfig
+	Arch        ArchConfig
 }
 
 // RawInput holds the raw inputs from all sources (flags, env, config file).
@@ -278,31 +399,42 @@ type RawInput struct {
 	RepoPathStr string
 
 	// --- Fields from rootCmd.PersistentFlags() ---
-	Filter            string `mapstructure:"filter"`
-	OutputFile        string `mapstructure:"output-file"`
-	Limit             int    `mapstructure:"limit"`
-	Start             string `mapstructure:"start"`
-	End               string `mapstructure:"end"`
-	URN               string `mapstructure:"urn"`
-	Workers           int    `mapstructure:"workers"`
-	Mode              string `mapstructure:"mode"`
-	Exclude           string `mapstructure:"exclude"`
-	Precision         int    `mapstructure:"precision"`
-	Output            string `mapstructure:"output"`
-	Owner             bool   `mapstructure:"owner"`
-	Detail            bool   `mapstructure:"detail"`
-	Width             int    `mapstructure:"width"`
-	CacheBackend      string `mapstructure:"cache-backend"`
-	CacheDBConnect    string `mapstructure:"cache-db-connect"`
-	AnalysisBackend   string `mapstructure:"analysis-backend"`
-	AnalysisDBConnect string `mapstructure:"analysis-db-connect"`
-	Color             string `mapstructure:"color"`
-	Quiet             bool   `mapstructure:"quiet"`
+	Filter            string  `mapstructure:"filter"`
+	OutputFile        string  `mapstructure:"output-file"`
+	Limit             int     `mapstructure:"limit"`
+	Start             string  `mapstructure:"start"`
+	End               string  `mapstructure:"end"`
+	URN               string  `mapstructure:"urn"`
+	Workers           int     `mapstructure:"workers"`
+	Mode              string  `mapstructure:"mode"`
+	Normalization     string  `mapstructure:"normalization"`
+	FolderAggregation string  `mapstructure:"folder-aggregation"`
+	FolderThreshold   float64 `mapstructure:"folder-threshold"`
+	Exclude           string  `mapstructure:"exclude"`
+	Precision         int     `mapstructure:"precision"`
+	Output            string  `mapstructure:"output"`
+	Owner             bool    `mapstructure:"owner"`
+	Detail            bool    `mapstructure:"detail"`
+	Width             int     `mapstructure:"width"`
+	CacheBackend      string  `mapstructure:"cache-backend"`
+	CacheDBConnect    string  `mapstructure:"cache-db-connect"`
+	AnalysisBackend   string  `mapstructure:"analysis-backend"`
+	AnalysisDBConnect string  `mapstructure:"analysis-db-connect"`
+	Color             string  `mapstructure:"color"`
+	Quiet             bool    `mapstructure:"quiet"`
+	NoWorkPatterns    bool    `mapstructure:"no-work-patterns"`
 
 	// --- Recency Thresholds ---
 	RecencyThresholdLow  float64 `mapstructure:"recency-threshold-low"`
 	RecencyThresholdHigh float64 `mapstructure:"recency-threshold-high"`
 
+	// --- Temporal Model ---
+	RecentWindowDays  int     `mapstructure:"recent-window-days"`
+	DecayHalfLifeDays float64 `mapstructure:"decay-half-life-days"`
+	DecayKernel       string  `mapstructure:"decay-kernel"`
+	ReworkWindowDays  int     `mapstructure:"rework-window-days"`
+	EntropyPeriodDays int     `mapstructure:"entropy-period-days"`
+
 	// --- Fields from filesCmd.Flags() ---
 	Explain bool `mapstructure:"explain"`
 	Follow  bool `mapstructure:"follow"`
@@ -312,11 +444,28 @@ type RawInput struct {
 	TargetRef string `mapstructure:"target-ref"`
 	Lookback  string `mapstructure:"lookback"`
 
-	// --- Fields from timeseriesCmd.Flags() ---
+	// --- Fields from timeseriesCmd.Flags() (path is also a blastRadiusCmd flag) ---
 	Path     string `mapstructure:"path"`
 	Interval string `mapstructure:"interval"`
 	Points   int    `mapstructure:"points"`
 
+	// --- Fields from functionsCmd.Flags() ---
+	Files int `mapstructure:"files"`
+
+	// --- Fields from foldersCmd.Flags() ---
+	Depth int  `mapstructure:"depth"`
+	Tree  bool `mapstructure:"tree"`
+
+	// --- Fields from blastRadiusCmd.Flags() ---
+	Hidden      bool   `mapstructure:"hidden"`
+	Level       string `mapstructure:"level"`
+	FolderDepth int    `mapstructure:"folder-depth"`
+	ImpactDepth int    `mapstructure:"impact-depth"`
+	GraphFormat string `mapstructure:"graph-format"`
+
+	// --- Fields from archCheckCmd.Flags() ---
+	Tolerance int `mapstructure:"tolerance"`
+
 	// --- Fields from checkCmd.Flags() ---
 	ThresholdsStr string `mapstructure:"thresholds-override"`
 
@@ -326,6 +475,27 @@ type RawInput struct {
 	// --- Risk thresholds from config file ---
 	Thresholds ThresholdsRawInput `mapstructure:"thresholds"`
 
+	// --- Custom composite modes from config file ---
+	Composites map[string]CompositeRawInput `mapstructure:"composites"`
+
+	// --- Score modifier rules from config file (nil = defaults) ---
+	Modifiers []ModifierRawInput `mapstructure:"modifiers"`
+
+	// --- Expression-based custom modes from config file ---
+	CustomModes map[string]ExpressionModeRawInput `mapstructure:"custom_modes"`
+
+	// --- Fix commit classification from config file ---
+	Fixes FixesRawInput `mapstructure:"fixes"`
+
+	// --- Ticket key patterns from config file ---
+	Tickets TicketsRawInput `mapstructure:"tickets"`
+
+	// --- Test pairing rules from config file ---
+	TestPairing TestPairingRawInput `mapstructure:"test_pairing"`
+
+	// --- Coupling modules from config file ---
+	Coupling CouplingRawInput `mapstructure:"coupling"`
+
 	// --- Preset override ---
 	Preset string `mapstructure:"preset"`
 }
@@ -355,6 +525,9 @@ func (c *Config) Clone() *Config {
 		clone.Scoring.RiskThresholds = make(map[schema.ScoringMode]float64)
 		maps.Copy(clone.Scoring.RiskThresholds, c.Scoring.RiskThresholds)
 	}
+	if c.Scoring.ScoreModifiers != nil {
+		clone.Scoring.ScoreModifiers = slices.Clone(c.Scoring.ScoreModifiers)
+	}
 	return &clone
 }
 
@@ -387,6 +560,12 @@ func ValidateInputs(cfg *Config, input *RawInput) error {
 	if err := ApplyPreset(cfg, schema.PresetName(input.Preset)); err != nil {
 		return err
 	}
+	if err := processCustomComposites(input); err != nil {
+		return err
+	}
+	if err := processExpressionModes(input); err != nil {
+		return err
+	}
 	if err := validateSimpleInputs(cfg, input); err != nil {
 		return err
 	}
@@ -399,12 +578,39 @@ func ValidateInputs(cfg *Config, input *RawInput) error {
 	if err := processTimeseriesMode(cfg, input); err != nil {
 		return err
 	}
+	if err := processFunctionsMode(cfg, input); err != nil {
+		return err
+	}
+	if err := processFoldersMode(cfg, input); err != nil {
+		return err
+	}
+	if err := processBlastRadiusMode(cfg, input); err != nil {
+		return err
+	}
+	if err := processArchMode(cfg, input); err != nil {
+		return err
+	}
 	if err := processCustomWeights(cfg, input); err != nil {
 		return err
 	}
 	if err := processRiskThresholds(cfg, input); err != nil {
 		return err
 	}
+	if err := processScoreModifiers(cfg, input); err != nil {
+		return err
+	}
+	if err := processTemporalModel(cfg, input); err != nil {
+		return err
+	}
+	if err := processFixClassifier(cfg, input); err != nil {
+		return err
+	}
+	if err := processTicketExtractor(cfg, input); err != nil {
+		return err
+	}
+	if err := processTestPairing(cfg, input); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -511,6 +717,7 @@ func validateSimpleInputs(cfg *Config, input *RawInput) error {
 	cfg.Output.Explain = input.Explain
 	cfg.Output.Owner = input.Owner
 	cfg.Git.Follow = input.Follow
+	cfg.Git.NoWorkPatterns = input.NoWorkPatterns
 	cfg.Git.RepoURN = input.URN
 	cfg.Output.Width = input.Width
 	cfg.Output.Quiet = input.Quiet
@@ -598,13 +805,41 @@ func validateScoringInputs(cfg *Config, input *RawInput) error {
 	// --- 3. Mode Validation ---
 	if input.Mode != "" {
 		cfg.Scoring.Mode = schema.ScoringMode(strings.ToLower(input.Mode))
-		if _, ok := schema.ValidScoringModes[cfg.Scoring.Mode]; !ok {
-			return fmt.Errorf("invalid mode '%s'. Base modes: hot (activity), risk (knowledge distribution), complexity (technical debt), roi (refactoring priority). Composite modes: active_owners, refactor_now, legacy_debt", input.Mode)
+		if !schema.IsValidScoringMode(cfg.Scoring.Mode) {
+			return fmt.Errorf("invalid mode '%s'. Base modes: hot (activity), risk (knowledge distribution), complexity (technical debt), roi (refactoring priority), defects (bug-fix density). Composite modes: %s%s", input.Mode, formatCompositeModeList(), formatExpressionModeList())
 		}
 	} else if cfg.Scoring.Mode == "" {
 		cfg.Scoring.Mode = schema.HotMode
 	}
 
+	// --- 3.2 Normalization Strategy ---
+	if input.Normalization != "" {
+		cfg.Scoring.Normalization = schema.NormalizationStrategy(strings.ToLower(input.Normalization))
+		if !schema.ValidNormalizationStrategies[cfg.Scoring.Normalization] {
+			return fmt.Errorf("invalid normalization '%s'. Must be one of: fixed (built-in maxima), percentile (95th percentile of analyzed files), log (log-scaled against the largest file)", input.Normalization)
+		}
+	} else if cfg.Scoring.Normalization == "" {
+		cfg.Scoring.Normalization = schema.FixedNormalization
+	}
+
+	// --- 3.3 Folder Aggregation Strategy ---
+	if input.FolderAggregation != "" {
+		cfg.Scoring.FolderAggregation = schema.FolderAggregation(strings.ToLower(input.FolderAggregation))
+		if !schema.ValidFolderAggregations[cfg.Scoring.FolderAggregation] {
+			return fmt.Errorf("invalid folder aggregation '%s'. Must be one of: mean (LOC-weighted mean), max (worst file), p90 (90th percentile file), excess (sum of scores above --folder-threshold), count (files above --folder-threshold)", input.FolderAggregation)
+		}
+	} else if cfg.Scoring.FolderAggregation == "" {
+		cfg.Scoring.FolderAggregation = schema.MeanFolderAggregation
+	}
+	if input.FolderThreshold < 0 || input.FolderThreshold > 100 {
+		return fmt.Errorf("--folder-threshold must be between 0 and 100")
+	}
+	if input.FolderThreshold != 0 {
+		cfg.Scoring.FolderThreshold = input.FolderThreshold
+	} else if cfg.Scoring.FolderThreshold == 0 {
+		cfg.Scoring.FolderThreshold = schema.DefaultFolderThreshold
+	}
+
 	// --- 3.5 Recency Thresholds Overrides ---
 	if input.RecencyThresholdLow != 0 {
 		cfg.Scoring.RecencyThresholdLow = input.RecencyThresholdLow
@@ -685,6 +920,7 @@ func processTimeRange(cfg *Config, input *RawInput) error {
 
 	// --- Process End Time ---
 	if input.End != "" {
+		cfg.Git.EndPinned = true
 		t, err := parseAbsolute(input.End)
 		if err == nil {
 			cfg.Git.EndTime = t
@@ -735,6 +971,49 @@ func RevalidateTimeseries(cfg *Config, intervalStr string) error {
 	return nil
 }
 
+// RevalidateBlastRadius re-parses and validates blast radius parameters. An empty level
+// or path and a zero depth leave the existing cfg values unchanged.
+func RevalidateBlastRadius(cfg *Config, levelStr, pathStr string, folderDepth, impactDepth int) error {
+	if levelStr != "" {
+		level, err := schema.ParseCouplingLevel(levelStr)
+		if err != nil {
+			return err
+		}
+		cfg.BlastRadius.Level = level
+	}
+	if cfg.BlastRadius.Level == "" {
+		cfg.BlastRadius.Level = schema.CouplingLevelFile
+	}
+	if folderDepth != 0 {
+		cfg.BlastRadius.FolderDepth = folderDepth
+	}
+	if cfg.BlastRadius.FolderDepth == 0 {
+		cfg.BlastRadius.FolderDepth = schema.DefaultCouplingFolderDepth
+	}
+	if cfg.BlastRadius.FolderDepth < 1 {
+		return fmt.Errorf("--folder-depth must be at least 1")
+	}
+	if pathStr = strings.TrimSpace(pathStr); pathStr != "" {
+		cfg.BlastRadius.Path = filepath.ToSlash(filepath.Clean(pathStr))
+	}
+	if impactDepth != 0 {
+		cfg.BlastRadius.ImpactDepth = impactDepth
+	}
+	if cfg.BlastRadius.ImpactDepth == 0 {
+		cfg.BlastRadius.ImpactDepth = schema.DefaultImpactDepth
+	}
+	if cfg.BlastRadius.ImpactDepth < 1 {
+		return fmt.Errorf("--impact-depth must be at least 1")
+	}
+	if cfg.BlastRadius.Level == schema.CouplingLevelModule && cfg.BlastRadius.Modules.Len() == 0 {
+		return fmt.Errorf("--level module requires coupling.modules in the config file")
+	}
+	if cfg.BlastRadius.HiddenOnly && cfg.BlastRadius.Level != schema.CouplingLevelFile {
+		return fmt.Errorf("--hidden only applies to --level file")
+	}
+	return nil
+}
+
 // RevalidateTimeRange re-parses and validates start/end time range parameters.
 // Both startStr and endStr accept ISO8601 absolute dates or relative expressions
 // like "30d ago" or "6 months ago". Empty strings leave the existing cfg values
@@ -800,6 +1079,11 @@ func ApplyPreset(cfg *Config, presetName schema.PresetName) error {
 	}
 	cfg.Scoring.RecencyThresholdLow = p.RecencyThresholdLow
 	cfg.Scoring.RecencyThresholdHigh = p.RecencyThresholdHigh
+	cfg.Git.Temporal = schema.TemporalModel{
+		RecentWindowDays:  p.RecentWindowDays,
+		DecayHalfLifeDays: p.DecayHalfLifeDays,
+		DecayKernel:       p.DecayKernel,
+	}
 
 	if p.Exclude != "" {
 		var custom []string
@@ -816,6 +1100,42 @@ func ApplyPreset(cfg *Config, presetName schema.PresetName) error {
 	return nil
 }
 
+// processTemporalModel applies the recent window, decay, rework and entropy settings on top of any preset values.
+func processTemporalModel(cfg *Config, input *RawInput) error {
+	if input.RecentWindowDays != 0 {
+		if input.RecentWindowDays < 1 || input.RecentWindowDays > 3650 {
+			return fmt.Errorf("recent-window-days (%d) must be between 1 and 3650", input.RecentWindowDays)
+		}
+		cfg.Git.Temporal.RecentWindowDays = input.RecentWindowDays
+	}
+	if input.DecayHalfLifeDays != 0 {
+		if input.DecayHalfLifeDays < 1 || input.DecayHalfLifeDays > 36500 {
+			return fmt.Errorf("decay-half-life-days (%v) must be between 1 and 36500", input.DecayHalfLifeDays)
+		}
+		cfg.Git.Temporal.DecayHalfLifeDays = input.DecayHalfLifeDays
+	}
+	if input.ReworkWindowDays != 0 {
+		if input.ReworkWindowDays < 1 || input.ReworkWindowDays > 365 {
+			return fmt.Errorf("rework-window-days (%d) must be between 1 and 365", input.ReworkWindowDays)
+		}
+		cfg.Git.Temporal.ReworkWindowDays = input.ReworkWindowDays
+	}
+	if input.EntropyPeriodDays != 0 {
+		if input.EntropyPeriodDays < 1 || input.EntropyPeriodDays > 365 {
+			return fmt.Errorf("entropy-period-days (%d) must be between 1 and 365", input.EntropyPeriodDays)
+		}
+		cfg.Git.Temporal.EntropyPeriodDays = input.EntropyPeriodDays
+	}
+	if input.DecayKernel != "" {
+		cfg.Git.Temporal.DecayKernel = schema.DecayKernel(strings.ToLower(input.DecayKernel))
+	}
+	if cfg.Git.Temporal.DecayKernel != "" && !schema.ValidDecayKernels[cfg.Git.Temporal.DecayKernel] {
+		return fmt.Errorf("invalid decay-kernel '%s'. Must be one of: exponential, linear, step", cfg.Git.Temporal.DecayKernel)
+	}
+	cfg.Git.Temporal = cfg.Git.Temporal.WithDefaults()
+	return nil
+}
+
 // processCompareMode handles the comparison references and lookback.
 func processCompareMode(cfg *Config, input *RawInput) error {
 	cfg.Compare.BaseRef = strings.TrimSpace(input.BaseRef)
@@ -878,12 +1198,13 @@ func processTimeseriesMode(cfg *Config, input *RawInput) error {
 func ProcessWeightsRawInput(weights WeightsRawInput, validateSum bool) (map[schema.ScoringMode]map[schema.BreakdownKey]float64, error) {
 	result := make(map[schema.ScoringMode]map[schema.BreakdownKey]float64)
 
-	modes := []schema.ScoringMode{schema.RiskMode, schema.HotMode, schema.ComplexityMode, schema.ROIMode}
+	modes := []schema.ScoringMode{schema.RiskMode, schema.HotMode, schema.ComplexityMode, schema.ROIMode, schema.DefectsMode}
 	modeWeights := map[schema.ScoringMode]*ModeWeightsRaw{
 		schema.RiskMode:       weights.Risk,
 		schema.HotMode:        weights.Hot,
 		schema.ComplexityMode: weights.Complexity,
 		schema.ROIMode:        weights.ROI,
+		schema.DefectsMode:    weights.Defects,
 	}
 
 	// Process each mode's raw weights and validate sums if required.
@@ -933,6 +1254,46 @@ func ProcessWeightsRawInput(weights WeightsRawInput, validateSum bool) (map[sche
 			modeMap[schema.BreakdownLowRecent] = *rawMode.LowRecent
 			sum += *rawMode.LowRecent
 		}
+		if rawMode.Fixes != nil {
+			modeMap[schema.BreakdownFixes] = *rawMode.Fixes
+			sum += *rawMode.Fixes
+		}
+		if rawMode.Entropy != nil {
+			modeMap[schema.BreakdownEntropy] = *rawMode.Entropy
+			sum += *rawMode.Entropy
+		}
+		if rawMode.MinorContributors != nil {
+			modeMap[schema.BreakdownMinorContrib] = *rawMode.MinorContributors
+			sum += *rawMode.MinorContributors
+		}
+		if rawMode.OwnerShare != nil {
+			modeMap[schema.BreakdownOwnerShare] = *rawMode.OwnerShare
+			sum += *rawMode.OwnerShare
+		}
+		if rawMode.OwnershipEntropy != nil {
+			modeMap[schema.BreakdownOwnershipEntropy] = *rawMode.OwnershipEntropy
+			sum += *rawMode.OwnershipEntropy
+		}
+		if rawMode.OffHours != nil {
+			modeMap[schema.BreakdownOffHours] = *rawMode.OffHours
+			sum += *rawMode.OffHours
+		}
+		if rawMode.TestGap != nil {
+			modeMap[schema.BreakdownTestGap] = *rawMode.TestGap
+			sum += *rawMode.TestGap
+		}
+		if rawMode.Indent != nil {
+			modeMap[schema.BreakdownIndent] = *rawMode.Indent
+			sum += *rawMode.Indent
+		}
+		if rawMode.Cyclomatic != nil {
+			modeMap[schema.BreakdownCyclomatic] = *rawMode.Cyclomatic
+			sum += *rawMode.Cyclomatic
+		}
+		if rawMode.Cognitive != nil {
+			modeMap[schema.BreakdownCognitive] = *rawMode.Cognitive
+			sum += *rawMode.Cognitive
+		}
 
 		// Only add to result if we have at least one weight
 		if len(modeMap) > 0 {
@@ -946,6 +1307,55 @@ func ProcessWeightsRawInput(weights WeightsRawInput, validateSum bool) (map[sche
 	return result, nil
 }
 
+// processBlastRadiusMode handles the blast radius parameters and the coupling modules.
+func processBlastRadiusMode(cfg *Config, input *RawInput) error {
+	modules := make([]schema.CouplingModule, len(input.Coupling.Modules))
+	for i, module := range input.Coupling.Modules {
+		modules[i] = schema.CouplingModule{Name: module.Name, Paths: module.Paths, Allow: module.Allow}
+	}
+	parsed, err := schema.NewCouplingModules(modules)
+	if err != nil {
+		return fmt.Errorf("invalid coupling config: %w", err)
+	}
+	cfg.BlastRadius.Modules = parsed
+	cfg.BlastRadius.HiddenOnly = input.Hidden
+	if cfg.BlastRadius.GraphFormat, err = schema.ParseGraphFormat(input.GraphFormat); err != nil {
+		return err
+	}
+	return RevalidateBlastRadius(cfg, input.Level, input.Path, input.FolderDepth, input.ImpactDepth)
+}
+
+// processFoldersMode handles the folder roll-up parameters.
+func processFoldersMode(cfg *Config, input *RawInput) error {
+	if input.Depth < 0 {
+		return fmt.Errorf("--depth must not be negative")
+	}
+	cfg.Folders.Depth = input.Depth
+	cfg.Folders.Tree = input.Tree
+	return nil
+}
+
+// processArchMode handles the architecture check parameters.
+func processArchMode(cfg *Config, input *RawInput) error {
+	if input.Tolerance < 0 {
+		return fmt.Errorf("--tolerance must not be negative")
+	}
+	cfg.Arch.Tolerance = input.Tolerance
+	return nil
+}
+
+// processFunctionsMode handles the function analysis parameters.
+func processFunctionsMode(cfg *Config, input *RawInput) error {
+	if input.Files < 0 {
+		return fmt.Errorf("--files must not be negative")
+	}
+	cfg.Functions.Files = input.Files
+	if cfg.Functions.Files == 0 {
+		cfg.Functions.Files = schema.DefaultFunctionFiles
+	}
+	return nil
+}
+
 // processCustomWeights converts the raw input into the final cfg.Scoring.CustomWeights map
 // and validates that the provided weights for any mode sum up to 1.0.
 // Also computes the final ComputedWeights for each mode.
@@ -958,7 +1368,7 @@ func processCustomWeights(cfg *Config, input *RawInput) error {
 
 	// Compute final weights for each mode
 	cfg.Scoring.ComputedWeights = make(map[schema.ScoringMode]map[schema.BreakdownKey]float64)
-	for _, mode := range []schema.ScoringMode{schema.HotMode, schema.RiskMode, schema.ComplexityMode, schema.ROIMode} {
+	for _, mode := range schema.BaseScoringModes {
 		// Start with default weights
 		defaultWeights := schema.GetDefaultWeights(mode)
 
@@ -984,14 +1394,10 @@ func processCustomWeights(cfg *Config, input *RawInput) error {
 func processRiskThresholds(cfg *Config, input *RawInput) error {
 	thresholds := make(map[schema.ScoringMode]float64)
 
-	// Set defaults first (50.0 for all modes)
-	thresholds[schema.HotMode] = 50.0
-	thresholds[schema.RiskMode] = 50.0
-	thresholds[schema.ComplexityMode] = 50.0
-	thresholds[schema.ROIMode] = 50.0
-	thresholds[schema.ActiveOwnersMode] = 50.0
-	thresholds[schema.RefactorNowMode] = 50.0
-	thresholds[schema.LegacyDebtMode] = 50.0
+	// Set defaults first (50.0 for all modes, including custom composites)
+	for _, mode := range schema.ScoringModes() {
+		thresholds[mode] = 50.0
+	}
 
 	// Override with config file values if provided
 	if input.Thresholds.Hot != nil {
@@ -1006,6 +1412,9 @@ func processRiskThresholds(cfg *Config, input *RawInput) error {
 	if input.Thresholds.ROI != nil {
 		thresholds[schema.ROIMode] = *input.Thresholds.ROI
 	}
+	if input.Thresholds.Defects != nil {
+		thresholds[schema.DefectsMode] = *input.Thresholds.Defects
+	}
 	if input.Thresholds.ActiveOwners != nil {
 		thresholds[schema.ActiveOwnersMode] = *input.Thresholds.ActiveOwners
 	}
@@ -1015,6 +1424,17 @@ func processRiskThresholds(cfg *Config, input *RawInput) error {
 	if input.Thresholds.LegacyDebt != nil {
 		thresholds[schema.LegacyDebtMode] = *input.Thresholds.LegacyDebt
 	}
+	for name, raw := range input.Thresholds.Custom {
+		mode := schema.ScoringMode(strings.ToLower(name))
+		if !schema.IsCustomScoringMode(mode) {
+			return fmt.Errorf("unknown threshold mode '%s', must be %s", name, formatThresholdModeList())
+		}
+		value, err := toFloat(raw)
+		if err != nil {
+			return fmt.Errorf("invalid threshold value for mode %s: %w", mode, err)
+		}
+		thresholds[mode] = value
+	}
 
 	// Override with command-line flag if provided (takes precedence)
 	if input.ThresholdsStr != "" {
@@ -1115,23 +1535,8 @@ func parseRiskThresholdsString(s string) (map[schema.ScoringMode]float64, error)
 		modeStr := strings.TrimSpace(keyValue[0])
 		valueStr := strings.TrimSpace(keyValue[1])
 
-		var mode schema.ScoringMode
-		switch strings.ToLower(modeStr) {
-		case "hot":
-			mode = schema.HotMode
-		case "risk":
-			mode = schema.RiskMode
-		case "complexity":
-			mode = schema.ComplexityMode
-		case "roi":
-			mode = schema.ROIMode
-		case "active_owners":
-			mode = schema.ActiveOwnersMode
-		case "refactor_now":
-			mode = schema.RefactorNowMode
-		case "legacy_debt":
-			mode = schema.LegacyDebtMode
-		default:
+		mode := schema.ScoringMode(strings.ToLower(modeStr))
+		if !schema.IsValidScoringMode(mode) {
 			return nil, fmt.Errorf("invalid mode '%s', must be %s", modeStr, formatThresholdModeList())
 		}
 
@@ -1147,8 +1552,9 @@ func parseRiskThresholdsString(s string) (map[schema.ScoringMode]float64, error)
 }
 
 func formatThresholdModeList() string {
-	modeNames := make([]string, 0, len(schema.AllScoringModes))
-	for _, mode := range schema.AllScoringModes {
+	modes := schema.ScoringModes()
+	modeNames := make([]string, 0, len(modes))
+	for _, mode := range modes {
 		modeNames = append(modeNames, string(mode))
 	}
 	if len(modeNames) == 1 {
@@ -1158,6 +1564,187 @@ func formatThresholdModeList() string {
 	return strings.Join(modeNames[:len(modeNames)-1], ", ") + " or " + modeNames[len(modeNames)-1]
 }
 
+func formatCompositeModeList() string {
+	composites := schema.CompositeScoringModes()
+	modeNames := make([]string, 0, len(composites))
+	for _, mode := range composites {
+		modeNames = append(modeNames, string(mode))
+	}
+	return strings.Join(modeNames, ", ")
+}
+
+func formatExpressionModeList() string {
+	expressions := schema.ExpressionScoringModes()
+	if len(expressions) == 0 {
+		return ""
+	}
+	modeNames := make([]string, 0, len(expressions))
+	for _, mode := range expressions {
+		modeNames = append(modeNames, string(mode))
+	}
+	return ". Expression modes: " + strings.Join(modeNames, ", ")
+}
+
+// processCustomComposites converts the composites section of the config file into
+// CompositeConfig values and registers them as scoring modes. It runs before mode
+// validation so that --mode can refer to a custom composite.
+func processCustomComposites(input *RawInput) error {
+	// Drop expression modes from a previous load so a mode can move between sections.
+	if err := schema.RegisterExpressionModes(nil); err != nil {
+		return err
+	}
+	composites := make(map[schema.ScoringMode]*schema.CompositeConfig, len(input.Composites))
+	for name, raw := range input.Composites {
+		mode := schema.ScoringMode(strings.ToLower(strings.TrimSpace(name)))
+		composite := &schema.CompositeConfig{
+			DisplayName:  raw.DisplayName,
+			Description:  raw.Description,
+			BlendWeights: make(map[schema.ScoringMode]float64, len(raw.BlendWeights)),
+		}
+		if composite.DisplayName == "" {
+			composite.DisplayName = string(mode)
+		}
+		for _, base := range raw.BaseModes {
+			composite.BaseModes = append(composite.BaseModes, schema.ScoringMode(strings.ToLower(strings.TrimSpace(base))))
+		}
+		for base, weight := range raw.BlendWeights {
+			composite.BlendWeights[schema.ScoringMode(strings.ToLower(strings.TrimSpace(base)))] = weight
+		}
+		composites[mode] = composite
+	}
+	if err := schema.RegisterCustomComposites(composites); err != nil {
+		return fmt.Errorf("invalid composites config: %w", err)
+	}
+	return nil
+}
+
+// processExpressionModes compiles the custom_modes section of the config file and
+// registers each formula as a scoring mode. Like composites, it runs before mode
+// validation so that --mode can refer to an expression mode.
+func processExpressionModes(input *RawInput) error {
+	expressions := make(map[schema.ScoringMode]*schema.ExpressionModeConfig, len(input.CustomModes))
+	for name, raw := range input.CustomModes {
+		mode := schema.ScoringMode(strings.ToLower(strings.TrimSpace(name)))
+		if _, err := schema.CompileExpression(raw.Expression); err != nil {
+			return fmt.Errorf("invalid custom mode %s: %w", mode, err)
+		}
+		expression := &schema.ExpressionModeConfig{
+			DisplayName: raw.DisplayName,
+			Description: raw.Description,
+			Expression:  strings.TrimSpace(raw.Expression),
+		}
+		if expression.DisplayName == "" {
+			expression.DisplayName = string(mode)
+		}
+		expressions[mode] = expression
+	}
+	if err := schema.RegisterExpressionModes(expressions); err != nil {
+		return fmt.Errorf("invalid custom_modes config: %w", err)
+	}
+	return nil
+}
+
+// processScoreModifiers compiles the modifiers section of the config file. When the
+// section is absent the default rules apply; an explicit empty list disables them.
+func processScoreModifiers(cfg *Config, input *RawInput) error {
+	if input.Modifiers == nil {
+		cfg.Scoring.ScoreModifiers = schema.DefaultScoreModifiers()
+		return nil
+	}
+	modifiers := make([]schema.ScoreModifier, 0, len(input.Modifiers))
+	for i, raw := range input.Modifiers {
+		name := strings.TrimSpace(raw.Name)
+		if name == "" {
+			name = fmt.Sprintf("modifier_%d", i+1)
+		}
+		modes := make([]schema.ScoringMode, 0, len(raw.Modes))
+		for _, mode := range raw.Modes {
+			modes = append(modes, schema.ScoringMode(strings.ToLower(strings.TrimSpace(mode))))
+		}
+		if raw.Multiplier == nil {
+			return fmt.Errorf("invalid modifiers config: modifier %s must set a multiplier", name)
+		}
+		modifier, err := schema.NewScoreModifier(name, strings.TrimSpace(raw.Glob), raw.Regex, modes, *raw.Multiplier)
+		if err != nil {
+			return fmt.Errorf("invalid modifiers config: %w", err)
+		}
+		modifiers = append(modifiers, modifier)
+	}
+	cfg.Scoring.ScoreModifiers = modifiers
+	return nil
+}
+
+// processFixClassifier compiles the fixes section of the config file. Omitted keywords
+// fall back to the defaults; an explicit empty list leaves only the regexes.
+func processFixClassifier(cfg *Config, input *RawInput) error {
+	if input.Fixes.Keywords == nil && len(input.Fixes.Regexes) == 0 {
+		cfg.Git.Fixes = nil
+		return nil
+	}
+	keywords := input.Fixes.Keywords
+	if keywords == nil {
+		keywords = schema.DefaultFixKeywords
+	}
+	classifier, err := schema.NewCommitClassifier(keywords, input.Fixes.Regexes)
+	if err != nil {
+		return fmt.Errorf("invalid fixes config: %w", err)
+	}
+	cfg.Git.Fixes = classifier
+	return nil
+}
+
+// processTicketExtractor compiles the tickets section of the config file. When the
+// patterns are absent the defaults apply; an explicit empty list disables extraction.
+func processTicketExtractor(cfg *Config, input *RawInput) error {
+	if input.Tickets.Patterns == nil {
+		cfg.Git.Tickets = nil
+		return nil
+	}
+	extractor, err := schema.NewTicketExtractor(input.Tickets.Patterns)
+	if err != nil {
+		return fmt.Errorf("invalid tickets config: %w", err)
+	}
+	cfg.Git.Tickets = extractor
+	return nil
+}
+
+// processTestPairing validates the test_pairing section of the config file. When the
+// rules are absent the defaults apply; an explicit empty list disables test pairing.
+func processTestPairing(cfg *Config, input *RawInput) error {
+	if input.TestPairing.Rules == nil {
+		cfg.Git.Tests = nil
+		return nil
+	}
+	rules := make([]schema.TestPairingRule, len(input.TestPairing.Rules))
+	for i, rule := range input.TestPairing.Rules {
+		rules[i] = schema.TestPairingRule{Source: rule.Source, Test: rule.Test}
+	}
+	pairing, err := schema.NewTestPairing(rules)
+	if err != nil {
+		return fmt.Errorf("invalid test_pairing config: %w", err)
+	}
+	cfg.Git.Tests = pairing
+	return nil
+}
+
+// toFloat converts a loosely-typed config value (YAML int, float or string) to float64.
+func toFloat(v any) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case float32:
+		return float64(n), nil
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case string:
+		return strconv.ParseFloat(strings.TrimSpace(n), 64)
+	default:
+		return 0, fmt.Errorf("expected a number, got %v", v)
+	}
+}
+
 // ProfileConfig holds profiling settings.
 type ProfileConfig struct {
 	Enabled bool
@@ -1170,19 +1757,30 @@ type WeightsRawInput struct {
 	Risk       *ModeWeightsRaw `mapstructure:"risk"`
 	Complexity *ModeWeightsRaw `mapstructure:"complexity"`
 	ROI        *ModeWeightsRaw `mapstructure:"roi"`
+	Defects    *ModeWeightsRaw `mapstructure:"defects"`
 }
 
 // ModeWeightsRaw holds the raw factor weights for a single mode.
 type ModeWeightsRaw struct {
-	Size            *float64 `mapstructure:"size"`
-	Age             *float64 `mapstructure:"age"`
-	Commits         *float64 `mapstructure:"commits"`
-	Contributors    *float64 `mapstructure:"contrib"`
-	InvContributors *float64 `mapstructure:"inv_contrib"`
-	Churn           *float64 `mapstructure:"churn"`
-	Gini            *float64 `mapstructure:"gini"`
-	LOC             *float64 `mapstructure:"loc"`
-	LowRecent       *float64 `mapstructure:"low_recent"`
+	Size              *float64 `mapstructure:"size"`
+	Age               *float64 `mapstructure:"age"`
+	Commits           *float64 `mapstructure:"commits"`
+	Contributors      *float64 `mapstructure:"contrib"`
+	InvContributors   *float64 `mapstructure:"inv_contrib"`
+	Churn             *float64 `mapstructure:"churn"`
+	Gini              *float64 `mapstructure:"gini"`
+	LOC               *float64 `mapstructure:"loc"`
+	LowRecent         *float64 `mapstructure:"low_recent"`
+	Fixes             *float64 `mapstructure:"fixes"`
+	Entropy           *float64 `mapstructure:"entropy"`
+	MinorContributors *float64 `mapstructure:"minor_contrib"`
+	OwnerShare        *float64 `mapstructure:"owner_share"`
+	OwnershipEntropy  *float64 `mapstructure:"ownership_entropy"`
+	OffHours          *float64 `mapstructure:"off_hours"`
+	TestGap           *float64 `mapstructure:"test_gap"`
+	Indent            *float64 `mapstructure:"indent"`
+	Cyclomatic        *float64 `mapstructure:"cyclomatic"`
+	Cognitive         *float64 `mapstructure:"cognitive"`
 }
 
 // ThresholdsRawInput holds the raw risk thresholds from the config file.
@@ -1191,7 +1789,69 @@ type ThresholdsRawInput struct {
 	Risk         *float64 `mapstructure:"risk"`
 	Complexity   *float64 `mapstructure:"complexity"`
 	ROI          *float64 `mapstructure:"roi"`
+	Defects      *float64 `mapstructure:"defects"`
 	ActiveOwners *float64 `mapstructure:"active_owners"`
 	RefactorNow  *float64 `mapstructure:"refactor_now"`
 	LegacyDebt   *float64 `mapstructure:"legacy_debt"`
+
+	// Custom captures thresholds for user-defined composite and expression modes.
+	Custom map[string]any `mapstructure:",remain"`
+}
+
+// CompositeRawInput holds a user-defined composite mode from the config file.
+type CompositeRawInput struct {
+	DisplayName  string             `mapstructure:"display_name"`
+	Description  string             `mapstructure:"description"`
+	BaseModes    []string           `mapstructure:"base_modes"`
+	BlendWeights map[string]float64 `mapstructure:"blend_weights"`
+}
+
+// ModifierRawInput holds a single score modifier rule from the config file.
+type ModifierRawInput struct {
+	Name       string   `mapstructure:"name"`
+	Glob       string   `mapstructure:"glob"`
+	Regex      string   `mapstructure:"regex"`
+	Modes      []string `mapstructure:"modes"`
+	Multiplier *float64 `mapstructure:"multiplier"`
+}
+
+// FixesRawInput holds the fix commit classification rules from the config file.
+type FixesRawInput struct {
+	Keywords []string `mapstructure:"keywords"`
+	Regexes  []string `mapstructure:"regexes"`
+}
+
+// TicketsRawInput holds the ticket key patterns from the config file.
+type TicketsRawInput struct {
+	Patterns []string `mapstructure:"patterns"`
+}
+
+// CouplingRawInput holds the coupling modules from the config file.
+type CouplingRawInput struct {
+	Modules []CouplingModuleRawInput `mapstructure:"modules"`
+}
+
+// CouplingModuleRawInput names a group of path patterns for module-level coupling.
+type CouplingModuleRawInput struct {
+	Name  string   `mapstructure:"name"`
+	Paths []string `mapstructure:"paths"`
+	Allow []string `mapstructure:"allow"`
+}
+
+// TestPairingRawInput holds the test pairing rules from the config file.
+type TestPairingRawInput struct {
+	Rules []TestPairingRuleRawInput `mapstructure:"rules"`
+}
+
+// TestPairingRuleRawInput pairs a production file pattern with a test file pattern.
+type TestPairingRuleRawInput struct {
+	Source string `mapstructure:"source"`
+	Test   string `mapstructure:"test"`
+}
+
+// ExpressionModeRawInput holds a user-defined expression mode from the config file.
+type ExpressionModeRawInput struct {
+	DisplayName string `mapstructure:"display_name"`
+	Description string `mapstructure:"description"`
+	Expression  string `mapstructure:"expression"`
 }
@@ -1248,3 +1248,398 @@ func TestRevalidateTimeseries(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateInputsRegistersCustomComposites(t *testing.T) {
+	t.Cleanup(func() { _ = schema.RegisterCustomComposites(nil) })
+
+	cfg := &Config{}
+	input := &RawInput{
+		Mode: "churny_silos",
+		Composites: map[string]CompositeRawInput{
+			"churny_silos": {
+				Description:  "Hot files owned by one person.",
+				BaseModes:    []string{"hot", "Risk"},
+				BlendWeights: map[string]float64{"hot": 0.7, "risk": 0.3},
+			},
+		},
+		Thresholds:    ThresholdsRawInput{Custom: map[string]any{"churny_silos": 65}},
+		ThresholdsStr: "hot:40",
+	}
+
+	require.NoError(t, ValidateInputs(cfg, input))
+	assert.Equal(t, schema.ScoringMode("churny_silos"), cfg.Scoring.Mode)
+	assert.Equal(t, 65.0, cfg.Scoring.RiskThresholds["churny_silos"])
+	assert.Equal(t, 40.0, cfg.Scoring.RiskThresholds[schema.HotMode])
+
+	composite := schema.GetCompositeConfig("churny_silos")
+	require.NotNil(t, composite)
+	assert.Equal(t, "churny_silos", composite.DisplayName)
+	assert.Equal(t, []schema.ScoringMode{schema.HotMode, schema.RiskMode}, composite.BaseModes)
+
+	thresholds, err := parseRiskThresholdsString("churny_silos:80")
+	require.NoError(t, err)
+	assert.Equal(t, 80.0, thresholds["churny_silos"])
+}
+
+func TestValidateInputsRejectsInvalidCustomComposites(t *testing.T) {
+	t.Cleanup(func() { _ = schema.RegisterCustomComposites(nil) })
+
+	err := ValidateInputs(&Config{}, &RawInput{
+		Composites: map[string]CompositeRawInput{
+			"lonely": {BaseModes: []string{"hot"}, BlendWeights: map[string]float64{"hot": 1}},
+		},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least two base_modes")
+
+	err = ValidateInputs(&Config{}, &RawInput{Thresholds: ThresholdsRawInput{Custom: map[string]any{"nope": 10}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown threshold mode")
+}
+
+func TestValidateInputsRegistersExpressionModes(t *testing.T) {
+	t.Cleanup(func() { _ = schema.RegisterExpressionModes(nil) })
+
+	cfg := &Config{}
+	input := &RawInput{
+		Mode: "Churny_Silos",
+		CustomModes: map[string]ExpressionModeRawInput{
+			"churny_silos": {
+				Description: "Churn concentrated in few hands.",
+				Expression:  "0.5*churn_norm + 0.5*(1-contrib_norm)",
+			},
+		},
+		Thresholds: ThresholdsRawInput{Custom: map[string]any{"churny_silos": "70"}},
+	}
+
+	require.NoError(t, ValidateInputs(cfg, input))
+	assert.Equal(t, schema.ScoringMode("churny_silos"), cfg.Scoring.Mode)
+	assert.Equal(t, 70.0, cfg.Scoring.RiskThresholds["churny_silos"])
+
+	expression := schema.GetExpressionModeConfig("churny_silos")
+	require.NotNil(t, expression)
+	assert.Equal(t, "churny_silos", expression.DisplayName)
+	assert.Equal(t, "expression", schema.GetModeType("churny_silos"))
+}
+
+func TestValidateInputsRejectsInvalidExpressionModes(t *testing.T) {
+	t.Cleanup(func() {
+		_ = schema.RegisterExpressionModes(nil)
+		_ = schema.RegisterCustomComposites(nil)
+	})
+
+	err := ValidateInputs(&Config{}, &RawInput{
+		CustomModes: map[string]ExpressionModeRawInput{"bad": {Expression: "os_exit(1)"}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown function")
+
+	err = ValidateInputs(&Config{}, &RawInput{
+		CustomModes: map[string]ExpressionModeRawInput{"hot": {Expression: "churn_norm"}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "conflicts with a built-in scoring mode")
+
+	err = ValidateInputs(&Config{}, &RawInput{
+		Composites: map[string]CompositeRawInput{
+			"dupe": {BaseModes: []string{"hot", "risk"}, BlendWeights: map[string]float64{"hot": 1, "risk": 1}},
+		},
+		CustomModes: map[string]ExpressionModeRawInput{"dupe": {Expression: "churn_norm"}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "conflicts with a composite mode")
+}
+
+func TestValidateInputsScoreModifiers(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{}))
+	assert.Len(t, cfg.Scoring.ScoreModifiers, len(schema.DefaultScoreModifiers()))
+
+	half := 0.5
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Modifiers: []ModifierRawInput{
+			{Name: "tests", Glob: "**/*_test.go", Modes: []string{"HOT", "risk"}, Multiplier: &half},
+			{Regex: `^gen/`, Multiplier: &half},
+		},
+	}))
+	require.Len(t, cfg.Scoring.ScoreModifiers, 2)
+	assert.Equal(t, []schema.ScoringMode{schema.HotMode, schema.RiskMode}, cfg.Scoring.ScoreModifiers[0].Modes)
+	assert.Equal(t, "modifier_2", cfg.Scoring.ScoreModifiers[1].Name)
+	assert.True(t, cfg.Scoring.ScoreModifiers[0].Matches("core/a_test.go", schema.HotMode))
+
+	// An explicit empty list disables the defaults.
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{Modifiers: []ModifierRawInput{}}))
+	assert.NotNil(t, cfg.Scoring.ScoreModifiers)
+	assert.Empty(t, cfg.Scoring.ScoreModifiers)
+
+	err := ValidateInputs(&Config{}, &RawInput{Modifiers: []ModifierRawInput{{Name: "nomult", Glob: "*.go"}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must set a multiplier")
+
+	err = ValidateInputs(&Config{}, &RawInput{Modifiers: []ModifierRawInput{{Name: "badmode", Glob: "*.go", Modes: []string{"nope"}, Multiplier: &half}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown mode")
+}
+
+func TestValidateInputsNormalization(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{}))
+	assert.Equal(t, schema.FixedNormalization, cfg.Scoring.GetNormalization())
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{Normalization: "Percentile"}))
+	assert.Equal(t, schema.PercentileNormalization, cfg.Scoring.GetNormalization())
+
+	err := ValidateInputs(&Config{}, &RawInput{Normalization: "zscore"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid normalization")
+}
+
+func TestValidateInputsFolderAggregation(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{}))
+	assert.Equal(t, schema.MeanFolderAggregation, cfg.Scoring.GetFolderAggregation())
+	assert.Equal(t, schema.DefaultFolderThreshold, cfg.Scoring.GetFolderThreshold())
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{FolderAggregation: "P90", FolderThreshold: 75}))
+	assert.Equal(t, schema.P90FolderAggregation, cfg.Scoring.GetFolderAggregation())
+	assert.Equal(t, 75.0, cfg.Scoring.GetFolderThreshold())
+
+	err := ValidateInputs(&Config{}, &RawInput{FolderAggregation: "median"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid folder aggregation")
+
+	err = ValidateInputs(&Config{}, &RawInput{FolderThreshold: 120})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--folder-threshold")
+}
+
+func TestValidateInputsTemporalModel(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{}))
+	assert.Equal(t, schema.DefaultTemporalModel(), cfg.Git.GetTemporalModel())
+
+	// Preset values apply, and explicit config overrides them
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{Preset: "infra", DecayKernel: "Step"}))
+	assert.Equal(t, 90, cfg.Git.Temporal.RecentWindowDays)
+	assert.Equal(t, 365.0, cfg.Git.Temporal.DecayHalfLifeDays)
+	assert.Equal(t, schema.StepDecay, cfg.Git.Temporal.DecayKernel)
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{RecentWindowDays: 7, DecayHalfLifeDays: 45}))
+	assert.Equal(t, schema.TemporalModel{RecentWindowDays: 7, DecayHalfLifeDays: 45, DecayKernel: schema.ExponentialDecay, ReworkWindowDays: schema.DefaultReworkWindowDays, EntropyPeriodDays: schema.DefaultEntropyPeriodDays}, cfg.Git.Temporal)
+
+	err := ValidateInputs(&Config{}, &RawInput{DecayKernel: "gaussian"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid decay-kernel")
+
+	err = ValidateInputs(&Config{}, &RawInput{RecentWindowDays: -3})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "recent-window-days")
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{ReworkWindowDays: 7}))
+	assert.Equal(t, 7, cfg.Git.GetTemporalModel().ReworkWindowDays)
+
+	err = ValidateInputs(&Config{}, &RawInput{ReworkWindowDays: 400})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "rework-window-days")
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{EntropyPeriodDays: 7}))
+	assert.Equal(t, 7, cfg.Git.GetTemporalModel().EntropyPeriodDays)
+
+	err = ValidateInputs(&Config{}, &RawInput{EntropyPeriodDays: -1})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "entropy-period-days")
+
+	// Change entropy can be weighted like any other factor
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Weights: WeightsRawInput{
+			Hot: &ModeWeightsRaw{Entropy: &[]float64{0.2}[0], Commits: &[]float64{0.4}[0], Churn: &[]float64{0.4}[0]},
+		},
+	}))
+	assert.Equal(t, 0.2, cfg.Scoring.ComputedWeights[schema.HotMode][schema.BreakdownEntropy])
+
+	// Ownership fragmentation factors are weighted in risk mode
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Weights: WeightsRawInput{
+			Risk: &ModeWeightsRaw{MinorContributors: &[]float64{0.3}[0], OwnerShare: &[]float64{0.3}[0], OwnershipEntropy: &[]float64{0.4}[0]},
+		},
+	}))
+	riskWeights := cfg.Scoring.ComputedWeights[schema.RiskMode]
+	assert.Equal(t, 0.3, riskWeights[schema.BreakdownMinorContrib])
+	assert.Equal(t, 0.3, riskWeights[schema.BreakdownOwnerShare])
+	assert.Equal(t, 0.4, riskWeights[schema.BreakdownOwnershipEntropy])
+}
+
+func TestValidateInputsTestPairing(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{}))
+	assert.Nil(t, cfg.Git.GetTestPairing())
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		TestPairing: TestPairingRawInput{Rules: []TestPairingRuleRawInput{{Source: "lib/*.py", Test: "tests/test_*.py"}}},
+	}))
+	require.NotNil(t, cfg.Git.GetTestPairing())
+	assert.True(t, cfg.Git.GetTestPairing().IsTest("tests/test_api.py"))
+
+	err := ValidateInputs(&Config{}, &RawInput{
+		TestPairing: TestPairingRawInput{Rules: []TestPairingRuleRawInput{{Source: "*.go", Test: "tests"}}},
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "test_pairing")
+
+	// The test gap can be weighted in risk and complexity
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Weights: WeightsRawInput{
+			Complexity: &ModeWeightsRaw{TestGap: &[]float64{0.3}[0], LOC: &[]float64{0.7}[0]},
+		},
+	}))
+	assert.Equal(t, 0.3, cfg.Scoring.ComputedWeights[schema.ComplexityMode][schema.BreakdownTestGap])
+
+	// Indentation complexity can replace LOC in complexity and roi
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Weights: WeightsRawInput{
+			ROI: &ModeWeightsRaw{Indent: &[]float64{0.4}[0], Churn: &[]float64{0.6}[0]},
+		},
+	}))
+	assert.Equal(t, 0.4, cfg.Scoring.ComputedWeights[schema.ROIMode][schema.BreakdownIndent])
+
+	// Go function complexity can be weighted in complexity, defects and roi
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Weights: WeightsRawInput{
+			Defects: &ModeWeightsRaw{Cyclomatic: &[]float64{0.2}[0], Cognitive: &[]float64{0.2}[0], Fixes: &[]float64{0.6}[0]},
+		},
+	}))
+	assert.Equal(t, 0.2, cfg.Scoring.ComputedWeights[schema.DefectsMode][schema.BreakdownCognitive])
+}
+
+func TestValidateInputsWorkPatterns(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{}))
+	assert.True(t, cfg.Git.IsWorkPatterns())
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{NoWorkPatterns: true}))
+	assert.False(t, cfg.Git.IsWorkPatterns())
+
+	// Off-hours work can be weighted in risk and defects
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Weights: WeightsRawInput{
+			Risk: &ModeWeightsRaw{OffHours: &[]float64{0.2}[0], Gini: &[]float64{0.8}[0]},
+		},
+	}))
+	assert.Equal(t, 0.2, cfg.Scoring.ComputedWeights[schema.RiskMode][schema.BreakdownOffHours])
+}
+
+func TestValidateInputsFixesAndDefects(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{Mode: "defects"}))
+	assert.Equal(t, schema.DefectsMode, cfg.Scoring.Mode)
+	assert.Nil(t, cfg.Git.GetFixClassifier())
+	assert.Equal(t, schema.GetDefaultWeights(schema.DefectsMode), cfg.Scoring.ComputedWeights[schema.DefectsMode])
+	assert.Equal(t, 50.0, cfg.Scoring.RiskThresholds[schema.DefectsMode])
+
+	// Regexes alone extend the default keywords
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{Fixes: FixesRawInput{Regexes: []string{`^INC-\d+`}}}))
+	require.NotNil(t, cfg.Git.GetFixClassifier())
+	assert.Equal(t, schema.DefaultFixKeywords, cfg.Git.Fixes.Keywords)
+	assert.True(t, cfg.Git.Fixes.IsFix("INC-12 restore quota"))
+
+	// Defects weights and the fixes factor can be customized
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Weights: WeightsRawInput{
+			Defects: &ModeWeightsRaw{Fixes: &[]float64{0.8}[0], LOC: &[]float64{0.2}[0]},
+			Risk:    &ModeWeightsRaw{Fixes: &[]float64{0.1}[0], InvContributors: &[]float64{0.9}[0]},
+		},
+		Thresholds: ThresholdsRawInput{Defects: &[]float64{35}[0]},
+	}))
+	assert.Equal(t, 0.8, cfg.Scoring.ComputedWeights[schema.DefectsMode][schema.BreakdownFixes])
+	assert.Equal(t, 0.1, cfg.Scoring.ComputedWeights[schema.RiskMode][schema.BreakdownFixes])
+	assert.Equal(t, 35.0, cfg.Scoring.RiskThresholds[schema.DefectsMode])
+
+	err := ValidateInputs(&Config{}, &RawInput{Fixes: FixesRawInput{Regexes: []string{"("}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid fixes config")
+}
+
+func TestValidateInputsTickets(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{}))
+	assert.Nil(t, cfg.Git.GetTicketExtractor())
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{Tickets: TicketsRawInput{Patterns: []string{`\bINC\d+\b`}}}))
+	require.NotNil(t, cfg.Git.GetTicketExtractor())
+	assert.Equal(t, []string{"INC42"}, cfg.Git.Tickets.Extract("INC42 restore PAY-1"))
+
+	// An explicit empty list disables extraction
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{Tickets: TicketsRawInput{Patterns: []string{}}}))
+	assert.Empty(t, cfg.Git.Tickets.Extract("PAY-1"))
+
+	err := ValidateInputs(&Config{}, &RawInput{Tickets: TicketsRawInput{Patterns: []string{"["}}})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid tickets config")
+}
+
+func TestValidateInputsBlastRadius(t *testing.T) {
+	cfg := &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{}))
+	assert.Equal(t, schema.CouplingLevelFile, cfg.BlastRadius.GetLevel())
+	assert.Equal(t, schema.DefaultCouplingFolderDepth, cfg.BlastRadius.GetFolderDepth())
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{
+		Level: "module",
+		Coupling: CouplingRawInput{Modules: []CouplingModuleRawInput{
+			{Name: "api", Paths: []string{"services/api/**"}},
+		}},
+	}))
+	assert.Equal(t, schema.CouplingLevelModule, cfg.BlastRadius.GetLevel())
+	assert.Equal(t, "api", cfg.BlastRadius.GetModules().ModuleOf("services/api/main.go"))
+
+	cfg = &Config{}
+	require.NoError(t, ValidateInputs(cfg, &RawInput{Path: "./core/../core/builder.go"}))
+	assert.Equal(t, "core/builder.go", cfg.BlastRadius.GetPath())
+	assert.Equal(t, schema.DefaultImpactDepth, cfg.BlastRadius.GetImpactDepth())
+
+	tests := []struct {
+		name  string
+		input *RawInput
+		want  string
+	}{
+		{"unknown level", &RawInput{Level: "package"}, "invalid level"},
+		{"negative depth", &RawInput{Level: "folder", FolderDepth: -1}, "--folder-depth"},
+		{"module level without modules", &RawInput{Level: "module"}, "coupling.modules"},
+		{"hidden at folder level", &RawInput{Level: "folder", Hidden: true}, "--hidden"},
+		{"negative impact depth", &RawInput{Path: "main.go", ImpactDepth: -1}, "--impact-depth"},
+		{"unknown graph format", &RawInput{GraphFormat: "png"}, "invalid graph format"},
+		{"negative tolerance", &RawInput{Tolerance: -1}, "--tolerance"},
+		{"negative folders depth", &RawInput{Depth: -1}, "--depth"},
+		{"negative function files", &RawInput{Files: -1}, "--files must not be negative"},
+		{"unknown allowed module", &RawInput{Coupling: CouplingRawInput{Modules: []CouplingModuleRawInput{{Name: "api", Paths: []string{"api/**"}, Allow: []string{"web"}}}}}, "unknown module"},
+		{"unnamed module", &RawInput{Coupling: CouplingRawInput{Modules: []CouplingModuleRawInput{{Paths: []string{"a/**"}}}}}, "coupling"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateInputs(&Config{}, tt.input)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
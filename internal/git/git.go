@@ -23,6 +23,10 @@ type Client interface {
 	// GetCommitTime returns the time of the specified Git reference (e.g., commit hash, tag, branch name).
 	GetCommitTime(ctx context.Context, repoPath string, ref string) (time.Time, error)
 
+	// GetCommitBefore returns the hash of the last commit reachable from ref that was
+	// committed at or before the given time, or "" if there is none.
+	GetCommitBefore(ctx context.Context, repoPath string, ref string, before time.Time) (string, error)
+
 	// GetRepoHash returns the current HEAD commit hash of the repository.
 	GetRepoHash(ctx context.Context, repoPath string) (string, error)
 
@@ -36,14 +40,34 @@ type Client interface {
 	// If path is non-empty, the log is restricted to that subdirectory or file.
 	GetActivityLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error)
 
+	// GetRefActivityLog returns the commit log of a revision or revision range (e.g.
+	// "main..feature") in the format of GetActivityLog, time-filtered the same way.
+	GetRefActivityLog(ctx context.Context, repoPath string, revision string, startTime, endTime time.Time) ([]byte, error)
+
 	// GetFileActivityLog returns the raw commit log output for a specific file path (supports --follow).
 	GetFileActivityLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time, follow bool) ([]byte, error)
 
+	// GetFilePatchLog returns the commit log of a single file with zero-context patches, so
+	// every hunk header gives the exact lines changed. It is time-filtered like GetActivityLog.
+	GetFilePatchLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error)
+
+	// GetRevertLog returns the hashes, one per line, of commits whose message body marks them as
+	// reverts ("This reverts commit ..."). It is restricted like GetActivityLog.
+	GetRevertLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error)
+
+	// GetCommitBodyLog returns the message bodies of commits, restricted like GetActivityLog.
+	// Each record starts with a 0x1e byte and holds the hash and the body separated by 0x1f.
+	GetCommitBodyLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error)
+
 	// --- File State / Content ---
 
 	// ListFilesAtRef returns a list of all trackable files in the repository at a specific reference.
 	ListFilesAtRef(ctx context.Context, repoPath string, ref string) ([]string, error)
 
+	// GetFileAtRef returns the contents of a file at a specific reference.
+	// It fails if the file does not exist at that reference.
+	GetFileAtRef(ctx context.Context, repoPath string, ref string, path string) ([]byte, error)
+
 	// GetChangedFilesBetweenRefs returns a list of files that changed between two Git references.
 	GetChangedFilesBetweenRefs(ctx context.Context, repoPath string, baseRef string, targetRef string) ([]string, error)
 
@@ -42,7 +42,7 @@ func (c *LocalGitClient) GetActivityLog(ctx context.Context, repoPath string, pa
 	args := []string{
 		"log",
 		"--numstat",
-		"--pretty=format:'--%H|%an|%ad'",
+		"--pretty=format:'--%H|%an|%ad|%s'",
 		"--date=iso-strict",
 	}
 	if !startTime.IsZero() {
@@ -57,6 +57,83 @@ func (c *LocalGitClient) GetActivityLog(ctx context.Context, repoPath string, pa
 	return c.Run(ctx, repoPath, args...)
 }
 
+// GetRefActivityLog implements the GitClient interface.
+func (c *LocalGitClient) GetRefActivityLog(ctx context.Context, repoPath string, revision string, startTime, endTime time.Time) ([]byte, error) {
+	args := []string{
+		"log",
+		"--numstat",
+		"--pretty=format:'--%H|%an|%ad|%s'",
+		"--date=iso-strict",
+	}
+	if !startTime.IsZero() {
+		args = append(args, fmt.Sprintf("--since=%s", startTime.Format(schema.DateTimeFormat)))
+	}
+	if !endTime.IsZero() {
+		args = append(args, fmt.Sprintf("--until=%s", endTime.Format(schema.DateTimeFormat)))
+	}
+	args = append(args, revision, "--")
+	return c.Run(ctx, repoPath, args...)
+}
+
+// GetFilePatchLog implements the GitClient interface.
+func (c *LocalGitClient) GetFilePatchLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error) {
+	args := []string{
+		"log",
+		"--patch",
+		"--unified=0",
+		"--no-color",
+		"--no-ext-diff",
+		"--pretty=format:DELIMITER_COMMIT_START%H|%an|%ad|%s",
+		"--date=iso-strict",
+	}
+	if !startTime.IsZero() {
+		args = append(args, fmt.Sprintf("--since=%s", startTime.Format(schema.DateTimeFormat)))
+	}
+	if !endTime.IsZero() {
+		args = append(args, fmt.Sprintf("--until=%s", endTime.Format(schema.DateTimeFormat)))
+	}
+	args = append(args, "--", path)
+	return c.Run(ctx, repoPath, args...)
+}
+
+// GetRevertLog implements the GitClient interface.
+func (c *LocalGitClient) GetRevertLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error) {
+	args := []string{
+		"log",
+		"--pretty=format:%H",
+		"--fixed-strings",
+		"--grep=" + schema.RevertBodyMarker,
+	}
+	if !startTime.IsZero() {
+		args = append(args, fmt.Sprintf("--since=%s", startTime.Format(schema.DateTimeFormat)))
+	}
+	if !endTime.IsZero() {
+		args = append(args, fmt.Sprintf("--until=%s", endTime.Format(schema.DateTimeFormat)))
+	}
+	if path != "" {
+		args = append(args, "--", path)
+	}
+	return c.Run(ctx, repoPath, args...)
+}
+
+// GetCommitBodyLog implements the GitClient interface.
+func (c *LocalGitClient) GetCommitBodyLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error) {
+	args := []string{
+		"log",
+		"--pretty=format:%x1e%H%x1f%b",
+	}
+	if !startTime.IsZero() {
+		args = append(args, fmt.Sprintf("--since=%s", startTime.Format(schema.DateTimeFormat)))
+	}
+	if !endTime.IsZero() {
+		args = append(args, fmt.Sprintf("--until=%s", endTime.Format(schema.DateTimeFormat)))
+	}
+	if path != "" {
+		args = append(args, "--", path)
+	}
+	return c.Run(ctx, repoPath, args...)
+}
+
 // GetCommitTime implements the GitClient interface.
 func (c *LocalGitClient) GetCommitTime(ctx context.Context, repoPath string, ref string) (time.Time, error) {
 	args := []string{
@@ -73,6 +150,15 @@ func (c *LocalGitClient) GetCommitTime(ctx context.Context, repoPath string, ref
 	return time.Parse(time.RFC3339, dateStr)
 }
 
+// GetCommitBefore implements the GitClient interface.
+func (c *LocalGitClient) GetCommitBefore(ctx context.Context, repoPath string, ref string, before time.Time) (string, error) {
+	out, err := c.Run(ctx, repoPath, "rev-list", "-1", "--before="+before.Format(time.RFC3339), ref)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
 // GetRepoHash implements the GitClient interface.
 func (c *LocalGitClient) GetRepoHash(ctx context.Context, repoPath string) (string, error) {
 	out, err := c.Run(ctx, repoPath, "rev-parse", "HEAD")
@@ -102,7 +188,7 @@ func (c *LocalGitClient) GetRootCommitHash(ctx context.Context, repoPath string)
 func (c *LocalGitClient) GetFileActivityLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time, follow bool) ([]byte, error) {
 	args := []string{
 		"log",
-		"--pretty=format:DELIMITER_COMMIT_START%an|%ad",
+		"--pretty=format:DELIMITER_COMMIT_START%an|%ad|%s",
 		"--date=iso-strict",
 		"--numstat",
 	}
@@ -148,6 +234,11 @@ func (c *LocalGitClient) ListFilesAtRef(ctx context.Context, repoPath string, re
 	return files, nil
 }
 
+// GetFileAtRef implements the GitClient interface.
+func (c *LocalGitClient) GetFileAtRef(ctx context.Context, repoPath string, ref string, path string) ([]byte, error) {
+	return c.Run(ctx, repoPath, "show", ref+":"+path)
+}
+
 // GetChangedFilesBetweenRefs implements the GitClient interface.
 // It returns files that have changed between baseRef and targetRef.
 // Uses Git's ".." (two-dot) range syntax which shows commits reachable from
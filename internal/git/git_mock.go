@@ -40,6 +40,13 @@ func (m *MockGitClient) GetCommitTime(ctx context.Context, repoPath string, ref
 	return t, ret.Error(1)
 }
 
+// GetCommitBefore implements the GitClient interface.
+func (m *MockGitClient) GetCommitBefore(ctx context.Context, repoPath string, ref string, before time.Time) (string, error) {
+	ret := m.Called(ctx, repoPath, ref, before)
+	hash, _ := ret.Get(0).(string)
+	return hash, ret.Error(1)
+}
+
 // GetRepoHash implements the GitClient interface.
 func (m *MockGitClient) GetRepoHash(ctx context.Context, repoPath string) (string, error) {
 	ret := m.Called(ctx, repoPath)
@@ -54,6 +61,34 @@ func (m *MockGitClient) GetRootCommitHash(ctx context.Context, repoPath string)
 	return hash, ret.Error(1)
 }
 
+// GetRefActivityLog implements the GitClient interface.
+func (m *MockGitClient) GetRefActivityLog(ctx context.Context, repoPath string, revision string, startTime, endTime time.Time) ([]byte, error) {
+	ret := m.Called(ctx, repoPath, revision, startTime, endTime)
+	output, _ := ret.Get(0).([]byte)
+	return output, ret.Error(1)
+}
+
+// GetFilePatchLog implements the GitClient interface.
+func (m *MockGitClient) GetFilePatchLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error) {
+	ret := m.Called(ctx, repoPath, path, startTime, endTime)
+	output, _ := ret.Get(0).([]byte)
+	return output, ret.Error(1)
+}
+
+// GetRevertLog implements the GitClient interface.
+func (m *MockGitClient) GetRevertLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error) {
+	ret := m.Called(ctx, repoPath, path, startTime, endTime)
+	output, _ := ret.Get(0).([]byte)
+	return output, ret.Error(1)
+}
+
+// GetCommitBodyLog implements the GitClient interface.
+func (m *MockGitClient) GetCommitBodyLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time) ([]byte, error) {
+	ret := m.Called(ctx, repoPath, path, startTime, endTime)
+	output, _ := ret.Get(0).([]byte)
+	return output, ret.Error(1)
+}
+
 // GetFileActivityLog implements the GitClient interface.
 func (m *MockGitClient) GetFileActivityLog(ctx context.Context, repoPath string, path string, startTime, endTime time.Time, follow bool) ([]byte, error) {
 	ret := m.Called(ctx, repoPath, path, startTime, endTime, follow)
@@ -75,6 +110,13 @@ func (m *MockGitClient) ListFilesAtRef(ctx context.Context, repoPath string, ref
 	return files, ret.Error(1)
 }
 
+// GetFileAtRef implements the GitClient interface.
+func (m *MockGitClient) GetFileAtRef(ctx context.Context, repoPath string, ref string, path string) ([]byte, error) {
+	ret := m.Called(ctx, repoPath, ref, path)
+	output, _ := ret.Get(0).([]byte)
+	return output, ret.Error(1)
+}
+
 // GetChangedFilesBetweenRefs implements the GitClient interface.
 func (m *MockGitClient) GetChangedFilesBetweenRefs(ctx context.Context, repoPath string, baseRef string, targetRef string) ([]string, error) {
 	ret := m.Called(ctx, repoPath, baseRef, targetRef)
@@ -238,6 +238,97 @@ func TestLocalGitClient_ListFilesAtRef(t *testing.T) {
 	assert.Error(t, err, "ListFilesAtRef should return an error for invalid ref")
 }
 
+// TestLocalGitClient_GetFileAtRef tests the GetFileAtRef method.
+func TestLocalGitClient_GetFileAtRef(t *testing.T) {
+	skipIfGitNotAvailable(t)
+
+	client := NewLocalGitClient()
+	ctx := context.Background()
+
+	repoRoot, err := client.GetRepoRoot(ctx, ".")
+	assert.NoError(t, err, "GetRepoRoot should not return an error")
+
+	content, err := client.GetFileAtRef(ctx, repoRoot, "HEAD", "go.mod")
+	assert.NoError(t, err, "GetFileAtRef should read go.mod at HEAD")
+	assert.Contains(t, string(content), "module github.com/huangsam/hotspot")
+
+	_, err = client.GetFileAtRef(ctx, repoRoot, "HEAD", "does/not/exist.txt")
+	assert.Error(t, err)
+}
+
+// TestLocalGitClient_GetCommitBefore tests the GetCommitBefore method.
+func TestLocalGitClient_GetCommitBefore(t *testing.T) {
+	skipIfGitNotAvailable(t)
+
+	client := NewLocalGitClient()
+	ctx := context.Background()
+
+	repoRoot, err := client.GetRepoRoot(ctx, ".")
+	assert.NoError(t, err, "GetRepoRoot should not return an error")
+
+	head, err := client.GetRepoHash(ctx, repoRoot)
+	assert.NoError(t, err)
+	hash, err := client.GetCommitBefore(ctx, repoRoot, "HEAD", time.Now().Add(time.Hour))
+	assert.NoError(t, err, "GetCommitBefore should resolve a commit before now")
+	assert.Equal(t, head, hash)
+
+	hash, err = client.GetCommitBefore(ctx, repoRoot, "HEAD", time.Unix(0, 0))
+	assert.NoError(t, err)
+	assert.Empty(t, hash, "No commit predates the epoch")
+}
+
+// TestLocalGitClient_GetCommitBodyLog tests the GetCommitBodyLog method.
+func TestLocalGitClient_GetCommitBodyLog(t *testing.T) {
+	skipIfGitNotAvailable(t)
+
+	client := NewLocalGitClient()
+	ctx := context.Background()
+
+	repoRoot, err := client.GetRepoRoot(ctx, ".")
+	assert.NoError(t, err, "GetRepoRoot should not return an error")
+
+	head, err := client.GetRepoHash(ctx, repoRoot)
+	assert.NoError(t, err)
+	out, err := client.GetCommitBodyLog(ctx, repoRoot, "", time.Time{}, time.Time{})
+	assert.NoError(t, err, "GetCommitBodyLog should not return an error")
+	assert.True(t, strings.HasPrefix(string(out), "\x1e"+head+"\x1f"), "Records start with the newest commit")
+}
+
+// TestLocalGitClient_GetFilePatchLog tests the GetFilePatchLog method.
+func TestLocalGitClient_GetFilePatchLog(t *testing.T) {
+	skipIfGitNotAvailable(t)
+
+	client := NewLocalGitClient()
+	ctx := context.Background()
+
+	repoRoot, err := client.GetRepoRoot(ctx, ".")
+	assert.NoError(t, err, "GetRepoRoot should not return an error")
+
+	out, err := client.GetFilePatchLog(ctx, repoRoot, "go.mod", time.Time{}, time.Time{})
+	assert.NoError(t, err, "GetFilePatchLog should read the history of go.mod")
+	assert.Contains(t, string(out), "DELIMITER_COMMIT_START")
+	assert.Contains(t, string(out), "@@ ")
+}
+
+// TestLocalGitClient_GetRefActivityLog tests the GetRefActivityLog method.
+func TestLocalGitClient_GetRefActivityLog(t *testing.T) {
+	skipIfGitNotAvailable(t)
+
+	client := NewLocalGitClient()
+	ctx := context.Background()
+
+	repoRoot, err := client.GetRepoRoot(ctx, ".")
+	assert.NoError(t, err, "GetRepoRoot should not return an error")
+
+	out, err := client.GetRefActivityLog(ctx, repoRoot, "HEAD", time.Time{}, time.Time{})
+	assert.NoError(t, err, "GetRefActivityLog should read the history of HEAD")
+	assert.Contains(t, string(out), "--")
+
+	out, err = client.GetRefActivityLog(ctx, repoRoot, "HEAD..HEAD", time.Time{}, time.Time{})
+	assert.NoError(t, err, "GetRefActivityLog should accept a revision range")
+	assert.Empty(t, out)
+}
+
 // TestLocalGitClient_GetOldestCommitDateForPath tests the GetOldestCommitDateForPath method.
 func TestLocalGitClient_GetOldestCommitDateForPath(t *testing.T) {
 	skipIfGitNotAvailable(t)
@@ -17,11 +17,18 @@ func LogAnalysisHeader(git config.GitSettings, scoring config.ScoringSettings, _
 		repoName = "current"
 	}
 
-	// Line 1: The analysis summary (Repo and Mode)
-	fmt.Fprintf(os.Stderr, "Repo: %s (Mode: %s)\n", repoName, scoring.GetMode())
+	// Line 1: The analysis summary (Repo and Mode, plus the normalization strategy when not fixed)
+	if n := scoring.GetNormalization(); n != "" && n != schema.FixedNormalization {
+		fmt.Fprintf(os.Stderr, "Repo: %s (Mode: %s, Normalization: %s)\n", repoName, scoring.GetMode(), n)
+	} else {
+		fmt.Fprintf(os.Stderr, "Repo: %s (Mode: %s)\n", repoName, scoring.GetMode())
+	}
 
 	// Line 2: The actual date range being analyzed
 	fmt.Fprintf(os.Stderr, "Range: %s → %s\n", git.GetStartTime().Format(schema.DateTimeFormat), git.GetEndTime().Format(schema.DateTimeFormat))
+
+	// Line 3: The temporal model behind recent and decayed metrics
+	fmt.Fprintf(os.Stderr, "Temporal: %s\n", git.GetTemporalModel())
 }
 
 // LogTimeseriesHeader prints a header for timeseries analysis.
@@ -32,6 +39,7 @@ func LogTimeseriesHeader(git config.GitSettings, scoring config.ScoringSettings,
 	}
 	fmt.Fprintf(os.Stderr, "Repo: %s (Mode: %s)\n", repoName, scoring.GetMode())
 	fmt.Fprintf(os.Stderr, "Timeseries: %d data points (interval: %v)\n", timeseries.GetPoints(), timeseries.GetInterval())
+	fmt.Fprintf(os.Stderr, "Temporal: %s\n", git.GetTemporalModel())
 }
 
 // LogCompareHeader prints a header for comparison analysis.
@@ -42,4 +50,5 @@ func LogCompareHeader(git config.GitSettings, scoring config.ScoringSettings, co
 	}
 	fmt.Fprintf(os.Stderr, "Repo: %s (Mode: %s)\n", repoName, scoring.GetMode())
 	fmt.Fprintf(os.Stderr, "Comparing: %s ↔ %s (lookback: %v)\n", compare.GetBaseRef(), compare.GetTargetRef(), compare.GetLookback())
+	fmt.Fprintf(os.Stderr, "Temporal: %s\n", git.GetTemporalModel())
 }
@@ -193,6 +193,23 @@ func (as *AnalysisStoreImpl) UpdateAnalysisRunURN(analysisID int64, urn string)
 	return err
 }
 
+// RecordNormalizationScales stores the normalization scales used by an analysis run as JSON.
+func (as *AnalysisStoreImpl) RecordNormalizationScales(analysisID int64, scales schema.NormalizationScales) error {
+	// Skip for NoneBackend
+	if as.db == nil || as.dialect == nil {
+		return nil
+	}
+
+	scalesJSON, err := json.Marshal(scales)
+	if err != nil {
+		return fmt.Errorf("failed to marshal normalization scales: %w", err)
+	}
+
+	query := as.dialect.GetUpdateNormalizationQuery(analysisRunsTable)
+	_, err = as.db.Exec(query, string(scalesJSON), analysisID)
+	return err
+}
+
 // RecordFileMetricsAndScores stores both raw git metrics and final scores for a file in one operation.
 func (as *AnalysisStoreImpl) RecordFileMetricsAndScores(analysisID int64, filePath string, metrics schema.FileMetrics, scores schema.FileScores) error {
 	return as.RecordFileResultsBatch(analysisID, []schema.BatchFileResult{
@@ -328,7 +345,7 @@ func (as *AnalysisStoreImpl) GetAnalysisRuns(filter schema.AnalysisQueryFilter)
 	}
 
 	quotedTableName := as.dialect.QuoteIdentifier(analysisRunsTable)
-	query := fmt.Sprintf("SELECT analysis_id, start_time, end_time, run_duration_ms, total_files_analyzed, config_params, urn FROM %s", quotedTableName)
+	query := fmt.Sprintf("SELECT analysis_id, start_time, end_time, run_duration_ms, total_files_analyzed, config_params, urn, normalization_scales FROM %s", quotedTableName)
 
 	var args []any
 	argIdx := 1 // For PostgreSQL $N placeholders
@@ -391,7 +408,8 @@ func (as *AnalysisStoreImpl) GetFileScoresMetrics(filter schema.AnalysisQueryFil
     contributor_count, recent_commits, recent_churn, recent_lines_added, recent_lines_deleted, recent_contributor_count,
     age_days, gini_coefficient, file_owner,
     score_hot, score_risk, score_complexity, score_roi, score_label, reasoning,
-    recency_signal, recency_threshold_low, recency_threshold_high
+    recency_signal, recency_threshold_low, recency_threshold_high, custom_scores,
+    minor_contributor_count, top_owner_share, ownership_entropy
     FROM %s`, quotedTableName)
 
 	var args []any
@@ -1,6 +1,7 @@
 package iocache
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
@@ -447,10 +448,12 @@ func TestAnalysisStore_RecordFileResultsBatch(t *testing.T) {
 				AnalysisTime: now, TotalCommits: 10, TotalChurn: 100, LinesAdded: 60, LinesDeleted: 40,
 				DecayedCommits: 10, DecayedChurn: 100, LinesOfCode: 200, ContributorCount: 2,
 				AgeDays: 30, GiniCoefficient: 0.1, FileOwner: "alice",
+				MinorContributorCount: 3, TopOwnerShare: 0.4, OwnershipEntropy: 1.5,
 			},
 			Scores: schema.FileScores{
 				AnalysisTime: now, HotScore: 50, RiskScore: 30, ComplexityScore: 40, ROIScore: 20,
 				ScoreLabel: "hot", Reasoning: []string{"active"},
+				CustomScores: map[schema.ScoringMode]float64{"churny_silos": 42.5},
 			},
 		},
 		{
@@ -492,6 +495,10 @@ func TestAnalysisStore_RecordFileResultsBatch(t *testing.T) {
 	assert.Equal(t, schema.Metric(10), f1.TotalCommits)
 	assert.Equal(t, 50.0, f1.ScoreHot)
 	assert.Equal(t, []string{"active"}, f1.Reasoning)
+	assert.Equal(t, map[schema.ScoringMode]float64{"churny_silos": 42.5}, f1.CustomScores)
+	assert.Equal(t, schema.Metric(3), f1.MinorContributorCount)
+	assert.Equal(t, 0.4, f1.TopOwnerShare)
+	assert.Equal(t, 1.5, f1.OwnershipEntropy)
 
 	// Verify file2
 	var f2 schema.FileScoresMetricsRecord
@@ -749,3 +756,25 @@ func TestAnalysisStore_PruneOrphanedRuns(t *testing.T) {
 	assert.True(t, ids[id3])
 	assert.False(t, ids[id4])
 }
+
+func TestAnalysisStore_RecordNormalizationScales(t *testing.T) {
+	store, err := NewAnalysisStore(schema.SQLiteBackend, ":memory:")
+	require.NoError(t, err)
+	require.NoError(t, store.Initialize(nil))
+	defer func() { _ = store.Close() }()
+
+	analysisID, err := store.BeginAnalysis("test-urn", time.Now(), map[string]any{"mode": "hot"})
+	require.NoError(t, err)
+
+	scales := schema.NormalizationScales{Strategy: schema.PercentileNormalization, Percentile: 95, Commits: 42, Churn: 900}
+	require.NoError(t, store.RecordNormalizationScales(analysisID, scales))
+
+	runs, err := store.GetAllAnalysisRuns()
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.NotNil(t, runs[0].NormalizationScales)
+
+	var stored schema.NormalizationScales
+	require.NoError(t, json.Unmarshal([]byte(*runs[0].NormalizationScales), &stored))
+	assert.Equal(t, scales, stored)
+}
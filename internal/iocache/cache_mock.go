@@ -127,6 +127,12 @@ func (m *MockAnalysisStore) UpdateAnalysisRunURN(analysisID int64, urn string) e
 	return args.Error(0)
 }
 
+// RecordNormalizationScales implements the AnalysisStore interface.
+func (m *MockAnalysisStore) RecordNormalizationScales(analysisID int64, scales schema.NormalizationScales) error {
+	args := m.Called(analysisID, scales)
+	return args.Error(0)
+}
+
 // GetAnalysisRuns implements the AnalysisStore interface.
 func (m *MockAnalysisStore) GetAnalysisRuns(filter schema.AnalysisQueryFilter) ([]schema.AnalysisRunRecord, error) {
 	args := m.Called(filter)
@@ -43,6 +43,9 @@ type SQLQueryDialect interface {
 	// GetUpdateURNQuery returns the query to update the URN of an analysis run.
 	GetUpdateURNQuery(tableName string) string
 
+	// GetUpdateNormalizationQuery returns the query to record the normalization scales of an analysis run.
+	GetUpdateNormalizationQuery(tableName string) string
+
 	// RecordFileMetricsAndScores inserts metrics and scores for a specific file.
 	RecordFileMetricsAndScores(db *sql.DB, tableName string, analysisID int64, filePath string, metrics schema.FileMetrics, scores schema.FileScores) error
 
@@ -68,8 +68,9 @@ func (d *PostgresDialect) RecordFileResultsBatch(db *sql.DB, tableName string, a
 						 contributor_count, recent_commits, recent_churn, recent_lines_added, recent_lines_deleted, recent_contributor_count,
 						 age_days, gini_coefficient, file_owner,
 						 score_hot, score_risk, score_complexity, score_roi, score_label, reasoning,
-						 recency_signal, recency_threshold_low, recency_threshold_high)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28)
+						 recency_signal, recency_threshold_low, recency_threshold_high, custom_scores,
+						 minor_contributor_count, top_owner_share, ownership_entropy)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32)
 	`, d.QuoteIdentifier(tableName))
 
 	stmt, err := tx.Prepare(query)
@@ -80,12 +81,14 @@ func (d *PostgresDialect) RecordFileResultsBatch(db *sql.DB, tableName string, a
 
 	for _, res := range results {
 		reasoningJSON, _ := json.Marshal(res.Scores.Reasoning)
+		customScoresJSON, _ := json.Marshal(res.Scores.CustomScores)
 		_, err := stmt.Exec(
 			analysisID, res.Path, d.FormatTime(res.Metrics.AnalysisTime), res.Metrics.TotalCommits, res.Metrics.TotalChurn, res.Metrics.LinesAdded, res.Metrics.LinesDeleted, res.Metrics.DecayedCommits, res.Metrics.DecayedChurn, res.Metrics.LinesOfCode,
 			res.Metrics.ContributorCount, res.Metrics.RecentCommits, res.Metrics.RecentChurn, res.Metrics.RecentLinesAdded, res.Metrics.RecentLinesDeleted, res.Metrics.RecentContributorCount,
 			res.Metrics.AgeDays, res.Metrics.GiniCoefficient, res.Metrics.FileOwner,
 			res.Scores.HotScore, res.Scores.RiskScore, res.Scores.ComplexityScore, res.Scores.ROIScore, res.Scores.ScoreLabel, reasoningJSON,
-			res.Metrics.RecencySignal, res.Metrics.RecencyThresholdLow, res.Metrics.RecencyThresholdHigh,
+			res.Metrics.RecencySignal, res.Metrics.RecencyThresholdLow, res.Metrics.RecencyThresholdHigh, customScoresJSON,
+			res.Metrics.MinorContributorCount, res.Metrics.TopOwnerShare, res.Metrics.OwnershipEntropy,
 		)
 		if err != nil {
 			return err
@@ -117,7 +120,7 @@ func (d *PostgresDialect) ScanOldestRunTime(row *sql.Row) (time.Time, error) {
 // ScanAnalysisRunRecord parses a full analysis run record from PostgreSQL rows.
 func (d *PostgresDialect) ScanAnalysisRunRecord(rows *sql.Rows, record *schema.AnalysisRunRecord) error {
 	var urn *string
-	if err := rows.Scan(&record.AnalysisID, &record.StartTime, &record.EndTime, &record.RunDurationMs, &record.TotalFilesAnalyzed, &record.ConfigParams, &urn); err != nil {
+	if err := rows.Scan(&record.AnalysisID, &record.StartTime, &record.EndTime, &record.RunDurationMs, &record.TotalFilesAnalyzed, &record.ConfigParams, &urn, &record.NormalizationScales); err != nil {
 		return err
 	}
 	if urn != nil {
@@ -128,19 +131,23 @@ func (d *PostgresDialect) ScanAnalysisRunRecord(rows *sql.Rows, record *schema.A
 
 // ScanFileScoresMetricsRecord parses a full file metrics and scores record from PostgreSQL rows.
 func (d *PostgresDialect) ScanFileScoresMetricsRecord(rows *sql.Rows, record *schema.FileScoresMetricsRecord) error {
-	var reasoningJSON []byte
+	var reasoningJSON, customScoresJSON []byte
 	if err := rows.Scan(&record.AnalysisID, &record.FilePath, &record.AnalysisTime, &record.TotalCommits,
 		&record.TotalChurn, &record.LinesAdded, &record.LinesDeleted, &record.DecayedCommits, &record.DecayedChurn, &record.LinesOfCode, &record.ContributorCount,
 		&record.RecentCommits, &record.RecentChurn, &record.RecentLinesAdded, &record.RecentLinesDeleted, &record.RecentContributorCount,
 		&record.AgeDays, &record.GiniCoefficient,
 		&record.FileOwner, &record.ScoreHot, &record.ScoreRisk, &record.ScoreComplexity, &record.ScoreROI,
 		&record.ScoreLabel, &reasoningJSON,
-		&record.RecencySignal, &record.RecencyThresholdLow, &record.RecencyThresholdHigh); err != nil {
+		&record.RecencySignal, &record.RecencyThresholdLow, &record.RecencyThresholdHigh, &customScoresJSON,
+		&record.MinorContributorCount, &record.TopOwnerShare, &record.OwnershipEntropy); err != nil {
 		return err
 	}
 	if len(reasoningJSON) > 0 {
 		_ = json.Unmarshal(reasoningJSON, &record.Reasoning)
 	}
+	if len(customScoresJSON) > 0 {
+		_ = json.Unmarshal(customScoresJSON, &record.CustomScores)
+	}
 	return nil
 }
 
@@ -163,3 +170,8 @@ func (d *PostgresDialect) GetSelectStartTimeQuery(tableName string) string {
 func (d *PostgresDialect) GetUpdateURNQuery(tableName string) string {
 	return fmt.Sprintf(`UPDATE %s SET urn = $1 WHERE analysis_id = $2`, d.QuoteIdentifier(tableName))
 }
+
+// GetUpdateNormalizationQuery returns the PostgreSQL-specific query for recording normalization scales.
+func (d *PostgresDialect) GetUpdateNormalizationQuery(tableName string) string {
+	return fmt.Sprintf(`UPDATE %s SET normalization_scales = $1 WHERE analysis_id = $2`, d.QuoteIdentifier(tableName))
+}
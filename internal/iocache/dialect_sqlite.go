@@ -70,8 +70,9 @@ func (d *SQLiteDialect) RecordFileResultsBatch(db *sql.DB, tableName string, ana
 						 contributor_count, recent_commits, recent_churn, recent_lines_added, recent_lines_deleted, recent_contributor_count,
 						 age_days, gini_coefficient, file_owner,
 						 score_hot, score_risk, score_complexity, score_roi, score_label, reasoning,
-						 recency_signal, recency_threshold_low, recency_threshold_high)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+						 recency_signal, recency_threshold_low, recency_threshold_high, custom_scores,
+						 minor_contributor_count, top_owner_share, ownership_entropy)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`, d.QuoteIdentifier(tableName))
 
 	stmt, err := tx.Prepare(query)
@@ -82,12 +83,14 @@ func (d *SQLiteDialect) RecordFileResultsBatch(db *sql.DB, tableName string, ana
 
 	for _, res := range results {
 		reasoningJSON, _ := json.Marshal(res.Scores.Reasoning)
+		customScoresJSON, _ := json.Marshal(res.Scores.CustomScores)
 		_, err := stmt.Exec(
 			analysisID, res.Path, d.FormatTime(res.Metrics.AnalysisTime), res.Metrics.TotalCommits, res.Metrics.TotalChurn, res.Metrics.LinesAdded, res.Metrics.LinesDeleted, res.Metrics.DecayedCommits, res.Metrics.DecayedChurn, res.Metrics.LinesOfCode,
 			res.Metrics.ContributorCount, res.Metrics.RecentCommits, res.Metrics.RecentChurn, res.Metrics.RecentLinesAdded, res.Metrics.RecentLinesDeleted, res.Metrics.RecentContributorCount,
 			res.Metrics.AgeDays, res.Metrics.GiniCoefficient, res.Metrics.FileOwner,
 			res.Scores.HotScore, res.Scores.RiskScore, res.Scores.ComplexityScore, res.Scores.ROIScore, res.Scores.ScoreLabel, string(reasoningJSON),
-			res.Metrics.RecencySignal, res.Metrics.RecencyThresholdLow, res.Metrics.RecencyThresholdHigh,
+			res.Metrics.RecencySignal, res.Metrics.RecencyThresholdLow, res.Metrics.RecencyThresholdHigh, string(customScoresJSON),
+			res.Metrics.MinorContributorCount, res.Metrics.TopOwnerShare, res.Metrics.OwnershipEntropy,
 		)
 		if err != nil {
 			return err
@@ -122,7 +125,7 @@ func (d *SQLiteDialect) ScanAnalysisRunRecord(rows *sql.Rows, record *schema.Ana
 	var startTimeStr string
 	var endTimeStr *string
 	var urn *string
-	if err := rows.Scan(&record.AnalysisID, &startTimeStr, &endTimeStr, &record.RunDurationMs, &record.TotalFilesAnalyzed, &record.ConfigParams, &urn); err != nil {
+	if err := rows.Scan(&record.AnalysisID, &startTimeStr, &endTimeStr, &record.RunDurationMs, &record.TotalFilesAnalyzed, &record.ConfigParams, &urn, &record.NormalizationScales); err != nil {
 		return err
 	}
 	if urn != nil {
@@ -148,14 +151,15 @@ func (d *SQLiteDialect) ScanAnalysisRunRecord(rows *sql.Rows, record *schema.Ana
 // ScanFileScoresMetricsRecord parses a full file metrics and scores record from SQLite rows.
 func (d *SQLiteDialect) ScanFileScoresMetricsRecord(rows *sql.Rows, record *schema.FileScoresMetricsRecord) error {
 	var analysisTimeStr string
-	var reasoningJSON []byte
+	var reasoningJSON, customScoresJSON []byte
 	if err := rows.Scan(&record.AnalysisID, &record.FilePath, &analysisTimeStr, &record.TotalCommits,
 		&record.TotalChurn, &record.LinesAdded, &record.LinesDeleted, &record.DecayedCommits, &record.DecayedChurn, &record.LinesOfCode, &record.ContributorCount,
 		&record.RecentCommits, &record.RecentChurn, &record.RecentLinesAdded, &record.RecentLinesDeleted, &record.RecentContributorCount,
 		&record.AgeDays, &record.GiniCoefficient,
 		&record.FileOwner, &record.ScoreHot, &record.ScoreRisk, &record.ScoreComplexity, &record.ScoreROI,
 		&record.ScoreLabel, &reasoningJSON,
-		&record.RecencySignal, &record.RecencyThresholdLow, &record.RecencyThresholdHigh); err != nil {
+		&record.RecencySignal, &record.RecencyThresholdLow, &record.RecencyThresholdHigh, &customScoresJSON,
+		&record.MinorContributorCount, &record.TopOwnerShare, &record.OwnershipEntropy); err != nil {
 		return err
 	}
 	analysisTime, err := time.Parse(time.RFC3339Nano, analysisTimeStr)
@@ -166,6 +170,9 @@ func (d *SQLiteDialect) ScanFileScoresMetricsRecord(rows *sql.Rows, record *sche
 	if len(reasoningJSON) > 0 {
 		_ = json.Unmarshal(reasoningJSON, &record.Reasoning)
 	}
+	if len(customScoresJSON) > 0 {
+		_ = json.Unmarshal(customScoresJSON, &record.CustomScores)
+	}
 	return nil
 }
 
@@ -188,3 +195,8 @@ func (d *SQLiteDialect) GetSelectStartTimeQuery(tableName string) string {
 func (d *SQLiteDialect) GetUpdateURNQuery(tableName string) string {
 	return fmt.Sprintf(`UPDATE %s SET urn = ? WHERE analysis_id = ?`, d.QuoteIdentifier(tableName))
 }
+
+// GetUpdateNormalizationQuery returns the SQLite-specific query for recording normalization scales.
+func (d *SQLiteDialect) GetUpdateNormalizationQuery(tableName string) string {
+	return fmt.Sprintf(`UPDATE %s SET normalization_scales = ? WHERE analysis_id = ?`, d.QuoteIdentifier(tableName))
+}
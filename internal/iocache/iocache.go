@@ -50,6 +50,9 @@ type AnalysisStore interface {
 	// UpdateAnalysisRunURN updates the urn for an existing analysis run record
 	UpdateAnalysisRunURN(analysisID int64, urn string) error
 
+	// RecordNormalizationScales stores the per-metric scales used to normalize scores for an analysis run
+	RecordNormalizationScales(analysisID int64, scales schema.NormalizationScales) error
+
 	// GetAnalysisRuns retrieves analysis runs with optional filtering and pagination
 	GetAnalysisRuns(filter schema.AnalysisQueryFilter) ([]schema.AnalysisRunRecord, error)
 
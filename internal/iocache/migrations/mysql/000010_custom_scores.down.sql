@@ -0,0 +1,3 @@
+-- Version 10: Remove Custom Mode Scores (MySQL)
+ALTER TABLE hotspot_file_scores_metrics
+DROP COLUMN custom_scores;
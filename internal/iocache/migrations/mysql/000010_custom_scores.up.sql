@@ -0,0 +1,3 @@
+-- Version 10: Custom Mode Scores (MySQL)
+ALTER TABLE hotspot_file_scores_metrics
+ADD COLUMN custom_scores JSON;
@@ -0,0 +1,3 @@
+-- Version 11: Remove Normalization Scales (MySQL)
+ALTER TABLE hotspot_analysis_runs
+DROP COLUMN normalization_scales;
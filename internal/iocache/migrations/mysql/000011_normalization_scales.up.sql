@@ -0,0 +1,3 @@
+-- Version 11: Normalization Scales (MySQL)
+ALTER TABLE hotspot_analysis_runs
+ADD COLUMN normalization_scales JSON;
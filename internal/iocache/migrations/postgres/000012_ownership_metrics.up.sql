@@ -0,0 +1,4 @@
+-- Version 12: Ownership Fragmentation Metrics (PostgreSQL)
+ALTER TABLE hotspot_file_scores_metrics ADD COLUMN minor_contributor_count DOUBLE PRECISION DEFAULT 0;
+ALTER TABLE hotspot_file_scores_metrics ADD COLUMN top_owner_share DOUBLE PRECISION DEFAULT 0;
+ALTER TABLE hotspot_file_scores_metrics ADD COLUMN ownership_entropy DOUBLE PRECISION DEFAULT 0;
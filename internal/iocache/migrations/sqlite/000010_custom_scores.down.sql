@@ -0,0 +1,2 @@
+-- Version 10: Remove Custom Mode Scores (SQLite)
+ALTER TABLE hotspot_file_scores_metrics DROP COLUMN custom_scores;
@@ -0,0 +1,2 @@
+-- Version 10: Custom Mode Scores (SQLite)
+ALTER TABLE hotspot_file_scores_metrics ADD COLUMN custom_scores TEXT;
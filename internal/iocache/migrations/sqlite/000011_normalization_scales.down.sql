@@ -0,0 +1,2 @@
+-- Version 11: Remove Normalization Scales (SQLite)
+ALTER TABLE hotspot_analysis_runs DROP COLUMN normalization_scales;
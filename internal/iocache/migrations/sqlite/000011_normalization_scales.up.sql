@@ -0,0 +1,2 @@
+-- Version 11: Normalization Scales (SQLite)
+ALTER TABLE hotspot_analysis_runs ADD COLUMN normalization_scales TEXT;
@@ -0,0 +1,4 @@
+-- Version 12: Remove Ownership Fragmentation Metrics (SQLite)
+ALTER TABLE hotspot_file_scores_metrics DROP COLUMN minor_contributor_count;
+ALTER TABLE hotspot_file_scores_metrics DROP COLUMN top_owner_share;
+ALTER TABLE hotspot_file_scores_metrics DROP COLUMN ownership_entropy;
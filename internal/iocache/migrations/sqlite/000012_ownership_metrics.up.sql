@@ -0,0 +1,4 @@
+-- Version 12: Ownership Fragmentation Metrics (SQLite)
+ALTER TABLE hotspot_file_scores_metrics ADD COLUMN minor_contributor_count REAL DEFAULT 0;
+ALTER TABLE hotspot_file_scores_metrics ADD COLUMN top_owner_share REAL DEFAULT 0;
+ALTER TABLE hotspot_file_scores_metrics ADD COLUMN ownership_entropy REAL DEFAULT 0;
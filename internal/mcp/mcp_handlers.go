@@ -145,7 +145,7 @@ func (h *toolHandler) handleGetFilesHotspots(ctx context.Context, request mcp.Ca
 
 	return h.jsonResponse(schema.FileResultsOutput{
 		Results:  schema.EnrichFiles(ranked),
-		Metadata: schema.BuildMetadata(cfg.Runtime, duration),
+		Metadata: schema.BuildMetadata(cfg.Runtime, duration).WithNormalization(schema.FileNormalization(ranked)),
 	})
 }
 
@@ -188,6 +188,9 @@ func (h *toolHandler) handleGetFoldersHotspots(ctx context.Context, request mcp.
 		return errRes, nil
 	}
 
+	if depth := request.GetInt("depth", 0); depth > 0 {
+		cfg.Folders.Depth = depth
+	}
 	ranked, duration, err := core.GetHotspotFoldersResults(core.WithSuppressHeader(ctx), cfg, h.client, h.mgr)
 	if err != nil {
 		return mcp.NewToolResultError(fmt.Sprintf("analysis failed: %v", err)), nil
@@ -195,7 +198,7 @@ func (h *toolHandler) handleGetFoldersHotspots(ctx context.Context, request mcp.
 
 	return h.jsonResponse(schema.FolderResultsOutput{
 		Results:  schema.EnrichFolders(ranked),
-		Metadata: schema.BuildMetadata(cfg.Runtime, duration),
+		Metadata: schema.BuildMetadata(cfg.Runtime, duration).WithNormalization(schema.FolderNormalization(ranked)),
 	})
 }
 
@@ -298,6 +301,10 @@ func (h *toolHandler) handleGetBlastRadius(ctx context.Context, request mcp.Call
 	}
 
 	threshold := request.GetFloat("threshold", 0.3)
+	cfg.BlastRadius.HiddenOnly = request.GetBool("hidden_only", false)
+	if err := config.RevalidateBlastRadius(cfg, request.GetString("level", ""), request.GetString("path", ""), request.GetInt("folder_depth", 0), request.GetInt("impact_depth", 0)); err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("invalid blast radius parameters: %v", err)), nil
+	}
 
 	start := time.Now()
 	result, err := core.GetHotspotBlastRadiusResults(ctx, cfg, h.client, cfg.Output.ResultLimit, threshold)
@@ -312,6 +319,31 @@ func (h *toolHandler) handleGetBlastRadius(ctx context.Context, request mcp.Call
 	})
 }
 
+// handleGetFunctionHotspots handles the get_function_hotspots tool.
+func (h *toolHandler) handleGetFunctionHotspots(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	cfg, errRes := h.setupConfig(ctx, request)
+	if errRes != nil {
+		return errRes, nil
+	}
+
+	if file := request.GetString("file", ""); file != "" {
+		cfg.Git.PathFilter = file
+	}
+	cfg.Functions.Files = request.GetInt("files", schema.DefaultFunctionFiles)
+
+	start := time.Now()
+	result, err := core.GetHotspotFunctionsResults(ctx, cfg, h.client, h.mgr)
+	if err != nil {
+		return mcp.NewToolResultError(fmt.Sprintf("function analysis failed: %v", err)), nil
+	}
+	duration := time.Since(start)
+
+	return h.jsonResponse(schema.FunctionsResultsOutput{
+		Results:  result,
+		Metadata: schema.BuildMetadata(cfg.Runtime, duration),
+	})
+}
+
 // handleRunCheck handles the run_check tool.
 func (h *toolHandler) handleRunCheck(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
 	cfg, errRes := h.setupConfig(ctx, request)
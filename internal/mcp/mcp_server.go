@@ -3,6 +3,7 @@ package mcp
 
 import (
 	"context"
+	"strings"
 
 	"github.com/huangsam/hotspot/internal/config"
 	"github.com/huangsam/hotspot/internal/git"
@@ -35,7 +36,11 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 	// Common parameter descriptions
 	urnDesc := "Universal Resource Name (e.g., 'git:github.com/org/repo' or 'local:hash'). If provided, repo_path is optional and utilizes cached/historical analysis results."
 	repoPathDesc := "Path to the Git repository (defaults to current directory if not specified)."
-	modeDesc := "Scoring mode: 'hot' (activity hotspots), 'risk' (knowledge silos/bus factor), 'complexity' (technical debt candidates), 'roi' (refactoring priority), or composite modes: 'active_owners' (volatile + siloed), 'refactor_now' (high ROI targets), 'legacy_debt' (fragile + under-maintained)."
+	modeDesc := "Scoring mode: 'hot' (activity hotspots), 'risk' (knowledge silos/bus factor), 'complexity' (technical debt candidates), 'roi' (refactoring priority), 'defects' (bug-fix density), or composite modes: 'active_owners' (volatile + siloed), 'refactor_now' (high ROI targets), 'legacy_debt' (fragile + under-maintained)."
+	modeEnum := scoringModeEnum()
+	if custom := customModeSummary(); custom != "" {
+		modeDesc += " Custom modes: " + custom + "."
+	}
 	startDesc := "Start date for the analysis window (ISO8601 e.g. '2024-01-01T00:00:00Z', or relative e.g. '30d ago', '6 months ago')."
 	endDesc := "End date for the analysis window (ISO8601 or relative). Defaults to now."
 
@@ -62,7 +67,7 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("preset", mcp.Description("Apply a named configuration preset (small, large, infra). It is recommended to run 'get_repo_shape' first to identify the correct preset for this repository."), mcp.Enum("small", "large", "infra")),
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
-		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum("hot", "risk", "complexity", "roi", "active_owners", "refactor_now", "legacy_debt"), mcp.DefaultString("hot")),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
 		mcp.WithNumber("limit", mcp.Description("Limit the number of results returned."), mcp.DefaultNumber(10)),
 		mcp.WithString("start", mcp.Description(startDesc)),
 		mcp.WithString("end", mcp.Description(endDesc)),
@@ -81,7 +86,7 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("preset", mcp.Description("Apply a named configuration preset (small, large, infra). It is recommended to run 'get_repo_shape' first to identify the correct preset for this repository."), mcp.Enum("small", "large", "infra")),
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
-		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum("hot", "risk", "complexity", "roi", "active_owners", "refactor_now", "legacy_debt"), mcp.DefaultString("hot")),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
 		mcp.WithString("start", mcp.Description(startDesc)),
 		mcp.WithString("end", mcp.Description(endDesc)),
 		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude (e.g. '**/vendor/, **/*.pb.go')."), mcp.DefaultString(schema.DefaultExclude)),
@@ -100,12 +105,13 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("preset", mcp.Description("Apply a named configuration preset (small, large, infra). It is recommended to run 'get_repo_shape' first to identify the correct preset for this repository."), mcp.Enum("small", "large", "infra")),
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
-		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum("hot", "risk", "complexity", "roi", "active_owners", "refactor_now", "legacy_debt"), mcp.DefaultString("hot")),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
 		mcp.WithNumber("limit", mcp.Description("Limit the number of results."), mcp.DefaultNumber(10)),
 		mcp.WithString("start", mcp.Description(startDesc)),
 		mcp.WithString("end", mcp.Description(endDesc)),
 		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude (e.g. '**/vendor/, **/*.pb.go')."), mcp.DefaultString(schema.DefaultExclude)),
 		mcp.WithString("filter", mcp.Description("Path prefix to filter analysis to a specific directory (e.g. 'src/main/').")),
+		mcp.WithNumber("depth", mcp.Description("Rank folders this many directories deep, each rolling up every file below it. 0 ranks the immediate parent folders of files."), mcp.DefaultNumber(0)),
 	), withRecovery(h.handleGetFoldersHotspots))
 
 	// --- 3. Tool: compare_file_hotspots ---
@@ -122,7 +128,7 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("lookback", mcp.Description("Time window for analysis (e.g., '6 months', '30d').")),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
-		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum("hot", "risk", "complexity", "roi", "active_owners", "refactor_now", "legacy_debt"), mcp.DefaultString("hot")),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
 		mcp.WithString("start", mcp.Description(startDesc)),
 		mcp.WithString("end", mcp.Description(endDesc)),
 		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude (e.g. '**/vendor/, **/*.pb.go')."), mcp.DefaultString(schema.DefaultExclude)),
@@ -143,7 +149,7 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("lookback", mcp.Description("Time window for analysis (e.g., '6 months', '30d').")),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
-		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum("hot", "risk", "complexity", "roi", "active_owners", "refactor_now", "legacy_debt"), mcp.DefaultString("hot")),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
 		mcp.WithString("start", mcp.Description(startDesc)),
 		mcp.WithString("end", mcp.Description(endDesc)),
 		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude (e.g. '**/vendor/, **/*.pb.go')."), mcp.DefaultString(schema.DefaultExclude)),
@@ -164,7 +170,7 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("preset", mcp.Description("Apply a named configuration preset (small, large, infra). It is recommended to run 'get_repo_shape' first to identify the correct preset for this repository."), mcp.Enum("small", "large", "infra")),
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
-		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum("hot", "risk", "complexity", "roi", "active_owners", "refactor_now", "legacy_debt"), mcp.DefaultString("hot")),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
 		mcp.WithString("start", mcp.Description("Start date for the entire timeseries window (anchors the first point).")),
 		mcp.WithString("end", mcp.Description("End date for the entire timeseries window.")),
 		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude (e.g. '**/vendor/, **/*.pb.go')."), mcp.DefaultString(schema.DefaultExclude)),
@@ -182,7 +188,7 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("preset", mcp.Description("Apply a named configuration preset (small, large, infra). It is recommended to run 'get_repo_shape' first to identify the correct preset for this repository."), mcp.Enum("small", "large", "infra")),
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
-		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum("hot", "risk", "complexity", "roi", "active_owners", "refactor_now", "legacy_debt"), mcp.DefaultString("hot")),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
 		mcp.WithNumber("transitions", mcp.Description("Number of successive tag transitions to analyze (e.g. 3 = last 4 tags). Defaults to 3."), mcp.DefaultNumber(3)),
 		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude (e.g. '**/vendor/, **/*.pb.go')."), mcp.DefaultString(schema.DefaultExclude)),
 		mcp.WithString("filter", mcp.Description("Path prefix to filter analysis to a specific directory (e.g. 'src/main/').")),
@@ -190,7 +196,7 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 
 	// --- 6. Tool: get_blast_radius ---
 	s.AddTool(mcp.NewTool("get_blast_radius",
-		mcp.WithDescription("Identifies files that historically change together (co-change coupling). Reveals 'married' files that may lack proper abstraction."),
+		mcp.WithDescription("Identifies files that historically change together (co-change coupling). Reveals 'married' files that may lack proper abstraction. Go pairs are classified against the package import graph; hidden pairs have no static dependency. With a path, returns its impact set instead: what tends to change when that path changes, with confidence, support, lift and transitive impact."),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
 			Title:          "Get Blast Radius",
 			ReadOnlyHint:   &readOnly,
@@ -199,13 +205,38 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
 		mcp.WithNumber("limit", mcp.Description("Limit the number of results."), mcp.DefaultNumber(10)),
-		mcp.WithNumber("threshold", mcp.Description("Minimum coupling score (Jaccard Index, 0.0 to 1.0) to include a pair in the results."), mcp.DefaultNumber(0.3)),
+		mcp.WithNumber("threshold", mcp.Description("Minimum coupling score (Jaccard Index, 0.0 to 1.0) to include a pair in the results, or minimum confidence when a path is given."), mcp.DefaultNumber(0.3)),
+		mcp.WithString("path", mcp.Description("File, folder or module to compute the impact set of, relative to the repository root (e.g. 'core/builder.go').")),
+		mcp.WithNumber("impact_depth", mcp.Description("Number of co-change steps away from path to follow for the impact set."), mcp.DefaultNumber(schema.DefaultImpactDepth)),
+		mcp.WithBoolean("hidden_only", mcp.Description("Rank only Go file pairs that no shared package or import explains (defaults to false).")),
+		mcp.WithString("level", mcp.Description("Coupling unit: 'file', 'folder' (folders cut to folder_depth) or 'module' (modules from the config file)."), mcp.Enum("file", "folder", "module"), mcp.DefaultString("file")),
+		mcp.WithNumber("folder_depth", mcp.Description("Number of leading directories that name a folder at the folder level."), mcp.DefaultNumber(schema.DefaultCouplingFolderDepth)),
 		mcp.WithString("start", mcp.Description(startDesc)),
 		mcp.WithString("end", mcp.Description(endDesc)),
 		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude (e.g. '**/vendor/, **/*.pb.go')."), mcp.DefaultString(schema.DefaultExclude)),
 		mcp.WithString("filter", mcp.Description("Path prefix to filter analysis to a specific directory (e.g. 'src/main/').")),
 	), withRecovery(h.handleGetBlastRadius))
 
+	// --- 7. Tool: get_function_hotspots ---
+	s.AddTool(mcp.NewTool("get_function_hotspots",
+		mcp.WithDescription("Ranks Go functions by commits, churn, authors and complexity. Maps each historical hunk to its enclosing function and scores functions with the file scoring pipeline, pinpointing the hot code inside a large file."),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:          "Get Function Hotspots",
+			ReadOnlyHint:   &readOnly,
+			IdempotentHint: &idempotent,
+		}),
+		mcp.WithString("urn", mcp.Description(urnDesc)),
+		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
+		mcp.WithString("file", mcp.Description("Go file to analyze, relative to the repository root (e.g. 'core/builder.go'). When omitted, the functions of the top Go files in the current mode are analyzed.")),
+		mcp.WithNumber("files", mcp.Description("Number of top Go files to analyze when no file is given."), mcp.DefaultNumber(schema.DefaultFunctionFiles)),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
+		mcp.WithNumber("limit", mcp.Description("Limit the number of results."), mcp.DefaultNumber(10)),
+		mcp.WithString("start", mcp.Description(startDesc)),
+		mcp.WithString("end", mcp.Description(endDesc)),
+		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude (e.g. '**/vendor/, **/*.pb.go')."), mcp.DefaultString(schema.DefaultExclude)),
+		mcp.WithString("filter", mcp.Description("Path prefix to filter analysis to a specific directory (e.g. 'src/main/').")),
+	), withRecovery(h.handleGetFunctionHotspots))
+
 	// --- 8. Tool: run_check ---
 	s.AddTool(mcp.NewTool("run_check",
 		mcp.WithDescription("Run a policy check for CI/CD gating. Analyzes files changed between base and target refs against configured thresholds."),
@@ -218,7 +249,7 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 		mcp.WithString("target_ref", mcp.Description("The target reference for comparison."), mcp.Required()),
 		mcp.WithString("urn", mcp.Description(urnDesc)),
 		mcp.WithString("repo_path", mcp.Description(repoPathDesc)),
-		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum("hot", "risk", "complexity", "roi", "active_owners", "refactor_now", "legacy_debt"), mcp.DefaultString("hot")),
+		mcp.WithString("mode", mcp.Description(modeDesc), mcp.Enum(modeEnum...), mcp.DefaultString("hot")),
 		mcp.WithString("lookback", mcp.Description("Time window for analysis.")),
 		mcp.WithString("exclude", mcp.Description("Comma-separated list of glob patterns to exclude."), mcp.DefaultString(schema.DefaultExclude)),
 	), withRecovery(h.handleRunCheck))
@@ -245,6 +276,39 @@ func NewMCPServer(baseCfg *config.Config, mgr iocache.CacheManager, client git.C
 	return s
 }
 
+// scoringModeEnum lists every accepted scoring mode, including custom composites from config.
+func scoringModeEnum() []string {
+	all := schema.ScoringModes()
+	modes := make([]string, 0, len(all))
+	for _, mode := range all {
+		modes = append(modes, string(mode))
+	}
+	return modes
+}
+
+// customModeSummary describes user-defined composite and expression modes for tool descriptions.
+func customModeSummary() string {
+	var parts []string
+	for _, mode := range schema.CompositeScoringModes() {
+		if !schema.IsCustomCompositeMode(mode) {
+			continue
+		}
+		part := "'" + string(mode) + "'"
+		if cfg := schema.GetCompositeConfig(mode); cfg != nil && cfg.Description != "" {
+			part += " (" + cfg.Description + ")"
+		}
+		parts = append(parts, part)
+	}
+	for _, mode := range schema.ExpressionScoringModes() {
+		part := "'" + string(mode) + "'"
+		if cfg := schema.GetExpressionModeConfig(mode); cfg != nil && cfg.Description != "" {
+			part += " (" + cfg.Description + ")"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
+
 // StartMCPServer starts the Hotspot MCP server.
 func StartMCPServer(_ context.Context, baseCfg *config.Config, mgr iocache.CacheManager, client git.Client, agentsDoc string) error {
 	s := NewMCPServer(baseCfg, mgr, client, agentsDoc)
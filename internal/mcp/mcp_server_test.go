@@ -110,6 +110,7 @@ func TestMCPServer_ToolRegistration(t *testing.T) {
 		"get_timeseries",
 		"get_release_journey",
 		"get_blast_radius",
+		"get_function_hotspots",
 		"run_check",
 		"run_batch_analysis",
 	}
@@ -143,6 +144,7 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		analysisStore.On("Initialize", mock.Anything).Return(nil)
 		analysisStore.On("BeginAnalysis", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
 		analysisStore.On("RecordFileResultsBatch", mock.Anything, mock.Anything).Return(nil)
+		analysisStore.On("RecordNormalizationScales", mock.Anything, mock.Anything).Return(nil)
 		analysisStore.On("EndAnalysis", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 		// Default cache store mocks
@@ -174,6 +176,8 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		// Use the format WITHOUT single quotes as it's more standard for the parser
 		client.On("GetActivityLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(fmt.Appendf(nil, "--abc|Tester|%s\n\n1\t1\tmain.go\n", nowStr), nil)
+		client.On("GetRevertLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+		client.On("GetCommitBodyLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
 
 		tool := s.GetTool("get_repo_shape")
 		require.NotNil(t, tool)
@@ -199,6 +203,8 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		nowStr := time.Now().UTC().Format(time.RFC3339)
 		client.On("GetActivityLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(fmt.Appendf(nil, "--abc|Tester|%s\n\n10\t5\tmain.go\n", nowStr), nil)
+		client.On("GetRevertLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+		client.On("GetCommitBodyLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
 		client.On("Run", mock.Anything, mock.Anything, "rev-list", "--count", mock.Anything, "--", "main.go").Return([]byte("1\n"), nil)
 
 		tool := s.GetTool("get_files_hotspots")
@@ -221,6 +227,8 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		nowStr := time.Now().UTC().Format(time.RFC3339)
 		client.On("GetActivityLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(fmt.Appendf(nil, "--abc|Tester|%s\n\n10\t5\tcmd/main.go\n", nowStr), nil)
+		client.On("GetRevertLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+		client.On("GetCommitBodyLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
 		client.On("Run", mock.Anything, mock.Anything, "rev-list", "--count", mock.Anything, "--", "cmd/main.go").Return([]byte("1\n"), nil)
 
 		tool := s.GetTool("get_folders_hotspots")
@@ -243,6 +251,8 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		nowStr := time.Now().UTC().Format(time.RFC3339)
 		client.On("GetActivityLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(fmt.Appendf(nil, "--abc|Tester|%s\n\n10\t5\tmain.go\n", nowStr), nil)
+		client.On("GetRevertLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+		client.On("GetCommitBodyLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
 		client.On("Run", mock.Anything, mock.Anything, "rev-list", "--count", mock.Anything, "--", "main.go").Return([]byte("1\n"), nil)
 
 		tool := s.GetTool("get_heatmap")
@@ -270,6 +280,8 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		logContent := fmt.Sprintf("'--abc|Tester|%s\n\n1\t1\ta.go\n1\t1\tb.go\n", nowStr)
 		client.On("GetActivityLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]byte(logContent), nil)
+		client.On("GetRevertLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+		client.On("GetCommitBodyLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
 
 		tool := s.GetTool("get_blast_radius")
 		require.NotNil(t, tool)
@@ -291,6 +303,8 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		nowStr := time.Now().UTC().Format(time.RFC3339)
 		client.On("GetActivityLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(fmt.Appendf(nil, "'--abc|Tester|%s\n\n10\t5\tmain.go\n", nowStr), nil)
+		client.On("GetRevertLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+		client.On("GetCommitBodyLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
 		client.On("Run", mock.Anything, mock.Anything, "rev-list", "--count", mock.Anything, "--", "main.go").Return([]byte("1\n"), nil)
 		client.On("GetCommitTime", mock.Anything, mock.Anything, mock.Anything).Return(time.Now(), nil)
 		client.On("GetTags", mock.Anything, mock.Anything, mock.Anything).Return([]string{}, nil)
@@ -322,6 +336,8 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		client.On("ListFilesAtRef", mock.Anything, mock.Anything, mock.Anything).Return([]string{"main.go"}, nil)
 		client.On("GetActivityLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return([]byte("'--abc|Tester|2026-01-01T00:00:00Z\n\n10\t5\tmain.go\n"), nil)
+		client.On("GetRevertLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+		client.On("GetCommitBodyLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
 		client.On("Run", mock.Anything, mock.Anything, "rev-list", "--count", mock.Anything, "--", "main.go").Return([]byte("1"), nil)
 		client.On("GetCommitTime", mock.Anything, mock.Anything, mock.Anything).Return(time.Now(), nil)
 
@@ -351,6 +367,8 @@ func TestMCPServerHandlers_Execution(t *testing.T) {
 		nowStr := time.Now().UTC().Format(time.RFC3339)
 		client.On("GetActivityLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(fmt.Appendf(nil, "--abc|Tester|%s\n\n1\t1\tmain.go\n", nowStr), nil)
+		client.On("GetRevertLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
+		client.On("GetCommitBodyLog", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]byte(""), nil)
 
 		tool := s.GetTool("run_batch_analysis")
 		require.NotNil(t, tool)
@@ -2,6 +2,7 @@
 package outwriter
 
 import (
+	"fmt"
 	"io"
 	"time"
 
@@ -14,9 +15,14 @@ import (
 type FormatProvider interface {
 	WriteFiles(w io.Writer, results []schema.FileResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
 	WriteFolders(w io.Writer, results []schema.FolderResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
+	WriteFolderTree(w io.Writer, root schema.FolderTreeNode, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
 	WriteComparison(w io.Writer, results schema.ComparisonResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
 	WriteTimeseries(w io.Writer, result schema.TimeseriesResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
 	WriteBlastRadius(w io.Writer, result schema.BlastRadiusResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
+	WriteTickets(w io.Writer, result schema.TicketsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
+	WriteDeps(w io.Writer, result schema.DepsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
+	WriteDocDrift(w io.Writer, result schema.DocDriftResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
+	WriteFunctions(w io.Writer, result schema.FunctionsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
 	WriteMetrics(w io.Writer, activeWeights map[schema.ScoringMode]map[schema.BreakdownKey]float64, output config.OutputSettings) error
 	WriteHistory(w io.Writer, runs []schema.AnalysisRunRecord, output config.OutputSettings) error
 	WriteBatch(w io.Writer, results []schema.RepoShape, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error
@@ -56,6 +62,11 @@ func (ow *OutWriter) WriteFolders(w io.Writer, results []schema.FolderResult, ou
 	return ow.providers[output.GetFormat()].WriteFolders(w, results, output, runtime, duration)
 }
 
+// WriteFolderTree writes a hierarchical folder roll-up using the configured output format.
+func (ow *OutWriter) WriteFolderTree(w io.Writer, root schema.FolderTreeNode, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	return ow.providers[output.GetFormat()].WriteFolderTree(w, root, output, runtime, duration)
+}
+
 // WriteComparison writes comparison analysis results using the configured output format.
 func (ow *OutWriter) WriteComparison(w io.Writer, results schema.ComparisonResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
 	return ow.providers[output.GetFormat()].WriteComparison(w, results, output, runtime, duration)
@@ -71,6 +82,26 @@ func (ow *OutWriter) WriteBlastRadius(w io.Writer, result schema.BlastRadiusResu
 	return ow.providers[output.GetFormat()].WriteBlastRadius(w, result, output, runtime, duration)
 }
 
+// WriteTickets writes ticket analysis results using the configured output format.
+func (ow *OutWriter) WriteTickets(w io.Writer, result schema.TicketsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	return ow.providers[output.GetFormat()].WriteTickets(w, result, output, runtime, duration)
+}
+
+// WriteDeps writes dependency analysis results using the configured output format.
+func (ow *OutWriter) WriteDeps(w io.Writer, result schema.DepsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	return ow.providers[output.GetFormat()].WriteDeps(w, result, output, runtime, duration)
+}
+
+// WriteDocDrift writes documentation drift results using the configured output format.
+func (ow *OutWriter) WriteDocDrift(w io.Writer, result schema.DocDriftResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	return ow.providers[output.GetFormat()].WriteDocDrift(w, result, output, runtime, duration)
+}
+
+// WriteFunctions writes function analysis results using the configured output format.
+func (ow *OutWriter) WriteFunctions(w io.Writer, result schema.FunctionsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	return ow.providers[output.GetFormat()].WriteFunctions(w, result, output, runtime, duration)
+}
+
 // WriteMetrics writes metrics definitions using the configured output format.
 func (ow *OutWriter) WriteMetrics(w io.Writer, activeWeights map[schema.ScoringMode]map[schema.BreakdownKey]float64, output config.OutputSettings) error {
 	return ow.providers[output.GetFormat()].WriteMetrics(w, activeWeights, output)
@@ -85,3 +116,17 @@ func (ow *OutWriter) WriteHistory(w io.Writer, runs []schema.AnalysisRunRecord,
 func (ow *OutWriter) WriteBatch(w io.Writer, results []schema.RepoShape, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
 	return ow.providers[output.GetFormat()].WriteBatch(w, results, output, runtime, duration)
 }
+
+// WriteCouplingGraph writes a coupling graph in the given graph format.
+func WriteCouplingGraph(w io.Writer, graph schema.CouplingGraph, format schema.GraphFormat) error {
+	switch format {
+	case schema.GraphFormatDOT:
+		return provider.WriteGraphDOT(w, graph)
+	case schema.GraphFormatGraphML:
+		return provider.WriteGraphML(w, graph)
+	case schema.GraphFormatJSON:
+		return provider.WriteGraphJSON(w, graph)
+	default:
+		return fmt.Errorf("unsupported graph format %q", format)
+	}
+}
@@ -83,6 +83,9 @@ func (p *CSVProvider) WriteFolders(w io.Writer, results []schema.FolderResult, o
 		"gini",
 		"owner",
 		"mode",
+		"top_file",
+		"top_file_score",
+		"aggregation",
 	}
 
 	return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
@@ -99,6 +102,9 @@ func (p *CSVProvider) WriteFolders(w io.Writer, results []schema.FolderResult, o
 				fmtFloat(r.Gini),               // Gini Coefficient
 				strings.Join(r.Owners, "|"),    // Owners
 				string(r.Mode),                 // Mode
+				r.TopFile,                      // Top Contributing File
+				fmtFloat(r.TopFileScore),       // Top File Score
+				string(r.Aggregation),          // Folder Aggregation
 			}
 			if err := csvWriter.Write(row); err != nil {
 				return err
@@ -108,6 +114,54 @@ func (p *CSVProvider) WriteFolders(w io.Writer, results []schema.FolderResult, o
 	})
 }
 
+// WriteFolderTree writes a hierarchical folder roll-up in CSV format, one folder per row
+// in depth-first order.
+func (p *CSVProvider) WriteFolderTree(w io.Writer, root schema.FolderTreeNode, output config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	header := []string{
+		"folder",
+		"depth",
+		"score",
+		"label",
+		"total_commits",
+		"total_churn",
+		"total_loc",
+		"unique_contributors",
+		"gini",
+		"owner",
+		"mode",
+		"top_file",
+		"top_file_score",
+		"aggregation",
+	}
+
+	return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
+		for _, row := range flattenFolderTree(root) {
+			r := row.node
+			rec := []string{
+				r.Path,                         // Folder Path
+				strconv.Itoa(r.Depth),          // Depth
+				fmtFloat(r.Score),              // Score
+				r.Label,                        // Label
+				r.Commits.Display(),            // Total Commits
+				r.Churn.Display(),              // Total Churn
+				r.TotalLOC.Display(),           // Total LOC
+				r.UniqueContributors.Display(), // Unique Contributors
+				fmtFloat(r.Gini),               // Gini Coefficient
+				strings.Join(r.Owners, "|"),    // Owners
+				string(r.Mode),                 // Mode
+				r.TopFile,                      // Top Contributing File
+				fmtFloat(r.TopFileScore),       // Top File Score
+				string(r.Aggregation),          // Folder Aggregation
+			}
+			if err := csvWriter.Write(rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // WriteComparison writes comparison analysis results in CSV format.
 func (p *CSVProvider) WriteComparison(w io.Writer, results schema.ComparisonResult, output config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
 	fmtFloat := CreateFormatters(output.GetPrecision())
@@ -158,6 +212,7 @@ func (p *CSVProvider) WriteTimeseries(w io.Writer, result schema.TimeseriesResul
 		"mode",
 		"start",
 		"end",
+		"change_entropy",
 	}
 
 	return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
@@ -171,6 +226,7 @@ func (p *CSVProvider) WriteTimeseries(w io.Writer, result schema.TimeseriesResul
 				string(point.Mode),                        // Mode
 				point.Start.Format(schema.DateTimeFormat), // Start
 				point.End.Format(schema.DateTimeFormat),   // End
+				fmtFloat(point.ChangeEntropy),             // Change Entropy
 			}
 			if err := csvWriter.Write(row); err != nil {
 				return err
@@ -183,12 +239,40 @@ func (p *CSVProvider) WriteTimeseries(w io.Writer, result schema.TimeseriesResul
 // WriteBlastRadius writes blast radius analysis results in CSV format.
 func (p *CSVProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadiusResult, output config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
 	fmtFloat := CreateFormatters(output.GetPrecision())
+	unit := strings.ToLower(couplingUnitName(result.Summary.Level))
+	if result.Summary.Path != "" {
+		header := []string{"rank", unit, "depth", "via", "co_change", "support", "confidence", "lift", "impact", "relation"}
+		return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
+			for i, entry := range result.Impact {
+				row := []string{
+					strconv.Itoa(i + 1),
+					entry.Path,
+					strconv.Itoa(entry.Depth),
+					entry.Via,
+					strconv.Itoa(entry.CoChange),
+					fmtFloat(entry.Support),
+					fmtFloat(entry.Confidence),
+					fmtFloat(entry.Lift),
+					fmtFloat(entry.Impact),
+					string(entry.Relation),
+				}
+				if err := csvWriter.Write(row); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
 	header := []string{
 		"rank",
-		"file_a",
-		"file_b",
+		unit + "_a",
+		unit + "_b",
 		"score",
 		"co_change",
+		"support",
+		"confidence",
+		"reverse_confidence",
+		"relation",
 	}
 
 	return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
@@ -199,6 +283,178 @@ func (p *CSVProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadiusRes
 				pair.Target,
 				fmtFloat(pair.Score),
 				strconv.Itoa(pair.CoChange),
+				fmtFloat(pair.Support),
+				fmtFloat(pair.Confidence),
+				fmtFloat(pair.ReverseConfidence),
+				string(pair.Relation),
+			}
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteTickets writes ticket analysis results in CSV format.
+func (p *CSVProvider) WriteTickets(w io.Writer, result schema.TicketsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	header := []string{
+		"rank",
+		"ticket",
+		"prefix",
+		"commits",
+		"fix_commits",
+		"churn",
+		"files",
+		"first_seen",
+		"last_seen",
+		"subject",
+	}
+
+	return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
+		for i, ticket := range result.Tickets {
+			row := []string{
+				strconv.Itoa(i + 1),
+				ticket.Key,
+				ticket.Prefix,
+				strconv.Itoa(ticket.Commits),
+				strconv.Itoa(ticket.FixCommits),
+				strconv.Itoa(ticket.Churn),
+				strconv.Itoa(ticket.Files),
+				ticket.FirstSeen.Format(schema.DateTimeFormat),
+				ticket.LastSeen.Format(schema.DateTimeFormat),
+				ticket.Subject,
+			}
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteDeps writes the dependency rankings in CSV format.
+func (p *CSVProvider) WriteDeps(w io.Writer, result schema.DepsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	header := []string{
+		"rank",
+		"dependency",
+		"ecosystem",
+		"version",
+		"changes",
+		"manifests",
+		"authors",
+		"top_author",
+		"last_changed",
+	}
+
+	return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
+		for i, dep := range result.Dependencies {
+			lastChanged := "" // Not changed within the window
+			if !dep.LastChanged.IsZero() {
+				lastChanged = dep.LastChanged.Format(schema.DateTimeFormat)
+			}
+			row := []string{
+				strconv.Itoa(i + 1),
+				dep.Name,
+				dep.Ecosystem,
+				dep.Version,
+				strconv.Itoa(dep.Changes),
+				strconv.Itoa(dep.Manifests),
+				strconv.Itoa(dep.Authors),
+				dep.TopAuthor,
+				lastChanged,
+			}
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteDocDrift writes documentation drift results in CSV format.
+func (p *CSVProvider) WriteDocDrift(w io.Writer, result schema.DocDriftResult, output config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	header := []string{
+		"rank",
+		"path",
+		"doc_files",
+		"docs_last_modified",
+		"code_commits",
+		"commits_since_docs",
+		"churn_since_docs",
+		"drift_ratio",
+		"score",
+	}
+
+	return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
+		for i, folder := range result.Folders {
+			docsLastModified := "" // Docs not modified within the window
+			if !folder.DocsLastModified.IsZero() {
+				docsLastModified = folder.DocsLastModified.Format(schema.DateTimeFormat)
+			}
+			row := []string{
+				strconv.Itoa(i + 1),
+				folder.Path,
+				strconv.Itoa(folder.DocFiles),
+				docsLastModified,
+				folder.CodeCommits.Display(),
+				folder.CommitsSinceDocs.Display(),
+				folder.ChurnSinceDocs.Display(),
+				fmtFloat(folder.DriftRatio),
+				fmtFloat(folder.Score),
+			}
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// WriteFunctions writes the function rankings in CSV format.
+func (p *CSVProvider) WriteFunctions(w io.Writer, result schema.FunctionsResult, output config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	header := []string{
+		"rank",
+		"path",
+		"function",
+		"line",
+		"end_line",
+		"score",
+		"label",
+		"commits",
+		"churn",
+		"contributors",
+		"cyclomatic",
+		"cognitive",
+		"last_changed",
+		"owners",
+		"mode",
+	}
+
+	return WriteCSVWithHeader(w, header, func(csvWriter *csv.Writer) error {
+		for i, fn := range result.Functions {
+			lastChanged := "" // Not changed within the window
+			if !fn.LastChanged.IsZero() {
+				lastChanged = fn.LastChanged.Format(schema.DateTimeFormat)
+			}
+			row := []string{
+				strconv.Itoa(i + 1),
+				fn.Path,
+				fn.Name,
+				strconv.Itoa(fn.Line),
+				strconv.Itoa(fn.EndLine),
+				fmtFloat(fn.Score),
+				schema.GetPlainLabel(fn.Score),
+				fn.Commits.Display(),
+				fn.Churn.Display(),
+				fn.Contributors.Display(),
+				strconv.Itoa(fn.Cyclomatic),
+				strconv.Itoa(fn.Cognitive),
+				lastChanged,
+				strings.Join(fn.Owners, "|"),
+				string(fn.Mode),
 			}
 			if err := csvWriter.Write(row); err != nil {
 				return err
@@ -230,6 +486,23 @@ func (p *CSVProvider) WriteMetrics(w io.Writer, activeWeights map[schema.Scoring
 				return err
 			}
 		}
+		for _, c := range renderModel.Composites {
+			row := []string{
+				c.Name,
+				c.Description,
+				strings.Join(c.BaseModes, "|"),
+				c.Formula,
+			}
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
+		for _, c := range renderModel.CustomModes {
+			row := []string{c.Name, c.Description, "", c.Expression}
+			if err := csvWriter.Write(row); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 }
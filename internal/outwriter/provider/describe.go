@@ -95,6 +95,12 @@ func (p *DescribeProvider) WriteFolders(w io.Writer, _ []schema.FolderResult, _
 	return err
 }
 
+// WriteFolderTree is not specifically implemented for describe mode.
+func (p *DescribeProvider) WriteFolderTree(w io.Writer, _ schema.FolderTreeNode, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Describe mode is not supported for folder trees.")
+	return err
+}
+
 // WriteComparison is not specifically implemented for describe mode.
 func (p *DescribeProvider) WriteComparison(w io.Writer, _ schema.ComparisonResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
 	_, err := fmt.Fprintln(w, "Describe mode is not supported for comparison analysis.")
@@ -113,6 +119,30 @@ func (p *DescribeProvider) WriteBlastRadius(w io.Writer, _ schema.BlastRadiusRes
 	return err
 }
 
+// WriteTickets is not specifically implemented for describe mode.
+func (p *DescribeProvider) WriteTickets(w io.Writer, _ schema.TicketsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Describe mode is not supported for ticket analysis.")
+	return err
+}
+
+// WriteDeps is not specifically implemented for describe mode.
+func (p *DescribeProvider) WriteDeps(w io.Writer, _ schema.DepsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Describe mode is not supported for dependency analysis.")
+	return err
+}
+
+// WriteDocDrift is not specifically implemented for describe mode.
+func (p *DescribeProvider) WriteDocDrift(w io.Writer, _ schema.DocDriftResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Describe mode is not supported for documentation drift analysis.")
+	return err
+}
+
+// WriteFunctions is not specifically implemented for describe mode.
+func (p *DescribeProvider) WriteFunctions(w io.Writer, _ schema.FunctionsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Describe mode is not supported for function analysis.")
+	return err
+}
+
 // WriteMetrics is not specifically implemented for describe mode.
 func (p *DescribeProvider) WriteMetrics(w io.Writer, _ map[schema.ScoringMode]map[schema.BreakdownKey]float64, _ config.OutputSettings) error {
 	_, err := fmt.Fprintln(w, "Describe mode is not supported for metrics definitions.")
@@ -4,6 +4,11 @@ import (
 	"encoding/csv"
 	"fmt"
 	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/huangsam/hotspot/schema"
 )
 
 // TruncatePath truncates a file path to a maximum width with ellipsis prefix.
@@ -43,3 +48,103 @@ func CreateFormatters(precision int) (fmtFloat func(float64) string) {
 }
 
 // formatWeights was moved to schema.FormatWeights
+
+// formatWindowDate formats the date of the latest change, which is zero when nothing
+// changed within the analysis window.
+func formatWindowDate(t time.Time) string {
+	if t.IsZero() {
+		return "before window"
+	}
+	return t.Format(time.DateOnly)
+}
+
+// couplingUnitName returns the column name for the units of a coupling level.
+func couplingUnitName(level schema.CouplingLevel) string {
+	switch level {
+	case schema.CouplingLevelFolder:
+		return "Folder"
+	case schema.CouplingLevelModule:
+		return "Module"
+	default:
+		return "File"
+	}
+}
+
+// formatPercent formats a ratio as a whole percentage.
+func formatPercent(v float64) string {
+	return fmt.Sprintf("%.0f%%", v*100)
+}
+
+// formatRelation formats the static dependency of a coupled pair, or "-" for pairs that
+// are not both Go files.
+func formatRelation(r schema.CouplingRelation) string {
+	if r == "" {
+		return "-"
+	}
+	return strings.ReplaceAll(string(r), "_", " ")
+}
+
+// formatFunctionComplexity formats a Go complexity metric, or "-" for files without Go functions.
+func formatFunctionComplexity(f *schema.FileResult, v schema.Metric) string {
+	if f.Functions == 0 {
+		return "-"
+	}
+	return v.Display()
+}
+
+// formatWorkRatio formats an off-hours or weekend ratio of a file, or "-" when work
+// patterns were not collected, so an unmeasured file does not read as 0.
+func formatWorkRatio(f *schema.FileResult, v float64, fmtFloat func(float64) string) string {
+	if !f.WorkPatterns {
+		return "-"
+	}
+	return fmtFloat(v)
+}
+
+// formatTopFile formats the top contributing file of a folder with its score, or "-" for
+// folders without files. A positive maxWidth truncates the path.
+func formatTopFile(f schema.FolderResult, fmtFloat func(float64) string, maxWidth int) string {
+	if f.TopFile == "" {
+		return "-"
+	}
+	name := f.TopFile
+	if maxWidth > 0 {
+		name = TruncatePath(name, maxWidth)
+	}
+	return fmt.Sprintf("%s (%s)", name, fmtFloat(f.TopFileScore))
+}
+
+// writeFolderAggregationNote notes how folder scores were aggregated when it is not the
+// default LOC-weighted mean.
+func writeFolderAggregationNote(w io.Writer, aggregation schema.FolderAggregation) error {
+	if aggregation == "" || aggregation == schema.MeanFolderAggregation {
+		return nil
+	}
+	_, err := fmt.Fprintf(w, "Folder scores use the %s aggregation of file scores\n", aggregation)
+	return err
+}
+
+// folderTreeRow is a folder of a tree with its name drawn under its parent.
+type folderTreeRow struct {
+	name string
+	node schema.FolderTreeNode
+}
+
+// flattenFolderTree lists the folders of a tree depth first, naming each below the
+// root by its base name behind box-drawing branches.
+func flattenFolderTree(root schema.FolderTreeNode) []folderTreeRow {
+	rows := []folderTreeRow{{name: root.Path, node: root}}
+	var walk func(node schema.FolderTreeNode, indent string)
+	walk = func(node schema.FolderTreeNode, indent string) {
+		for i, child := range node.Children {
+			branch, next := "├─ ", "│  "
+			if i == len(node.Children)-1 {
+				branch, next = "└─ ", "   "
+			}
+			rows = append(rows, folderTreeRow{name: indent + branch + path.Base(child.Path), node: child})
+			walk(child, indent+next)
+		}
+	}
+	walk(root, "")
+	return rows
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/huangsam/hotspot/schema"
+)
+
+// WriteGraphDOT writes a coupling graph in the Graphviz DOT language. Graphviz needs
+// integer edge weights, so edges are weighted by their co-change count and carry the
+// coupling score as score and as the pen width.
+func WriteGraphDOT(w io.Writer, graph schema.CouplingGraph) error {
+	kind, arrow := "graph", "--"
+	if graph.Directed {
+		kind, arrow = "digraph", "->"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s coupling {\n", kind)
+	fmt.Fprintf(&b, "  graph [level=%s, mode=%s];\n", dotQuote(string(graph.Level)), dotQuote(string(graph.Mode)))
+	b.WriteString("  node [shape=box];\n")
+	for _, n := range graph.Nodes {
+		attrs := []string{
+			"files=" + strconv.Itoa(n.Files),
+			"score=" + formatGraphFloat(n.Score),
+		}
+		if n.Folder != "" {
+			attrs = append(attrs, "folder="+dotQuote(n.Folder))
+		}
+		if n.Label != "" {
+			attrs = append(attrs, "criticality="+dotQuote(n.Label))
+		}
+		if len(n.Owners) > 0 {
+			attrs = append(attrs, "owners="+dotQuote(strings.Join(n.Owners, ", ")))
+		}
+		fmt.Fprintf(&b, "  %s [%s];\n", dotQuote(n.ID), strings.Join(attrs, ", "))
+	}
+	for _, e := range graph.Edges {
+		attrs := []string{
+			"weight=" + strconv.Itoa(e.CoChange),
+			"score=" + formatGraphFloat(e.Weight),
+			"penwidth=" + formatGraphFloat(1+4*e.Weight),
+			"support=" + formatGraphFloat(e.Support),
+			"confidence=" + formatGraphFloat(e.Confidence),
+		}
+		if e.Relation != "" {
+			attrs = append(attrs, "relation="+dotQuote(string(e.Relation)))
+		}
+		fmt.Fprintf(&b, "  %s %s %s [%s];\n", dotQuote(e.Source), arrow, dotQuote(e.Target), strings.Join(attrs, ", "))
+	}
+	b.WriteString("}\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// dotQuote quotes an ID or attribute value for the DOT language.
+func dotQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// graphML is the root element of a GraphML document.
+type graphML struct {
+	XMLName xml.Name       `xml:"graphml"`
+	XMLNS   string         `xml:"xmlns,attr"`
+	Keys    []graphMLKey   `xml:"key"`
+	Graph   graphMLElement `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLElement struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Data        []graphMLData `xml:"data"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// graphMLKeys declares the attributes of the graph, its nodes and its edges.
+var graphMLKeys = []graphMLKey{
+	{ID: "level", For: "graph", Name: "level", Type: "string"},
+	{ID: "mode", For: "graph", Name: "mode", Type: "string"},
+	{ID: "folder", For: "node", Name: "folder", Type: "string"},
+	{ID: "files", For: "node", Name: "files", Type: "int"},
+	{ID: "score", For: "node", Name: "score", Type: "double"},
+	{ID: "criticality", For: "node", Name: "criticality", Type: "string"},
+	{ID: "owners", For: "node", Name: "owners", Type: "string"},
+	{ID: "weight", For: "edge", Name: "weight", Type: "double"},
+	{ID: "co_change", For: "edge", Name: "co_change", Type: "int"},
+	{ID: "support", For: "edge", Name: "support", Type: "double"},
+	{ID: "confidence", For: "edge", Name: "confidence", Type: "double"},
+	{ID: "relation", For: "edge", Name: "relation", Type: "string"},
+}
+
+// WriteGraphML writes a coupling graph as GraphML.
+func WriteGraphML(w io.Writer, graph schema.CouplingGraph) error {
+	edgeDefault := "undirected"
+	if graph.Directed {
+		edgeDefault = "directed"
+	}
+	doc := graphML{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys:  graphMLKeys,
+		Graph: graphMLElement{
+			ID:          "coupling",
+			EdgeDefault: edgeDefault,
+			Data: []graphMLData{
+				{Key: "level", Value: string(graph.Level)},
+				{Key: "mode", Value: string(graph.Mode)},
+			},
+		},
+	}
+	for _, n := range graph.Nodes {
+		data := []graphMLData{
+			{Key: "files", Value: strconv.Itoa(n.Files)},
+			{Key: "score", Value: formatGraphFloat(n.Score)},
+		}
+		if n.Folder != "" {
+			data = append(data, graphMLData{Key: "folder", Value: n.Folder})
+		}
+		if n.Label != "" {
+			data = append(data, graphMLData{Key: "criticality", Value: n.Label})
+		}
+		if len(n.Owners) > 0 {
+			data = append(data, graphMLData{Key: "owners", Value: strings.Join(n.Owners, ", ")})
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, graphMLNode{ID: n.ID, Data: data})
+	}
+	for _, e := range graph.Edges {
+		data := []graphMLData{
+			{Key: "weight", Value: formatGraphFloat(e.Weight)},
+			{Key: "co_change", Value: strconv.Itoa(e.CoChange)},
+			{Key: "support", Value: formatGraphFloat(e.Support)},
+			{Key: "confidence", Value: formatGraphFloat(e.Confidence)},
+		}
+		if e.Relation != "" {
+			data = append(data, graphMLData{Key: "relation", Value: string(e.Relation)})
+		}
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{Source: e.Source, Target: e.Target, Data: data})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to encode GraphML: %w", err)
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// WriteGraphJSON writes a coupling graph as JSON nodes and edges.
+func WriteGraphJSON(w io.Writer, graph schema.CouplingGraph) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(graph); err != nil {
+		return fmt.Errorf("failed to encode JSON: %w", err)
+	}
+	return nil
+}
+
+// formatGraphFloat formats a graph attribute to four decimals.
+func formatGraphFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 4, 64)
+}
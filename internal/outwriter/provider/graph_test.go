@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCouplingGraph() schema.CouplingGraph {
+	return schema.CouplingGraph{
+		Level: schema.CouplingLevelFile,
+		Mode:  schema.HotMode,
+		Nodes: []schema.CouplingGraphNode{
+			{ID: "api/handler.go", Folder: "api", Files: 1, Score: 82, Label: schema.CriticalValue, Owners: []string{"Alice", "Bob"}},
+			{ID: `docs/"quoted".md`, Folder: "docs"},
+		},
+		Edges: []schema.CouplingGraphEdge{
+			{Source: "api/handler.go", Target: `docs/"quoted".md`, Weight: 0.5, CoChange: 3, Support: 0.25, Confidence: 0.75},
+		},
+	}
+}
+
+func TestWriteGraphDOT(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteGraphDOT(&buf, testCouplingGraph()))
+	out := buf.String()
+	assert.Contains(t, out, "graph coupling {\n")
+	assert.Contains(t, out, `"api/handler.go" [files=1, score=82.0000, folder="api", criticality="Critical", owners="Alice, Bob"];`)
+	assert.Contains(t, out, `"api/handler.go" -- "docs/\"quoted\".md" [weight=3, score=0.5000, penwidth=3.0000, support=0.2500, confidence=0.7500];`)
+
+	directed := testCouplingGraph()
+	directed.Directed = true
+	buf.Reset()
+	require.NoError(t, WriteGraphDOT(&buf, directed))
+	assert.Contains(t, buf.String(), "digraph coupling {\n")
+	assert.Contains(t, buf.String(), `"api/handler.go" -> "docs/\"quoted\".md"`)
+}
+
+func TestWriteGraphML(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteGraphML(&buf, testCouplingGraph()))
+
+	var doc graphML
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, "undirected", doc.Graph.EdgeDefault)
+	require.Len(t, doc.Graph.Nodes, 2)
+	assert.Equal(t, `docs/"quoted".md`, doc.Graph.Nodes[1].ID)
+	assert.Contains(t, doc.Graph.Nodes[0].Data, graphMLData{Key: "owners", Value: "Alice, Bob"})
+	require.Len(t, doc.Graph.Edges, 1)
+	assert.Contains(t, doc.Graph.Edges[0].Data, graphMLData{Key: "weight", Value: "0.5000"})
+
+	// Every data key is declared
+	keys := make(map[string]bool)
+	for _, k := range doc.Keys {
+		keys[k.ID] = true
+	}
+	for _, n := range doc.Graph.Nodes {
+		for _, d := range n.Data {
+			assert.True(t, keys[d.Key], d.Key)
+		}
+	}
+}
+
+func TestWriteGraphJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteGraphJSON(&buf, testCouplingGraph()))
+
+	var graph schema.CouplingGraph
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &graph))
+	assert.Equal(t, testCouplingGraph(), graph)
+}
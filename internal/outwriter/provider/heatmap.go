@@ -68,11 +68,36 @@ func (p *HeatmapProvider) WriteBlastRadius(_ io.Writer, _ schema.BlastRadiusResu
 	return fmt.Errorf("heatmap output not supported for blast radius results")
 }
 
+// WriteTickets is not implemented for heatmap.
+func (p *HeatmapProvider) WriteTickets(_ io.Writer, _ schema.TicketsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return fmt.Errorf("heatmap output not supported for ticket results")
+}
+
+// WriteDeps is not implemented for heatmap.
+func (p *HeatmapProvider) WriteDeps(_ io.Writer, _ schema.DepsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return fmt.Errorf("heatmap output not supported for dependency results")
+}
+
+// WriteDocDrift is not implemented for heatmap.
+func (p *HeatmapProvider) WriteDocDrift(_ io.Writer, _ schema.DocDriftResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return fmt.Errorf("heatmap output not supported for documentation drift results")
+}
+
+// WriteFunctions is not implemented for heatmap.
+func (p *HeatmapProvider) WriteFunctions(_ io.Writer, _ schema.FunctionsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return fmt.Errorf("heatmap output not supported for function results")
+}
+
 // WriteMetrics is not implemented for heatmap.
 func (p *HeatmapProvider) WriteMetrics(_ io.Writer, _ map[schema.ScoringMode]map[schema.BreakdownKey]float64, _ config.OutputSettings) error {
 	return fmt.Errorf("heatmap output not supported for metrics")
 }
 
+// WriteFolderTree is not implemented for heatmap.
+func (p *HeatmapProvider) WriteFolderTree(_ io.Writer, _ schema.FolderTreeNode, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return fmt.Errorf("heatmap output not supported for folder trees")
+}
+
 // WriteHistory is not implemented for heatmap.
 func (p *HeatmapProvider) WriteHistory(_ io.Writer, _ []schema.AnalysisRunRecord, _ config.OutputSettings) error {
 	return fmt.Errorf("heatmap output not supported for history")
@@ -23,7 +23,7 @@ func NewJSONProvider() *JSONProvider {
 func (p *JSONProvider) WriteFiles(w io.Writer, results []schema.FileResult, _ config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
 	output := schema.FileResultsOutput{
 		Results:  schema.EnrichFiles(results),
-		Metadata: schema.BuildMetadata(runtime, duration),
+		Metadata: schema.BuildMetadata(runtime, duration).WithNormalization(schema.FileNormalization(results)),
 	}
 	return p.encode(w, output)
 }
@@ -32,7 +32,16 @@ func (p *JSONProvider) WriteFiles(w io.Writer, results []schema.FileResult, _ co
 func (p *JSONProvider) WriteFolders(w io.Writer, results []schema.FolderResult, _ config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
 	output := schema.FolderResultsOutput{
 		Results:  schema.EnrichFolders(results),
-		Metadata: schema.BuildMetadata(runtime, duration),
+		Metadata: schema.BuildMetadata(runtime, duration).WithNormalization(schema.FolderNormalization(results)),
+	}
+	return p.encode(w, output)
+}
+
+// WriteFolderTree serializes a hierarchical folder roll-up to JSON.
+func (p *JSONProvider) WriteFolderTree(w io.Writer, root schema.FolderTreeNode, _ config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	output := schema.FolderTreeOutput{
+		Results:  root,
+		Metadata: schema.BuildMetadata(runtime, duration).WithNormalization(root.Normalization),
 	}
 	return p.encode(w, output)
 }
@@ -56,6 +65,26 @@ func (p *JSONProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadiusRe
 	return p.encode(w, result)
 }
 
+// WriteTickets serializes ticket results to JSON.
+func (p *JSONProvider) WriteTickets(w io.Writer, result schema.TicketsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return p.encode(w, result)
+}
+
+// WriteDeps serializes dependency results to JSON.
+func (p *JSONProvider) WriteDeps(w io.Writer, result schema.DepsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return p.encode(w, result)
+}
+
+// WriteDocDrift serializes documentation drift results to JSON.
+func (p *JSONProvider) WriteDocDrift(w io.Writer, result schema.DocDriftResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return p.encode(w, result)
+}
+
+// WriteFunctions serializes function analysis results to JSON.
+func (p *JSONProvider) WriteFunctions(w io.Writer, result schema.FunctionsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return p.encode(w, result)
+}
+
 // WriteMetrics serializes metrics definitions to JSON.
 func (p *JSONProvider) WriteMetrics(w io.Writer, activeWeights map[schema.ScoringMode]map[schema.BreakdownKey]float64, _ config.OutputSettings) error {
 	model := schema.BuildMetricsRenderModel(activeWeights)
@@ -71,6 +71,47 @@ func TestWriteFolders(t *testing.T) {
 	assert.Equal(t, "High", output.Results[0].Label)
 }
 
+func TestWriteFolderTree(t *testing.T) {
+	p := NewJSONProvider()
+	root := schema.FolderTreeNode{
+		Label:        "Low",
+		FolderResult: schema.FolderResult{Path: ".", Score: 30.0},
+		Children: []schema.FolderTreeNode{
+			{Label: "High", Depth: 1, FolderResult: schema.FolderResult{Path: "core", Score: 65.0}},
+		},
+	}
+
+	var buf bytes.Buffer
+	err := p.WriteFolderTree(&buf, root, config.OutputConfig{}, config.RuntimeConfig{Workers: 2}, time.Millisecond)
+	require.NoError(t, err)
+
+	var output schema.FolderTreeOutput
+	err = json.Unmarshal(buf.Bytes(), &output)
+	require.NoError(t, err)
+
+	assert.Equal(t, ".", output.Results.Path)
+	require.Len(t, output.Results.Children, 1)
+	assert.Equal(t, "core", output.Results.Children[0].Path)
+	assert.Equal(t, 1, output.Results.Children[0].Depth)
+	assert.Empty(t, output.Results.Children[0].Children)
+}
+
+func TestFlattenFolderTree(t *testing.T) {
+	leaf := func(path string) schema.FolderTreeNode {
+		return schema.FolderTreeNode{FolderResult: schema.FolderResult{Path: path}}
+	}
+	core := leaf("core")
+	core.Children = []schema.FolderTreeNode{leaf("core/agg"), leaf("core/algo")}
+	root := leaf(".")
+	root.Children = []schema.FolderTreeNode{leaf("cmd"), core}
+
+	var names []string
+	for _, row := range flattenFolderTree(root) {
+		names = append(names, row.name)
+	}
+	assert.Equal(t, []string{".", "├─ cmd", "└─ core", "   ├─ agg", "   └─ algo"}, names)
+}
+
 func TestWriteMetrics(t *testing.T) {
 	p := NewJSONProvider()
 	activeWeights := map[schema.ScoringMode]map[schema.BreakdownKey]float64{
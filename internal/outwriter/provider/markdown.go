@@ -33,7 +33,7 @@ func (p *MarkdownProvider) WriteFiles(w io.Writer, files []schema.FileResult, ou
 
 	headers := []string{"Rank", "Path", "Score", "Label"}
 	if output.IsDetail() {
-		headers = append(headers, "Contrib", "Commits", "LOC", "Churn", "Age", "Gini")
+		headers = append(headers, "Contrib", "Commits", "LOC", "SLOC", "Cyclo", "Cognit", "Churn", "Age", "Gini", "Off Hrs", "Weekend")
 	}
 	if output.IsExplain() {
 		headers = append(headers, "Explain")
@@ -56,9 +56,14 @@ func (p *MarkdownProvider) WriteFiles(w io.Writer, files []schema.FileResult, ou
 				f.UniqueContributors.Display(),
 				f.Commits.Display(),
 				f.LinesOfCode.Display(),
+				f.SLOC.Display(),
+				formatFunctionComplexity(&f, f.CyclomaticMax),
+				formatFunctionComplexity(&f, f.CognitiveMax),
 				f.Churn.Display(),
 				f.AgeDays.Display(),
 				fmtFloat(f.Gini),
+				formatWorkRatio(&f, f.OffHoursRatio, fmtFloat),
+				formatWorkRatio(&f, f.WeekendRatio, fmtFloat),
 			)
 		}
 		if output.IsExplain() {
@@ -92,7 +97,7 @@ func (p *MarkdownProvider) WriteFolders(w io.Writer, results []schema.FolderResu
 
 	headers := []string{"Rank", "Path", "Score", "Label"}
 	if output.IsDetail() {
-		headers = append(headers, "Commits", "Churn", "LOC", "Contrib", "Gini")
+		headers = append(headers, "Commits", "Churn", "LOC", "Contrib", "Gini", "Top File")
 	}
 	if output.IsOwner() {
 		headers = append(headers, "Owner")
@@ -114,6 +119,7 @@ func (p *MarkdownProvider) WriteFolders(w io.Writer, results []schema.FolderResu
 				r.TotalLOC.Display(),
 				r.UniqueContributors.Display(),
 				fmtFloat(r.Gini),
+				formatTopFile(r, fmtFloat, 0),
 			)
 		}
 		if output.IsOwner() {
@@ -131,6 +137,56 @@ func (p *MarkdownProvider) WriteFolders(w io.Writer, results []schema.FolderResu
 	return nil
 }
 
+// WriteFolderTree writes a hierarchical folder roll-up in Markdown format.
+func (p *MarkdownProvider) WriteFolderTree(w io.Writer, root schema.FolderTreeNode, output config.OutputSettings, _ config.RuntimeSettings, duration time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+
+	if _, err := fmt.Fprintln(w, "## Folder Tree"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	headers := []string{"Path", "Score", "Label"}
+	if output.IsDetail() {
+		headers = append(headers, "Commits", "Churn", "LOC", "Contrib", "Gini", "Top File")
+	}
+	headers = append(headers, "Owner")
+
+	p.writeMarkdownTable(w, headers)
+
+	rows := flattenFolderTree(root)
+	for _, tr := range rows {
+		r := tr.node
+		row := []string{
+			"`" + tr.name + "`",
+			fmtFloat(r.Score),
+			r.Label,
+		}
+		if output.IsDetail() {
+			row = append(row,
+				r.Commits.Display(),
+				r.Churn.Display(),
+				r.TotalLOC.Display(),
+				r.UniqueContributors.Display(),
+				fmtFloat(r.Gini),
+				formatTopFile(r.FolderResult, fmtFloat, 0),
+			)
+		}
+		row = append(row, strings.Join(r.Owners, ", "))
+		p.writeMarkdownRow(w, row)
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "*Showing %d folders. Analysis completed in %v.*\n", len(rows), duration); err != nil {
+		return err
+	}
+	return nil
+}
+
 // WriteComparison writes comparison analysis results in Markdown format.
 func (p *MarkdownProvider) WriteComparison(w io.Writer, results schema.ComparisonResult, output config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
 	fmtFloat := CreateFormatters(output.GetPrecision())
@@ -207,7 +263,7 @@ func (p *MarkdownProvider) WriteTimeseries(w io.Writer, result schema.Timeseries
 		return err
 	}
 
-	headers := []string{"Rank", "Path", "Period", "Score", "Mode", "Owner"}
+	headers := []string{"Rank", "Path", "Period", "Score", "Entropy", "Mode", "Owner"}
 	p.writeMarkdownTable(w, headers)
 
 	for i, pt := range result.Points {
@@ -220,6 +276,7 @@ func (p *MarkdownProvider) WriteTimeseries(w io.Writer, result schema.Timeseries
 			pt.Path,
 			pt.Period,
 			fmtFloat(pt.Score),
+			fmtFloat(pt.ChangeEntropy),
 			string(pt.Mode),
 			ownersStr,
 		}
@@ -231,6 +288,9 @@ func (p *MarkdownProvider) WriteTimeseries(w io.Writer, result schema.Timeseries
 
 // WriteBlastRadius writes blast radius analysis results in Markdown format.
 func (p *MarkdownProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadiusResult, output config.OutputSettings, _ config.RuntimeSettings, duration time.Duration) error {
+	if result.Summary.Path != "" {
+		return p.writeBlastRadiusImpact(w, result, output, duration)
+	}
 	fmtFloat := CreateFormatters(output.GetPrecision())
 
 	if _, err := fmt.Fprintln(w, "## Blast Radius Analysis"); err != nil {
@@ -239,11 +299,21 @@ func (p *MarkdownProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadi
 	if _, err := fmt.Fprintln(w); err != nil {
 		return err
 	}
-	if _, err := fmt.Fprintf(w, "Found **%d** coupled pairs above threshold **%v**.\n\n", result.Summary.TotalPairs, result.Summary.Threshold); err != nil {
+	unit := couplingUnitName(result.Summary.Level)
+	fileLevel := unit == "File"
+	if _, err := fmt.Fprintf(w, "Found **%d** coupled %s pairs above threshold **%v**.\n\n", result.Summary.TotalPairs, strings.ToLower(unit), result.Summary.Threshold); err != nil {
 		return err
 	}
+	if s := result.Summary; s.ExplainedPairs+s.HiddenPairs > 0 {
+		if _, err := fmt.Fprintf(w, "The Go import graph explains **%d** pairs; **%d** are hidden.\n\n", s.ExplainedPairs, s.HiddenPairs); err != nil {
+			return err
+		}
+	}
 
-	headers := []string{"Rank", "File A", "File B", "Score", "Co-Change"}
+	headers := []string{"Rank", unit + " A", unit + " B", "Score", "Co-Change", "Support", "Conf A→B", "Conf B→A"}
+	if fileLevel {
+		headers = append(headers, "Relation")
+	}
 	p.writeMarkdownTable(w, headers)
 
 	for i, pair := range result.Pairs {
@@ -253,6 +323,12 @@ func (p *MarkdownProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadi
 			pair.Target,
 			fmtFloat(pair.Score),
 			strconv.Itoa(pair.CoChange),
+			formatPercent(pair.Support),
+			formatPercent(pair.Confidence),
+			formatPercent(pair.ReverseConfidence),
+		}
+		if fileLevel {
+			row = append(row, formatRelation(pair.Relation))
 		}
 		p.writeMarkdownRow(w, row)
 	}
@@ -266,6 +342,247 @@ func (p *MarkdownProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadi
 	return nil
 }
 
+// writeBlastRadiusImpact outputs the impact set of one file, folder or module.
+func (p *MarkdownProvider) writeBlastRadiusImpact(w io.Writer, result schema.BlastRadiusResult, output config.OutputSettings, duration time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+
+	if _, err := fmt.Fprintln(w, "## Blast Radius Analysis"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	s := result.Summary
+	unit := couplingUnitName(s.Level)
+	fileLevel := unit == "File"
+	if _, err := fmt.Fprintf(w, "Found **%d** %ss that change with `%s` (%d commits) up to depth **%d** at confidence **%v**.\n\n", len(result.Impact), strings.ToLower(unit), s.Path, s.PathCommits, s.ImpactDepth, s.Threshold); err != nil {
+		return err
+	}
+	if s.ExplainedPairs+s.HiddenPairs > 0 {
+		if _, err := fmt.Fprintf(w, "The Go import graph explains **%d** files; **%d** are hidden.\n\n", s.ExplainedPairs, s.HiddenPairs); err != nil {
+			return err
+		}
+	}
+
+	headers := []string{"Rank", unit, "Depth", "Via", "Co-Change", "Support", "Confidence", "Lift", "Impact"}
+	if fileLevel {
+		headers = append(headers, "Relation")
+	}
+	p.writeMarkdownTable(w, headers)
+
+	for i, entry := range result.Impact {
+		row := []string{
+			strconv.Itoa(i + 1),
+			entry.Path,
+			strconv.Itoa(entry.Depth),
+			entry.Via,
+			strconv.Itoa(entry.CoChange),
+			formatPercent(entry.Support),
+			formatPercent(entry.Confidence),
+			fmtFloat(entry.Lift),
+			formatPercent(entry.Impact),
+		}
+		if fileLevel {
+			row = append(row, formatRelation(entry.Relation))
+		}
+		p.writeMarkdownRow(w, row)
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "*Blast radius analysis completed in %v. Total commits analyzed: %d.*\n", duration, s.TotalCommits); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteTickets writes ticket analysis results in Markdown format.
+func (p *MarkdownProvider) WriteTickets(w io.Writer, result schema.TicketsResult, _ config.OutputSettings, _ config.RuntimeSettings, duration time.Duration) error {
+	if _, err := fmt.Fprintln(w, "## Ticket Analysis"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Found **%d** tickets in **%d** of %d commits%s.\n\n", result.Summary.TotalTickets, result.Summary.LinkedCommits, result.Summary.TotalCommits, formatTicketPrefixes(result.Summary.Prefixes)); err != nil {
+		return err
+	}
+
+	headers := []string{"Rank", "Ticket", "Commits", "Fixes", "Churn", "Files", "Last Seen", "Subject"}
+	p.writeMarkdownTable(w, headers)
+
+	for i, ticket := range result.Tickets {
+		row := []string{
+			strconv.Itoa(i + 1),
+			ticket.Key,
+			strconv.Itoa(ticket.Commits),
+			strconv.Itoa(ticket.FixCommits),
+			strconv.Itoa(ticket.Churn),
+			strconv.Itoa(ticket.Files),
+			ticket.LastSeen.Format(time.DateOnly),
+			strings.ReplaceAll(ticket.Subject, "|", "\\|"),
+		}
+		p.writeMarkdownRow(w, row)
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "*Ticket analysis completed in %v.*\n", duration); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteDeps writes dependency analysis results in Markdown format.
+func (p *MarkdownProvider) WriteDeps(w io.Writer, result schema.DepsResult, _ config.OutputSettings, _ config.RuntimeSettings, duration time.Duration) error {
+	if _, err := fmt.Fprintln(w, "## Dependency Analysis"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Found **%d** dependency changes across **%d** dependencies in %d manifests, %d of them silos%s.\n\n", result.Summary.Changes, result.Summary.Dependencies, result.Summary.Manifests, result.Summary.SiloManifests, formatDepsAuthors(result.Authors)); err != nil {
+		return err
+	}
+
+	p.writeMarkdownTable(w, []string{"Rank", "Dependency", "Ecosystem", "Version", "Changes", "Top Author", "Last Changed"})
+	for i, dep := range result.Dependencies {
+		p.writeMarkdownRow(w, []string{
+			strconv.Itoa(i + 1),
+			dep.Name,
+			dep.Ecosystem,
+			dep.Version,
+			strconv.Itoa(dep.Changes),
+			dep.TopAuthor,
+			formatWindowDate(dep.LastChanged),
+		})
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "### Manifests"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	p.writeMarkdownTable(w, []string{"Manifest", "Deps", "Commits", "Authors", "Top Author", "Share", "Silo"})
+	for _, manifest := range result.Manifests {
+		silo := ""
+		if manifest.Silo {
+			silo = "yes"
+		}
+		p.writeMarkdownRow(w, []string{
+			manifest.Path,
+			strconv.Itoa(manifest.Dependencies),
+			strconv.Itoa(manifest.Commits),
+			strconv.Itoa(manifest.Authors),
+			manifest.TopAuthor,
+			fmt.Sprintf("%.0f%%", manifest.TopAuthorShare*100),
+			silo,
+		})
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "*Dependency analysis completed in %v.*\n", duration); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteDocDrift writes documentation drift results in Markdown format.
+func (p *MarkdownProvider) WriteDocDrift(w io.Writer, result schema.DocDriftResult, output config.OutputSettings, _ config.RuntimeSettings, duration time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	if _, err := fmt.Fprintln(w, "## Documentation Drift"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Found **%d** documented folders, **%d** with code commits since their docs changed (%d undocumented).\n\n", result.Summary.DocumentedFolders, result.Summary.StaleFolders, result.Summary.UndocumentedFolders); err != nil {
+		return err
+	}
+
+	headers := []string{"Rank", "Folder", "Docs", "Docs Changed", "Commits", "Since Docs", "Churn Since", "Drift", "Score"}
+	p.writeMarkdownTable(w, headers)
+
+	for i, folder := range result.Folders {
+		row := []string{
+			strconv.Itoa(i + 1),
+			folder.Path,
+			strconv.Itoa(folder.DocFiles),
+			formatWindowDate(folder.DocsLastModified),
+			folder.CodeCommits.Display(),
+			folder.CommitsSinceDocs.Display(),
+			folder.ChurnSinceDocs.Display(),
+			fmt.Sprintf("%.0f%%", folder.DriftRatio*100),
+			fmtFloat(folder.Score),
+		}
+		p.writeMarkdownRow(w, row)
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "*Documentation drift analysis completed in %v.*\n", duration); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteFunctions writes function analysis results in Markdown format.
+func (p *MarkdownProvider) WriteFunctions(w io.Writer, result schema.FunctionsResult, output config.OutputSettings, _ config.RuntimeSettings, duration time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	if _, err := fmt.Fprintln(w, "## Function Hotspots"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Showing **%d** of %d functions in %d Go files (%d commits).\n\n", len(result.Functions), result.Summary.Functions, len(result.Summary.Files), result.Summary.Commits); err != nil {
+		return err
+	}
+
+	headers := []string{"Rank", "Function", "File", "Lines", "Score", "Label", "Commits", "Churn", "Authors", "Cyclo", "Cognit"}
+	if output.IsOwner() {
+		headers = append(headers, "Owner")
+	}
+	p.writeMarkdownTable(w, headers)
+
+	for i, fn := range result.Functions {
+		row := []string{
+			strconv.Itoa(i + 1),
+			"`" + fn.Name + "`",
+			fn.Path,
+			fmt.Sprintf("%d-%d", fn.Line, fn.EndLine),
+			fmtFloat(fn.Score),
+			schema.GetPlainLabel(fn.Score),
+			fn.Commits.Display(),
+			fn.Churn.Display(),
+			fn.Contributors.Display(),
+			strconv.Itoa(fn.Cyclomatic),
+			strconv.Itoa(fn.Cognitive),
+		}
+		if output.IsOwner() {
+			row = append(row, strings.Join(fn.Owners, ", "))
+		}
+		p.writeMarkdownRow(w, row)
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "*Function analysis completed in %v.*\n", duration); err != nil {
+		return err
+	}
+	return nil
+}
+
 // WriteMetrics writes metrics definitions in Markdown format.
 func (p *MarkdownProvider) WriteMetrics(w io.Writer, activeWeights map[schema.ScoringMode]map[schema.BreakdownKey]float64, _ config.OutputSettings) error {
 	renderModel := schema.BuildMetricsRenderModel(activeWeights)
@@ -289,6 +606,18 @@ func (p *MarkdownProvider) WriteMetrics(w io.Writer, activeWeights map[schema.Sc
 		}
 		p.writeMarkdownRow(w, row)
 	}
+	for _, c := range renderModel.Composites {
+		row := []string{
+			c.Name,
+			c.Description,
+			strings.Join(c.BaseModes, ", "),
+			c.Formula,
+		}
+		p.writeMarkdownRow(w, row)
+	}
+	for _, c := range renderModel.CustomModes {
+		p.writeMarkdownRow(w, []string{c.Name, c.Description, "", c.Expression})
+	}
 
 	return nil
 }
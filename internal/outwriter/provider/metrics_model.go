@@ -60,5 +60,9 @@ func FormatTopMetricBreakdown(f *schema.FileResult) string {
 	if len(parts) == 0 {
 		return "No meaningful contributors"
 	}
-	return strings.Join(parts, " > ")
+	explain := strings.Join(parts, " > ")
+	if len(f.Modifiers) > 0 {
+		explain += " [" + strings.Join(f.Modifiers, ", ") + "]"
+	}
+	return explain
 }
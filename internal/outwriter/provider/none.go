@@ -26,6 +26,11 @@ func (p *NoneProvider) WriteFolders(_ io.Writer, _ []schema.FolderResult, _ conf
 	return nil
 }
 
+// WriteFolderTree is a no-op implementation of FormatProvider.
+func (p *NoneProvider) WriteFolderTree(_ io.Writer, _ schema.FolderTreeNode, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return nil
+}
+
 // WriteComparison is a no-op implementation of FormatProvider.
 func (p *NoneProvider) WriteComparison(_ io.Writer, _ schema.ComparisonResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
 	return nil
@@ -46,6 +51,26 @@ func (p *NoneProvider) WriteBlastRadius(_ io.Writer, _ schema.BlastRadiusResult,
 	return nil
 }
 
+// WriteTickets is a no-op implementation of FormatProvider.
+func (p *NoneProvider) WriteTickets(_ io.Writer, _ schema.TicketsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return nil
+}
+
+// WriteDeps is a no-op implementation of FormatProvider.
+func (p *NoneProvider) WriteDeps(_ io.Writer, _ schema.DepsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return nil
+}
+
+// WriteDocDrift is a no-op implementation of FormatProvider.
+func (p *NoneProvider) WriteDocDrift(_ io.Writer, _ schema.DocDriftResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return nil
+}
+
+// WriteFunctions is a no-op implementation of FormatProvider.
+func (p *NoneProvider) WriteFunctions(_ io.Writer, _ schema.FunctionsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	return nil
+}
+
 // WriteHistory is a no-op implementation of FormatProvider.
 func (p *NoneProvider) WriteHistory(_ io.Writer, _ []schema.AnalysisRunRecord, _ config.OutputSettings) error {
 	return nil
@@ -41,18 +41,21 @@ func (p *ParquetProvider) WriteFiles(_ io.Writer, files []schema.FileResult, out
 		// Note: We don't have all scores here, only the current mode's score.
 		// We'll fill what we have.
 		records[i] = parquet.FileScoresMetrics{
-			FilePath:           f.Path,
-			AnalysisTime:       time.Now().UTC(),
-			TotalCommits:       f.Commits.Float64(),
-			TotalChurn:         f.Churn.Float64(),
-			LinesOfCode:        f.LinesOfCode.Float64(),
-			ContributorCount:   f.UniqueContributors.Float64(),
-			AgeDays:            f.AgeDays.Float64(),
-			RecentLinesAdded:   f.RecentLinesAdded.Float64(),
-			RecentLinesDeleted: f.RecentLinesDeleted.Float64(),
-			GiniCoefficient:    f.Gini,
-			FileOwner:          owner,
-			ScoreLabel:         string(f.Mode),
+			FilePath:              f.Path,
+			AnalysisTime:          time.Now().UTC(),
+			TotalCommits:          f.Commits.Float64(),
+			TotalChurn:            f.Churn.Float64(),
+			LinesOfCode:           f.LinesOfCode.Float64(),
+			ContributorCount:      f.UniqueContributors.Float64(),
+			AgeDays:               f.AgeDays.Float64(),
+			RecentLinesAdded:      f.RecentLinesAdded.Float64(),
+			RecentLinesDeleted:    f.RecentLinesDeleted.Float64(),
+			GiniCoefficient:       f.Gini,
+			MinorContributorCount: f.MinorContributors.Float64(),
+			TopOwnerShare:         f.TopOwnerShare,
+			OwnershipEntropy:      f.OwnershipEntropy,
+			FileOwner:             owner,
+			ScoreLabel:            string(f.Mode),
 		}
 
 		// Set the appropriate score field based on mode
@@ -75,6 +78,12 @@ func (p *ParquetProvider) WriteFolders(w io.Writer, _ []schema.FolderResult, _ c
 	return err
 }
 
+// WriteFolderTree is not specifically implemented for Parquet.
+func (p *ParquetProvider) WriteFolderTree(w io.Writer, _ schema.FolderTreeNode, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Parquet output is not supported for folder trees.")
+	return err
+}
+
 // WriteComparison is not specifically implemented for Parquet.
 func (p *ParquetProvider) WriteComparison(w io.Writer, _ schema.ComparisonResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
 	_, err := fmt.Fprintln(w, "Parquet output is not supported for comparison analysis.")
@@ -93,6 +102,30 @@ func (p *ParquetProvider) WriteBlastRadius(w io.Writer, _ schema.BlastRadiusResu
 	return err
 }
 
+// WriteTickets is not specifically implemented for Parquet.
+func (p *ParquetProvider) WriteTickets(w io.Writer, _ schema.TicketsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Parquet output is not supported for ticket analysis.")
+	return err
+}
+
+// WriteDeps is not specifically implemented for Parquet.
+func (p *ParquetProvider) WriteDeps(w io.Writer, _ schema.DepsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Parquet output is not supported for dependency analysis.")
+	return err
+}
+
+// WriteDocDrift is not specifically implemented for Parquet.
+func (p *ParquetProvider) WriteDocDrift(w io.Writer, _ schema.DocDriftResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Parquet output is not supported for documentation drift analysis.")
+	return err
+}
+
+// WriteFunctions is not specifically implemented for Parquet.
+func (p *ParquetProvider) WriteFunctions(w io.Writer, _ schema.FunctionsResult, _ config.OutputSettings, _ config.RuntimeSettings, _ time.Duration) error {
+	_, err := fmt.Fprintln(w, "Parquet output is not supported for function analysis.")
+	return err
+}
+
 // WriteMetrics is not specifically implemented for Parquet.
 func (p *ParquetProvider) WriteMetrics(w io.Writer, _ map[schema.ScoringMode]map[schema.BreakdownKey]float64, _ config.OutputSettings) error {
 	_, err := fmt.Fprintln(w, "Parquet output is not supported for metrics definitions.")
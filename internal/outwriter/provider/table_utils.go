@@ -35,7 +35,7 @@ func GetMaxTablePathWidth(output config.OutputSettings) int {
 
 	// Add detail columns with formatting
 	if output.IsDetail() {
-		baseWidth += 55 // All detail columns (Contrib + Commits + LOC + Churn + Age + Gini) with formatting
+		baseWidth += 99 // All detail columns (Contrib + Commits + LOC + SLOC + Cyclo + Cognit + Churn + Age + Gini + Off Hrs + Weekend) with formatting
 	}
 
 	// Add explain column
@@ -30,7 +30,7 @@ func (p *TextProvider) WriteFiles(w io.Writer, files []schema.FileResult, output
 
 	headers := []string{"Rank", "Path", "Score", "Label"}
 	if output.IsDetail() {
-		headers = append(headers, "Contrib", "Commits", "LOC", "Churn", "Age", "Gini")
+		headers = append(headers, "Contrib", "Commits", "LOC", "SLOC", "Cyclo", "Cognit", "Churn", "Age", "Gini", "Off Hrs", "Weekend")
 	}
 	if output.IsExplain() {
 		headers = append(headers, "Explain")
@@ -57,14 +57,21 @@ func (p *TextProvider) WriteFiles(w io.Writer, files []schema.FileResult, output
 			label,                                              // Label
 		}
 		if output.IsDetail() {
+			cyclo := formatFunctionComplexity(&f, f.CyclomaticMax)
+			cognit := formatFunctionComplexity(&f, f.CognitiveMax)
 			row = append(
 				row,
 				f.UniqueContributors.Display(), // Contrib
 				f.Commits.Display(),            // Commits
 				f.LinesOfCode.Display(),        // LOC
+				f.SLOC.Display(),               // SLOC
+				cyclo,                          // Cyclo
+				cognit,                         // Cognit
 				f.Churn.Display(),              // Churn
 				f.AgeDays.Display(),            // Age
 				fmtFloat(f.Gini),               // Gini
+				formatWorkRatio(&f, f.OffHoursRatio, fmtFloat), // Off Hrs
+				formatWorkRatio(&f, f.WeekendRatio, fmtFloat),  // Weekend
 			)
 		}
 		if output.IsExplain() {
@@ -108,7 +115,7 @@ func (p *TextProvider) WriteFolders(w io.Writer, results []schema.FolderResult,
 
 	headers := []string{"Rank", "Path", "Score", "Label"}
 	if output.IsDetail() {
-		headers = append(headers, "Commits", "Churn", "LOC", "Contrib", "Gini")
+		headers = append(headers, "Commits", "Churn", "LOC", "Contrib", "Gini", "Top File")
 	}
 	if output.IsOwner() {
 		headers = append(headers, "Owner")
@@ -138,6 +145,7 @@ func (p *TextProvider) WriteFolders(w io.Writer, results []schema.FolderResult,
 				r.TotalLOC.Display(),           // Total LOC
 				r.UniqueContributors.Display(), // Unique Contributors
 				fmtFloat(r.Gini),               // Gini Coefficient
+				formatTopFile(r, fmtFloat, GetMaxTablePathWidth(output)), // Top Contributing File
 			)
 		}
 		if output.IsOwner() {
@@ -165,6 +173,77 @@ func (p *TextProvider) WriteFolders(w io.Writer, results []schema.FolderResult,
 	if _, err := fmt.Fprintf(w, "Showing top %d folders (total commits: %s, total churn: %s, total LOC: %s)\n", numFolders, totalCommits.Display(), totalChurn.Display(), totalLOC.Display()); err != nil {
 		return err
 	}
+	if numFolders > 0 {
+		if err := writeFolderAggregationNote(w, results[0].Aggregation); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "Analysis completed in %v with %d workers. Cache backend: %s\n", duration, runtime.GetWorkers(), runtime.GetCacheBackend()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteFolderTree writes a hierarchical folder roll-up as an indented table. Owners are
+// always shown so every level names who to talk to.
+func (p *TextProvider) WriteFolderTree(w io.Writer, root schema.FolderTreeNode, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	table := tablewriter.NewWriter(w)
+	defer func() { _ = table.Close() }()
+
+	headers := []string{"Path", "Score", "Label"}
+	if output.IsDetail() {
+		headers = append(headers, "Commits", "Churn", "LOC", "Contrib", "Gini", "Top File")
+	}
+	headers = append(headers, "Owner")
+	table.Header(headers)
+
+	table.Configure(func(cfg *tablewriter.Config) {
+		cfg.Behavior.TrimSpace = tw.Off // Keep the indentation of nested folders
+		cfg.Row.Alignment.PerColumn = []tw.Align{tw.AlignLeft}
+		cfg.Row.Alignment.Global = tw.AlignRight
+	})
+
+	rows := flattenFolderTree(root)
+	var data [][]string
+	for _, tr := range rows {
+		r := tr.node
+		label := r.Label
+		if output.IsUseColors() {
+			label = GetColorLabel(r.Score)
+		}
+		row := []string{
+			TruncatePath(tr.name, GetMaxTablePathWidth(output)), // Indented folder name
+			fmtFloat(r.Score), // Score
+			label,             // Label
+		}
+		if output.IsDetail() {
+			row = append(row,
+				r.Commits.Display(),            // Total Commits
+				r.Churn.Display(),              // Total Churn
+				r.TotalLOC.Display(),           // Total LOC
+				r.UniqueContributors.Display(), // Unique Contributors
+				fmtFloat(r.Gini),               // Gini Coefficient
+				formatTopFile(r.FolderResult, fmtFloat, GetMaxTablePathWidth(output)), // Top Contributing File
+			)
+		}
+		row = append(row, schema.FormatOwners(r.Owners))
+		data = append(data, row)
+	}
+
+	if err := table.Bulk(data); err != nil {
+		return err
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "Showing %d folders (total commits: %s, total churn: %s, total LOC: %s)\n", len(rows), root.Commits.Display(), root.Churn.Display(), root.TotalLOC.Display()); err != nil {
+		return err
+	}
+	if err := writeFolderAggregationNote(w, root.Aggregation); err != nil {
+		return err
+	}
 	if _, err := fmt.Fprintf(w, "Analysis completed in %v with %d workers. Cache backend: %s\n", duration, runtime.GetWorkers(), runtime.GetCacheBackend()); err != nil {
 		return err
 	}
@@ -240,7 +319,7 @@ func (p *TextProvider) WriteTimeseries(w io.Writer, result schema.TimeseriesResu
 	table := tablewriter.NewWriter(w)
 	defer func() { _ = table.Close() }()
 
-	headers := []string{"Rank", "Path", "Period", "Score", "Mode", "Owner"}
+	headers := []string{"Rank", "Path", "Period", "Score", "Entropy", "Mode", "Owner"}
 	table.Header(headers)
 
 	table.Configure(func(cfg *tablewriter.Config) {
@@ -258,6 +337,7 @@ func (p *TextProvider) WriteTimeseries(w io.Writer, result schema.TimeseriesResu
 			TruncatePath(pt.Path, GetMaxTablePathWidth(output)),
 			pt.Period,
 			fmtFloat(pt.Score),
+			fmtFloat(pt.ChangeEntropy),
 			string(pt.Mode),
 			ownersStr,
 		}
@@ -279,11 +359,19 @@ func (p *TextProvider) WriteTimeseries(w io.Writer, result schema.TimeseriesResu
 
 // WriteBlastRadius writes blast radius analysis results in a human-readable table.
 func (p *TextProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadiusResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	if result.Summary.Path != "" {
+		return p.writeBlastRadiusImpact(w, result, output, runtime, duration)
+	}
 	fmtFloat := CreateFormatters(output.GetPrecision())
 	table := tablewriter.NewWriter(w)
 	defer func() { _ = table.Close() }()
 
-	headers := []string{"Rank", "File A", "File B", "Score", "Co-Change"}
+	unit := couplingUnitName(result.Summary.Level)
+	fileLevel := unit == "File"
+	headers := []string{"Rank", unit + " A", unit + " B", "Score", "Co-Change", "Support", "Conf A→B", "Conf B→A"}
+	if fileLevel {
+		headers = append(headers, "Relation")
+	}
 	table.Header(headers)
 
 	table.Configure(func(cfg *tablewriter.Config) {
@@ -298,6 +386,12 @@ func (p *TextProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadiusRe
 			TruncatePath(pair.Target, GetMaxTablePathWidth(output)),
 			fmtFloat(pair.Score),
 			strconv.Itoa(pair.CoChange),
+			formatPercent(pair.Support),
+			formatPercent(pair.Confidence),
+			formatPercent(pair.ReverseConfidence),
+		}
+		if fileLevel {
+			row = append(row, formatRelation(pair.Relation))
 		}
 		data = append(data, row)
 	}
@@ -309,15 +403,304 @@ func (p *TextProvider) WriteBlastRadius(w io.Writer, result schema.BlastRadiusRe
 		return err
 	}
 
-	if _, err := fmt.Fprintf(w, "Found %d coupled pairs above threshold %v (total commits analyzed: %d)\n", result.Summary.TotalPairs, result.Summary.Threshold, result.Summary.TotalCommits); err != nil {
+	if _, err := fmt.Fprintf(w, "Found %d coupled %s pairs above threshold %v (total commits analyzed: %d)\n", result.Summary.TotalPairs, strings.ToLower(unit), result.Summary.Threshold, result.Summary.TotalCommits); err != nil {
 		return err
 	}
+	if s := result.Summary; s.ExplainedPairs+s.HiddenPairs > 0 {
+		if _, err := fmt.Fprintf(w, "Go import graph: %d pairs explained by a shared package or import, %d hidden\n", s.ExplainedPairs, s.HiddenPairs); err != nil {
+			return err
+		}
+	}
 	if _, err := fmt.Fprintf(w, "Blast radius analysis completed in %v with %d workers. Cache backend: %s\n", duration, runtime.GetWorkers(), runtime.GetCacheBackend()); err != nil {
 		return err
 	}
 	return nil
 }
 
+// writeBlastRadiusImpact outputs the impact set of one file, folder or module.
+func (p *TextProvider) writeBlastRadiusImpact(w io.Writer, result schema.BlastRadiusResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	table := tablewriter.NewWriter(w)
+	defer func() { _ = table.Close() }()
+
+	unit := couplingUnitName(result.Summary.Level)
+	fileLevel := unit == "File"
+	headers := []string{"Rank", unit, "Depth", "Via", "Co-Change", "Support", "Confidence", "Lift", "Impact"}
+	if fileLevel {
+		headers = append(headers, "Relation")
+	}
+	table.Header(headers)
+
+	table.Configure(func(cfg *tablewriter.Config) {
+		cfg.Row.Alignment.Global = tw.AlignRight
+	})
+
+	var data [][]string
+	for i, entry := range result.Impact {
+		row := []string{
+			strconv.Itoa(i + 1),
+			TruncatePath(entry.Path, GetMaxTablePathWidth(output)),
+			strconv.Itoa(entry.Depth),
+			TruncatePath(entry.Via, GetMaxTablePathWidth(output)),
+			strconv.Itoa(entry.CoChange),
+			formatPercent(entry.Support),
+			formatPercent(entry.Confidence),
+			fmtFloat(entry.Lift),
+			formatPercent(entry.Impact),
+		}
+		if fileLevel {
+			row = append(row, formatRelation(entry.Relation))
+		}
+		data = append(data, row)
+	}
+
+	if err := table.Bulk(data); err != nil {
+		return err
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	s := result.Summary
+	if _, err := fmt.Fprintf(w, "Found %d %ss that change with %s (%d commits) up to depth %d at confidence %v (total commits analyzed: %d)\n", len(result.Impact), strings.ToLower(unit), s.Path, s.PathCommits, s.ImpactDepth, s.Threshold, s.TotalCommits); err != nil {
+		return err
+	}
+	if s.ExplainedPairs+s.HiddenPairs > 0 {
+		if _, err := fmt.Fprintf(w, "Go import graph: %d files explained by a shared package or import, %d hidden\n", s.ExplainedPairs, s.HiddenPairs); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "Blast radius analysis completed in %v with %d workers. Cache backend: %s\n", duration, runtime.GetWorkers(), runtime.GetCacheBackend()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteTickets writes ticket analysis results in a human-readable table.
+func (p *TextProvider) WriteTickets(w io.Writer, result schema.TicketsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	table := tablewriter.NewWriter(w)
+	defer func() { _ = table.Close() }()
+
+	headers := []string{"Rank", "Ticket", "Commits", "Fixes", "Churn", "Files", "Last Seen", "Subject"}
+	table.Header(headers)
+
+	table.Configure(func(cfg *tablewriter.Config) {
+		cfg.Row.Alignment.Global = tw.AlignRight
+	})
+
+	var data [][]string
+	for i, ticket := range result.Tickets {
+		row := []string{
+			strconv.Itoa(i + 1),
+			ticket.Key,
+			strconv.Itoa(ticket.Commits),
+			strconv.Itoa(ticket.FixCommits),
+			strconv.Itoa(ticket.Churn),
+			strconv.Itoa(ticket.Files),
+			ticket.LastSeen.Format(time.DateOnly),
+			TruncatePath(ticket.Subject, GetMaxTablePathWidth(output)),
+		}
+		data = append(data, row)
+	}
+
+	if err := table.Bulk(data); err != nil {
+		return err
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "Found %d tickets in %d of %d commits%s\n", result.Summary.TotalTickets, result.Summary.LinkedCommits, result.Summary.TotalCommits, formatTicketPrefixes(result.Summary.Prefixes)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Ticket analysis completed in %v with %d workers. Cache backend: %s\n", duration, runtime.GetWorkers(), runtime.GetCacheBackend()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteDeps writes dependency analysis results as a dependency table and a manifest table.
+func (p *TextProvider) WriteDeps(w io.Writer, result schema.DepsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	maxWidth := GetMaxTablePathWidth(output)
+
+	var deps [][]string
+	for i, dep := range result.Dependencies {
+		deps = append(deps, []string{
+			strconv.Itoa(i + 1),
+			TruncatePath(dep.Name, maxWidth),
+			dep.Ecosystem,
+			dep.Version,
+			strconv.Itoa(dep.Changes),
+			dep.TopAuthor,
+			formatWindowDate(dep.LastChanged),
+		})
+	}
+	if err := renderTextTable(w, []string{"Rank", "Dependency", "Ecosystem", "Version", "Changes", "Top Author", "Last Changed"}, deps); err != nil {
+		return err
+	}
+
+	var manifests [][]string
+	for _, manifest := range result.Manifests {
+		silo := ""
+		if manifest.Silo {
+			silo = "yes"
+		}
+		manifests = append(manifests, []string{
+			TruncatePath(manifest.Path, maxWidth),
+			strconv.Itoa(manifest.Dependencies),
+			strconv.Itoa(manifest.Commits),
+			strconv.Itoa(manifest.Authors),
+			manifest.TopAuthor,
+			fmt.Sprintf("%.0f%%", manifest.TopAuthorShare*100),
+			silo,
+		})
+	}
+	if err := renderTextTable(w, []string{"Manifest", "Deps", "Commits", "Authors", "Top Author", "Share", "Silo"}, manifests); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "Found %d dependency changes across %d dependencies in %d manifests, %d of them silos%s\n", result.Summary.Changes, result.Summary.Dependencies, result.Summary.Manifests, result.Summary.SiloManifests, formatDepsAuthors(result.Authors)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Dependency analysis completed in %v with %d workers. Cache backend: %s\n", duration, runtime.GetWorkers(), runtime.GetCacheBackend()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// renderTextTable renders one right-aligned table.
+func renderTextTable(w io.Writer, headers []string, data [][]string) error {
+	table := tablewriter.NewWriter(w)
+	defer func() { _ = table.Close() }()
+
+	table.Header(headers)
+	table.Configure(func(cfg *tablewriter.Config) {
+		cfg.Row.Alignment.Global = tw.AlignRight
+	})
+	if err := table.Bulk(data); err != nil {
+		return err
+	}
+	return table.Render()
+}
+
+// formatDepsAuthors summarizes the authors who change dependencies most, e.g. " (top upgraders: alice 12, bob 4)".
+func formatDepsAuthors(authors []schema.DepsAuthor) string {
+	parts := make([]string, 0, 3)
+	for i := 0; i < len(authors) && len(parts) < 3; i++ {
+		if authors[i].Changes > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", authors[i].Author, authors[i].Changes))
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (top upgraders: " + strings.Join(parts, ", ") + ")"
+}
+
+// WriteDocDrift writes documentation drift results in a human-readable table.
+func (p *TextProvider) WriteDocDrift(w io.Writer, result schema.DocDriftResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	table := tablewriter.NewWriter(w)
+	defer func() { _ = table.Close() }()
+
+	headers := []string{"Rank", "Folder", "Docs", "Docs Changed", "Commits", "Since Docs", "Churn Since", "Drift", "Score"}
+	table.Header(headers)
+
+	table.Configure(func(cfg *tablewriter.Config) {
+		cfg.Row.Alignment.Global = tw.AlignRight
+	})
+
+	var data [][]string
+	for i, folder := range result.Folders {
+		row := []string{
+			strconv.Itoa(i + 1),
+			TruncatePath(folder.Path, GetMaxTablePathWidth(output)),
+			strconv.Itoa(folder.DocFiles),
+			formatWindowDate(folder.DocsLastModified),
+			folder.CodeCommits.Display(),
+			folder.CommitsSinceDocs.Display(),
+			folder.ChurnSinceDocs.Display(),
+			fmt.Sprintf("%.0f%%", folder.DriftRatio*100),
+			fmtFloat(folder.Score),
+		}
+		data = append(data, row)
+	}
+
+	if err := table.Bulk(data); err != nil {
+		return err
+	}
+	if err := table.Render(); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "Found %d documented folders, %d with code commits since their docs changed (%d undocumented)\n", result.Summary.DocumentedFolders, result.Summary.StaleFolders, result.Summary.UndocumentedFolders); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Documentation drift analysis completed in %v with %d workers. Cache backend: %s\n", duration, runtime.GetWorkers(), runtime.GetCacheBackend()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// WriteFunctions writes function analysis results in a human-readable table.
+func (p *TextProvider) WriteFunctions(w io.Writer, result schema.FunctionsResult, output config.OutputSettings, runtime config.RuntimeSettings, duration time.Duration) error {
+	fmtFloat := CreateFormatters(output.GetPrecision())
+	maxWidth := GetMaxTablePathWidth(output)
+
+	headers := []string{"Rank", "Function", "File", "Lines", "Score", "Label", "Commits", "Churn", "Authors", "Cyclo", "Cognit"}
+	if output.IsOwner() {
+		headers = append(headers, "Owner")
+	}
+
+	var data [][]string
+	for i, fn := range result.Functions {
+		label := schema.GetPlainLabel(fn.Score)
+		if output.IsUseColors() {
+			label = GetColorLabel(fn.Score)
+		}
+		row := []string{
+			strconv.Itoa(i + 1),
+			fn.Name,
+			TruncatePath(fn.Path, maxWidth),
+			fmt.Sprintf("%d-%d", fn.Line, fn.EndLine),
+			fmtFloat(fn.Score),
+			label,
+			fn.Commits.Display(),
+			fn.Churn.Display(),
+			fn.Contributors.Display(),
+			strconv.Itoa(fn.Cyclomatic),
+			strconv.Itoa(fn.Cognitive),
+		}
+		if output.IsOwner() {
+			row = append(row, schema.FormatOwners(fn.Owners))
+		}
+		data = append(data, row)
+	}
+	if err := renderTextTable(w, headers, data); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "Showing top %d of %d functions in %d Go files (%d commits)\n", len(result.Functions), result.Summary.Functions, len(result.Summary.Files), result.Summary.Commits); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "Function analysis completed in %v with %d workers. Cache backend: %s\n", duration, runtime.GetWorkers(), runtime.GetCacheBackend()); err != nil {
+		return err
+	}
+	return nil
+}
+
+// formatTicketPrefixes summarizes the most frequent ticket prefixes, e.g. " (top prefixes: PAY 12, # 4)".
+func formatTicketPrefixes(prefixes []schema.TicketPrefixCount) string {
+	if len(prefixes) == 0 {
+		return ""
+	}
+	parts := make([]string, 0, 3)
+	for i := 0; i < len(prefixes) && i < 3; i++ {
+		parts = append(parts, fmt.Sprintf("%s %d", prefixes[i].Prefix, prefixes[i].Tickets))
+	}
+	return " (top prefixes: " + strings.Join(parts, ", ") + ")"
+}
+
 // WriteMetrics writes metrics definitions in a human-readable text format.
 func (p *TextProvider) WriteMetrics(w io.Writer, activeWeights map[schema.ScoringMode]map[schema.BreakdownKey]float64, _ config.OutputSettings) error {
 	renderModel := schema.BuildMetricsRenderModel(activeWeights)
@@ -350,6 +733,41 @@ func (p *TextProvider) WriteMetrics(w io.Writer, activeWeights map[schema.Scorin
 			return err
 		}
 	}
+
+	for _, c := range renderModel.Composites {
+		name := c.DisplayName
+		if name == "" {
+			name = c.Name
+		}
+		if c.Custom {
+			name += " (custom)"
+		}
+		if _, err := fmt.Fprintf(w, "%s [%s]: %s\n", name, c.Name, c.Description); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "  Blend: %s\n", c.Formula); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range renderModel.CustomModes {
+		name := c.DisplayName
+		if name == "" {
+			name = c.Name
+		}
+		if _, err := fmt.Fprintf(w, "%s (custom) [%s]: %s\n", name, c.Name, c.Description); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "  Expression: %s\n", c.Expression); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -66,6 +66,15 @@ type FileScoresMetrics struct {
 	// GiniCoefficient measures commit distribution (0-1, lower is more even)
 	GiniCoefficient float64 `parquet:"gini_coefficient,snappy"`
 
+	// MinorContributorCount is the number of contributors with under 5% of the commits
+	MinorContributorCount float64 `parquet:"minor_contributor_count,snappy"`
+
+	// TopOwnerShare is the top contributor's share of the commits (0-1)
+	TopOwnerShare float64 `parquet:"top_owner_share,snappy"`
+
+	// OwnershipEntropy is the Shannon entropy of commit shares across contributors, in bits
+	OwnershipEntropy float64 `parquet:"ownership_entropy,snappy"`
+
 	// LinesAdded is the total number of lines added to the file
 	LinesAdded float64 `parquet:"lines_added,snappy"`
 
@@ -122,6 +131,9 @@ type FileScoresMetrics struct {
 
 	// RecencyThresholdHigh is the scale-aware ceiling for recency
 	RecencyThresholdHigh float64 `parquet:"recency_threshold_high,snappy"`
+
+	// CustomScores holds scores for composite and user-defined modes, keyed by mode name
+	CustomScores map[string]float64 `parquet:"custom_scores"`
 }
 
 // WriteAnalysisRunsParquet writes a slice of AnalysisRun structs to a Parquet file.
@@ -299,6 +311,18 @@ func ConvertAnalysisRunRecords(records []schema.AnalysisRunRecord) []AnalysisRun
 	return result
 }
 
+// convertCustomScores converts mode-keyed scores to plain string keys for Parquet.
+func convertCustomScores(scores map[schema.ScoringMode]float64) map[string]float64 {
+	if len(scores) == 0 {
+		return nil
+	}
+	result := make(map[string]float64, len(scores))
+	for mode, score := range scores {
+		result[string(mode)] = score
+	}
+	return result
+}
+
 // ConvertFileScoresMetricsRecords converts schema.FileScoresMetricsRecord to FileScoresMetrics for Parquet export.
 func ConvertFileScoresMetricsRecords(records []schema.FileScoresMetricsRecord) []FileScoresMetrics {
 	result := make([]FileScoresMetrics, len(records))
@@ -322,6 +346,9 @@ func ConvertFileScoresMetricsRecords(records []schema.FileScoresMetricsRecord) [
 			RecentChurn:            record.RecentChurn.Float64(),
 			RecentContributorCount: record.RecentContributorCount.Float64(),
 			GiniCoefficient:        record.GiniCoefficient,
+			MinorContributorCount:  record.MinorContributorCount.Float64(),
+			TopOwnerShare:          record.TopOwnerShare,
+			OwnershipEntropy:       record.OwnershipEntropy,
 			FileOwner:              record.FileOwner,
 			ScoreHot:               record.ScoreHot,
 			ScoreRisk:              record.ScoreRisk,
@@ -332,6 +359,7 @@ func ConvertFileScoresMetricsRecords(records []schema.FileScoresMetricsRecord) [
 			RecencySignal:          record.RecencySignal,
 			RecencyThresholdLow:    record.RecencyThresholdLow,
 			RecencyThresholdHigh:   record.RecencyThresholdHigh,
+			CustomScores:           convertCustomScores(record.CustomScores),
 		}
 	}
 	return result
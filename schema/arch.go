@@ -0,0 +1,37 @@
+package schema
+
+import "time"
+
+// ArchViolation is a pair of components that changed together without either allowing
+// the other.
+type ArchViolation struct {
+	ComponentA string   `json:"component_a"`
+	ComponentB string   `json:"component_b"`
+	Commits    []string `json:"commits"`  // Commits of the change set that changed both components
+	Baseline   int      `json:"baseline"` // Commits that changed both within the lookback window before the base ref
+	New        bool     `json:"new"`      // Whether the coupling is new, with no baseline commits
+}
+
+// ArchCommit is a commit of the change set that crossed a disallowed component boundary.
+type ArchCommit struct {
+	Hash       string   `json:"hash"`
+	Author     string   `json:"author"`
+	Subject    string   `json:"subject"`
+	Components []string `json:"components"` // Components the commit changed, sorted by name
+}
+
+// ArchCheckResult is the result of checking a change set against the allowed component
+// dependencies.
+type ArchCheckResult struct {
+	BaseRef       string          `json:"base_ref"`
+	TargetRef     string          `json:"target_ref"`
+	Lookback      time.Duration   `json:"lookback"`
+	Components    int             `json:"components"`     // Components declared in the config file
+	ChangedFiles  int             `json:"changed_files"`  // Files changed between the refs, after excludes
+	Commits       int             `json:"commits"`        // Commits between the refs
+	Violations    []ArchViolation `json:"violations"`     // Disallowed pairs the change set changed together
+	Crossing      []ArchCommit    `json:"crossing"`       // Commits that changed a disallowed pair
+	NewViolations int             `json:"new_violations"` // Violations with no baseline commits
+	Tolerance     int             `json:"tolerance"`      // New violations allowed before the check fails
+	Passed        bool            `json:"passed"`
+}
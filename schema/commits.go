@@ -0,0 +1,102 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// RevertBodyMarker is the sentence git revert writes into the message body of a revert commit.
+const RevertBodyMarker = "This reverts commit"
+
+// IsRevertSubject returns true if the subject has the form git revert gives it: Revert "...".
+func IsRevertSubject(subject string) bool {
+	return strings.HasPrefix(subject, `Revert "`)
+}
+
+// DefaultFixKeywords lists the words that mark a commit subject as a fix by default.
+var DefaultFixKeywords = []string{"fix", "bug", "bugfix", "hotfix", "revert"}
+
+// fixKeywordPattern restricts keywords to plain words so they can be joined into one regex.
+var fixKeywordPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// conventionalPrefix matches a Conventional Commits header such as "fix(api)!: ...".
+var conventionalPrefix = regexp.MustCompile(`^([A-Za-z]+)(\([^)]*\))?!?:`)
+
+// CommitClassifier decides whether a commit subject describes a fix.
+// Subjects with a Conventional Commits prefix are classified by their type alone; other
+// subjects match if they contain a keyword (with common suffixes such as "fixes" or
+// "bugs"). Any subject matching one of the regexes is always a fix.
+type CommitClassifier struct {
+	Keywords []string // Lowercase words that mark a fix (fix, bug, hotfix, ...)
+	Regexes  []string // Additional patterns matched against the full subject
+
+	keywordRe *regexp.Regexp
+	regexes   []*regexp.Regexp
+}
+
+// NewCommitClassifier validates and compiles a fix classifier.
+func NewCommitClassifier(keywords, regexes []string) (*CommitClassifier, error) {
+	c := &CommitClassifier{}
+	for _, k := range keywords {
+		k = strings.ToLower(strings.TrimSpace(k))
+		if k == "" {
+			continue
+		}
+		if !fixKeywordPattern.MatchString(k) {
+			return nil, fmt.Errorf("fix keyword %q must contain only letters, digits, '-' or '_'", k)
+		}
+		if !slices.Contains(c.Keywords, k) {
+			c.Keywords = append(c.Keywords, k)
+		}
+	}
+	if len(c.Keywords) > 0 {
+		c.keywordRe = regexp.MustCompile(`(?i)\b(` + strings.Join(c.Keywords, "|") + `)(e[sd]|s|ing)?\b`)
+	}
+	for _, pattern := range regexes {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid fix regex %q: %w", pattern, err)
+		}
+		c.Regexes = append(c.Regexes, pattern)
+		c.regexes = append(c.regexes, re)
+	}
+	return c, nil
+}
+
+// DefaultCommitClassifier returns the classifier used when no fix patterns are configured.
+func DefaultCommitClassifier() *CommitClassifier {
+	c, err := NewCommitClassifier(DefaultFixKeywords, nil)
+	if err != nil {
+		panic(err)
+	}
+	return c
+}
+
+// IsFix returns true if the commit subject describes a fix.
+func (c *CommitClassifier) IsFix(subject string) bool {
+	if c == nil || subject == "" {
+		return false
+	}
+	for _, re := range c.regexes {
+		if re.MatchString(subject) {
+			return true
+		}
+	}
+	if c.keywordRe == nil {
+		return false
+	}
+	if m := conventionalPrefix.FindStringSubmatch(subject); m != nil {
+		return slices.Contains(c.Keywords, strings.ToLower(m[1]))
+	}
+	return c.keywordRe.MatchString(subject)
+}
+
+// Signature returns a stable description of the rules, used to key cached aggregations.
+func (c *CommitClassifier) Signature() string {
+	if c == nil {
+		return ""
+	}
+	return strings.Join(c.Keywords, ",") + "|" + strings.Join(c.Regexes, "|")
+}
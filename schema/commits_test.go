@@ -0,0 +1,55 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitClassifier_IsFix(t *testing.T) {
+	classifier := schema.DefaultCommitClassifier()
+
+	tests := []struct {
+		subject string
+		want    bool
+	}{
+		{"fix: handle nil pointer", true},
+		{"fix(api)!: reject empty tokens", true},
+		{"hotfix: restore login", true},
+		{"Fixes crash when config is missing", true},
+		{"Revert \"feat: add cache\"", true},
+		{"Squash two bugs in the parser", true},
+		{"feat: add fix-up command", false}, // Conventional type decides
+		{"docs: describe the bug report template", false},
+		{"Add prefix handling", false}, // No partial word matches
+		{"Debugging helpers", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.subject, func(t *testing.T) {
+			assert.Equal(t, tt.want, classifier.IsFix(tt.subject))
+		})
+	}
+}
+
+func TestCommitClassifier_Custom(t *testing.T) {
+	classifier, err := schema.NewCommitClassifier([]string{"Defect", " patch "}, []string{`^PROD-\d+`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"defect", "patch"}, classifier.Keywords)
+
+	assert.True(t, classifier.IsFix("Patch the retry loop"))
+	assert.True(t, classifier.IsFix("PROD-42 tighten validation"))
+	assert.False(t, classifier.IsFix("fix: no longer a keyword"))
+	assert.Equal(t, "defect,patch|^PROD-\\d+", classifier.Signature())
+
+	_, err = schema.NewCommitClassifier([]string{"fix|bug"}, nil)
+	assert.Error(t, err)
+	_, err = schema.NewCommitClassifier(nil, []string{"("})
+	assert.Error(t, err)
+
+	var none *schema.CommitClassifier
+	assert.False(t, none.IsFix("fix: anything"))
+}
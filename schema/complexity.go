@@ -0,0 +1,34 @@
+package schema
+
+// HighCyclomatic is the cyclomatic complexity from which a function is called complex.
+const HighCyclomatic = 15
+
+// FunctionComplexity holds the complexity of one function or method declaration.
+type FunctionComplexity struct {
+	Name       string `json:"name"`       // Function name, with the receiver for methods (e.g. (*Server).Handle)
+	Line       int    `json:"line"`       // First line of the declaration
+	EndLine    int    `json:"end_line"`   // Last line of the declaration
+	Cyclomatic int    `json:"cyclomatic"` // McCabe cyclomatic complexity
+	Cognitive  int    `json:"cognitive"`  // Cognitive complexity (nesting-aware)
+}
+
+// ComplexityStats summarizes the function complexity of a file.
+type ComplexityStats struct {
+	Functions       int
+	CyclomaticTotal int
+	CyclomaticMax   int
+	CognitiveTotal  int
+	CognitiveMax    int
+}
+
+// SummarizeComplexity totals the complexity of a file's functions.
+func SummarizeComplexity(functions []FunctionComplexity) ComplexityStats {
+	stats := ComplexityStats{Functions: len(functions)}
+	for _, fn := range functions {
+		stats.CyclomaticTotal += fn.Cyclomatic
+		stats.CyclomaticMax = max(stats.CyclomaticMax, fn.Cyclomatic)
+		stats.CognitiveTotal += fn.Cognitive
+		stats.CognitiveMax = max(stats.CognitiveMax, fn.Cognitive)
+	}
+	return stats
+}
@@ -54,3 +54,118 @@ func TestValidateCompositeConfigs_PanicsOnInvalidConfigs(t *testing.T) {
 		assert.Panics(t, func() { validateCompositeConfigs() })
 	})
 }
+
+func TestRegisterCustomComposites(t *testing.T) {
+	t.Cleanup(func() { _ = RegisterCustomComposites(nil) })
+
+	custom := map[ScoringMode]*CompositeConfig{
+		"churny_silos": {
+			DisplayName:  "Churny Silos",
+			Description:  "Hot files owned by one person.",
+			BaseModes:    []ScoringMode{HotMode, RiskMode},
+			BlendWeights: map[ScoringMode]float64{HotMode: 3, RiskMode: 1},
+		},
+	}
+	assert.NoError(t, RegisterCustomComposites(custom))
+
+	assert.True(t, IsValidScoringMode(ScoringMode("churny_silos")))
+	assert.Contains(t, ScoringModes(), ScoringMode("churny_silos"))
+	assert.True(t, IsCompositeMode("churny_silos"))
+	assert.True(t, IsCustomCompositeMode("churny_silos"))
+	assert.False(t, IsCustomCompositeMode(ActiveOwnersMode))
+	assert.Equal(t, "Churny Silos", GetCompositeConfig("churny_silos").DisplayName)
+	assert.Equal(t, ScoringMode("churny_silos"), CompositeScoringModes()[3])
+
+	// Re-registering replaces the previous set.
+	assert.NoError(t, RegisterCustomComposites(nil))
+	assert.False(t, IsValidScoringMode(ScoringMode("churny_silos")))
+	assert.Equal(t, builtinScoringModes, ScoringModes())
+	assert.Nil(t, GetCompositeConfig("churny_silos"))
+}
+
+func TestRegisterCustomComposites_ConcurrentReaders(t *testing.T) {
+	t.Cleanup(func() { _ = RegisterCustomComposites(nil) })
+
+	custom := map[ScoringMode]*CompositeConfig{
+		"churny_silos": {
+			BaseModes:    []ScoringMode{HotMode, RiskMode},
+			BlendWeights: map[ScoringMode]float64{HotMode: 1, RiskMode: 1},
+		},
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range 100 {
+			_ = RegisterCustomComposites(custom)
+			_ = RegisterCustomComposites(nil)
+		}
+	}()
+	for range 100 {
+		assert.True(t, IsValidScoringMode(HotMode))
+		assert.Contains(t, ScoringModes(), LegacyDebtMode)
+		assert.NotEmpty(t, CompositeScoringModes())
+	}
+	<-done
+}
+
+func TestRegisterCustomComposites_RejectsInvalidConfigs(t *testing.T) {
+	t.Cleanup(func() { _ = RegisterCustomComposites(nil) })
+
+	valid := func() *CompositeConfig {
+		return &CompositeConfig{
+			BaseModes:    []ScoringMode{HotMode, RiskMode},
+			BlendWeights: map[ScoringMode]float64{HotMode: 0.5, RiskMode: 0.5},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		composites map[ScoringMode]*CompositeConfig
+	}{
+		{"base mode name", map[ScoringMode]*CompositeConfig{HotMode: valid()}},
+		{"built-in composite name", map[ScoringMode]*CompositeConfig{LegacyDebtMode: valid()}},
+		{"bad name", map[ScoringMode]*CompositeConfig{"Bad-Name": valid()}},
+		{"single base mode", map[ScoringMode]*CompositeConfig{"solo": {BaseModes: []ScoringMode{HotMode}, BlendWeights: map[ScoringMode]float64{HotMode: 1}}}},
+		{"missing weight", map[ScoringMode]*CompositeConfig{"partial": {BaseModes: []ScoringMode{HotMode, RiskMode}, BlendWeights: map[ScoringMode]float64{HotMode: 1}}}},
+		{"composite as base", map[ScoringMode]*CompositeConfig{"nested": {BaseModes: []ScoringMode{HotMode, ActiveOwnersMode}, BlendWeights: map[ScoringMode]float64{HotMode: 1, ActiveOwnersMode: 1}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, RegisterCustomComposites(tt.composites))
+			assert.Equal(t, builtinScoringModes, ScoringModes())
+		})
+	}
+}
+
+func TestRegisterExpressionModes(t *testing.T) {
+	t.Cleanup(func() {
+		_ = RegisterExpressionModes(nil)
+		_ = RegisterCustomComposites(nil)
+	})
+
+	err := RegisterExpressionModes(map[ScoringMode]*ExpressionModeConfig{
+		"churny": {DisplayName: "Churny", Expression: "churn_norm"},
+	})
+	assert.NoError(t, err)
+	assert.True(t, IsExpressionMode("churny"))
+	assert.True(t, IsCustomScoringMode("churny"))
+	assert.False(t, IsCompositeMode("churny"))
+	assert.Equal(t, []ScoringMode{"churny"}, ExpressionScoringModes())
+	assert.Contains(t, ScoringModes(), ScoringMode("churny"))
+	assert.True(t, IsValidScoringMode(ScoringMode("churny")))
+	assert.NotContains(t, CompositeScoringModes(), ScoringMode("churny"))
+
+	// A composite cannot reuse the name of a registered expression mode.
+	err = RegisterCustomComposites(map[ScoringMode]*CompositeConfig{
+		"churny": {BaseModes: []ScoringMode{HotMode, RiskMode}, BlendWeights: map[ScoringMode]float64{HotMode: 1, RiskMode: 1}},
+	})
+	assert.Error(t, err)
+
+	assert.Error(t, RegisterExpressionModes(map[ScoringMode]*ExpressionModeConfig{"risk": {Expression: "gini"}}))
+	assert.Error(t, RegisterExpressionModes(map[ScoringMode]*ExpressionModeConfig{"empty": {}}))
+
+	assert.NoError(t, RegisterExpressionModes(nil))
+	assert.False(t, IsExpressionMode("churny"))
+	assert.False(t, IsValidScoringMode(ScoringMode("churny")))
+	assert.Equal(t, builtinScoringModes, ScoringModes())
+}
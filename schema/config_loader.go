@@ -3,7 +3,11 @@ package schema
 import (
 	_ "embed"
 	"fmt"
+	"maps"
 	"math"
+	"regexp"
+	"slices"
+	"sync"
 
 	"gopkg.in/yaml.v3"
 )
@@ -55,38 +59,47 @@ func init() {
 
 func validateCompositeConfigs() {
 	for mode, composite := range cCfg.Composites {
-		if composite == nil {
-			panic(fmt.Sprintf("composite mode %s has nil config", mode))
-		}
-		if len(composite.BaseModes) < 2 {
-			panic(fmt.Sprintf("composite mode %s must define at least two base_modes", mode))
+		if err := ValidateCompositeConfig(mode, composite); err != nil {
+			panic(err.Error())
 		}
+	}
+}
 
-		seen := map[ScoringMode]struct{}{}
-		for _, baseMode := range composite.BaseModes {
-			if !IsBaseMode(baseMode) {
-				panic(fmt.Sprintf("composite mode %s has invalid base mode %s", mode, baseMode))
-			}
-			if _, ok := seen[baseMode]; ok {
-				panic(fmt.Sprintf("composite mode %s contains duplicate base mode %s", mode, baseMode))
-			}
-			seen[baseMode] = struct{}{}
-		}
+// ValidateCompositeConfig checks that a composite definition blends at least two
+// distinct base modes and carries a positive, finite blend weight for each of them.
+func ValidateCompositeConfig(mode ScoringMode, composite *CompositeConfig) error {
+	if composite == nil {
+		return fmt.Errorf("composite mode %s has nil config", mode)
+	}
+	if len(composite.BaseModes) < 2 {
+		return fmt.Errorf("composite mode %s must define at least two base_modes", mode)
+	}
 
-		if len(composite.BlendWeights) == 0 {
-			panic(fmt.Sprintf("composite mode %s must define blend_weights", mode))
+	seen := map[ScoringMode]struct{}{}
+	for _, baseMode := range composite.BaseModes {
+		if !IsBaseMode(baseMode) {
+			return fmt.Errorf("composite mode %s has invalid base mode %s", mode, baseMode)
+		}
+		if _, ok := seen[baseMode]; ok {
+			return fmt.Errorf("composite mode %s contains duplicate base mode %s", mode, baseMode)
 		}
+		seen[baseMode] = struct{}{}
+	}
+
+	if len(composite.BlendWeights) == 0 {
+		return fmt.Errorf("composite mode %s must define blend_weights", mode)
+	}
 
-		for _, baseMode := range composite.BaseModes {
-			weight, ok := composite.BlendWeights[baseMode]
-			if !ok {
-				panic(fmt.Sprintf("composite mode %s missing blend weight for base mode %s", mode, baseMode))
-			}
-			if weight <= 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
-				panic(fmt.Sprintf("composite mode %s has invalid blend weight %v for base mode %s", mode, weight, baseMode))
-			}
+	for _, baseMode := range composite.BaseModes {
+		weight, ok := composite.BlendWeights[baseMode]
+		if !ok {
+			return fmt.Errorf("composite mode %s missing blend weight for base mode %s", mode, baseMode)
+		}
+		if weight <= 0 || math.IsNaN(weight) || math.IsInf(weight, 0) {
+			return fmt.Errorf("composite mode %s has invalid blend weight %v for base mode %s", mode, weight, baseMode)
 		}
 	}
+	return nil
 }
 
 // GetDefaultWeights returns the default weights for a given scoring mode from the YAML config.
@@ -135,9 +148,159 @@ func AllPresets() []Preset {
 // GetCompositeConfig returns the CompositeConfig for a given composite mode.
 // Returns nil if the mode is not a composite.
 func GetCompositeConfig(mode ScoringMode) *CompositeConfig {
-	cfg, ok := cCfg.Composites[mode]
-	if !ok {
-		return nil
+	if cfg, ok := cCfg.Composites[mode]; ok {
+		return cfg
+	}
+	customModes.RLock()
+	defer customModes.RUnlock()
+	if cfg, ok := customModes.composites[mode]; ok {
+		return cfg
+	}
+	return nil
+}
+
+// customModes holds scoring modes defined by users in .hotspot.yml: composites that
+// blend base modes and expression modes computed from a formula. They are registered
+// once per configuration load, after the embedded composites.
+var customModes struct {
+	sync.RWMutex
+	composites  map[ScoringMode]*CompositeConfig
+	expressions map[ScoringMode]*ExpressionModeConfig
+}
+
+// RegisterCustomComposites validates and registers user-defined composite modes,
+// replacing any previously registered set. Passing an empty map clears them.
+// Registered modes become part of ScoringModes and IsValidScoringMode so they
+// are accepted wherever a scoring mode is.
+func RegisterCustomComposites(composites map[ScoringMode]*CompositeConfig) error {
+	for mode, composite := range composites {
+		if err := validateCustomModeName("composite", mode); err != nil {
+			return err
+		}
+		if IsExpressionMode(mode) {
+			return fmt.Errorf("composite mode %s conflicts with an expression mode of the same name", mode)
+		}
+		if err := ValidateCompositeConfig(mode, composite); err != nil {
+			return err
+		}
 	}
-	return cfg
+
+	customModes.Lock()
+	defer customModes.Unlock()
+	customModes.composites = maps.Clone(composites)
+	rebuildScoringModesLocked()
+	return nil
 }
+
+// RegisterExpressionModes registers user-defined expression modes, replacing any
+// previously registered set. Passing an empty map clears them. Formulas are compiled
+// and checked by the scoring package; this only validates names and presence.
+func RegisterExpressionModes(expressions map[ScoringMode]*ExpressionModeConfig) error {
+	for mode, expression := range expressions {
+		if err := validateCustomModeName("expression", mode); err != nil {
+			return err
+		}
+		if IsCustomCompositeMode(mode) {
+			return fmt.Errorf("expression mode %s conflicts with a composite mode of the same name", mode)
+		}
+		if expression == nil || expression.Expression == "" {
+			return fmt.Errorf("expression mode %s must define an expression", mode)
+		}
+	}
+
+	customModes.Lock()
+	defer customModes.Unlock()
+	customModes.expressions = maps.Clone(expressions)
+	rebuildScoringModesLocked()
+	return nil
+}
+
+func validateCustomModeName(kind string, mode ScoringMode) error {
+	if !customModeNamePattern.MatchString(string(mode)) {
+		return fmt.Errorf("%s mode name %q must be lowercase letters, digits and underscores, starting with a letter", kind, mode)
+	}
+	if IsBaseMode(mode) || cCfg.Composites[mode] != nil {
+		return fmt.Errorf("%s mode %s conflicts with a built-in scoring mode", kind, mode)
+	}
+	return nil
+}
+
+// rebuildScoringModesLocked recomputes allScoringModes and validScoringModes from the
+// built-in modes plus the registered custom modes. The caller must hold the write lock.
+func rebuildScoringModesLocked() {
+	composites := slices.Sorted(maps.Keys(customModes.composites))
+	expressions := slices.Sorted(maps.Keys(customModes.expressions))
+	all := make([]ScoringMode, 0, len(builtinScoringModes)+len(composites)+len(expressions))
+	all = append(all, builtinScoringModes...)
+	all = append(all, composites...)
+	all = append(all, expressions...)
+	valid := make(map[ScoringMode]struct{}, len(all))
+	for _, mode := range all {
+		valid[mode] = struct{}{}
+	}
+	allScoringModes = all
+	validScoringModes = valid
+}
+
+// ScoringModes returns all supported scoring modes (base, composite, then custom) in a
+// stable order. It is safe to call while custom modes are being registered.
+func ScoringModes() []ScoringMode {
+	customModes.RLock()
+	defer customModes.RUnlock()
+	return slices.Clone(allScoringModes)
+}
+
+// IsValidScoringMode returns true if mode is a built-in or registered custom scoring mode.
+func IsValidScoringMode(mode ScoringMode) bool {
+	customModes.RLock()
+	defer customModes.RUnlock()
+	_, ok := validScoringModes[mode]
+	return ok
+}
+
+// CompositeScoringModes returns all composite modes (built-in first, then custom) in a stable order.
+func CompositeScoringModes() []ScoringMode {
+	var composites []ScoringMode
+	for _, mode := range ScoringModes() {
+		if GetCompositeConfig(mode) != nil {
+			composites = append(composites, mode)
+		}
+	}
+	return composites
+}
+
+// ExpressionScoringModes returns all registered expression modes in a stable order.
+func ExpressionScoringModes() []ScoringMode {
+	customModes.RLock()
+	defer customModes.RUnlock()
+	return slices.Sorted(maps.Keys(customModes.expressions))
+}
+
+// GetExpressionModeConfig returns the ExpressionModeConfig for a given mode.
+// Returns nil if the mode is not an expression mode.
+func GetExpressionModeConfig(mode ScoringMode) *ExpressionModeConfig {
+	customModes.RLock()
+	defer customModes.RUnlock()
+	return customModes.expressions[mode]
+}
+
+// IsCustomCompositeMode returns true if the mode was registered from user configuration.
+func IsCustomCompositeMode(mode ScoringMode) bool {
+	customModes.RLock()
+	defer customModes.RUnlock()
+	_, ok := customModes.composites[mode]
+	return ok
+}
+
+// IsExpressionMode returns true if the mode is a user-defined expression mode.
+func IsExpressionMode(mode ScoringMode) bool {
+	return GetExpressionModeConfig(mode) != nil
+}
+
+// IsCustomScoringMode returns true if the mode was defined in user configuration,
+// either as a composite or as an expression.
+func IsCustomScoringMode(mode ScoringMode) bool {
+	return IsCustomCompositeMode(mode) || IsExpressionMode(mode)
+}
+
+var customModeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
@@ -1,5 +1,7 @@
 package schema
 
+import "slices"
+
 // Custom string types for type safety.
 type (
 	// BreakdownKey represents keys used in scoring breakdowns.
@@ -30,6 +32,19 @@ const (
 	BreakdownGini       BreakdownKey = "gini"        // nGiniRaw
 	BreakdownInvContrib BreakdownKey = "inv_contrib" // nInvContrib
 	BreakdownLowRecent  BreakdownKey = "low_recent"  // nInvRecentCommits (Staleness / Decay)
+	BreakdownFixes      BreakdownKey = "fixes"       // nFixes (Bug-fix density)
+	BreakdownEntropy    BreakdownKey = "entropy"     // nEntropy (Change entropy)
+
+	BreakdownMinorContrib     BreakdownKey = "minor_contrib"     // nMinorContrib (Drive-by contributors)
+	BreakdownOwnerShare       BreakdownKey = "owner_share"       // nOwnerShare (Top owner's share of commits)
+	BreakdownOwnershipEntropy BreakdownKey = "ownership_entropy" // nOwnershipEntropy (Ownership fragmentation)
+
+	BreakdownOffHours BreakdownKey = "off_hours" // nOffHours (Off-hours commit ratio)
+	BreakdownTestGap  BreakdownKey = "test_gap"  // nTestGap (Commits that left the tests untouched)
+
+	BreakdownIndent     BreakdownKey = "indent"     // nIndent (Indentation complexity)
+	BreakdownCyclomatic BreakdownKey = "cyclomatic" // nCyclomatic (Summed cyclomatic complexity of Go functions)
+	BreakdownCognitive  BreakdownKey = "cognitive"  // nCognitive (Summed cognitive complexity of Go functions)
 )
 
 // All output modes supported.
@@ -58,6 +73,7 @@ const (
 	RiskMode       ScoringMode = "risk"
 	ComplexityMode ScoringMode = "complexity"
 	ROIMode        ScoringMode = "roi"
+	DefectsMode    ScoringMode = "defects"
 	// Composite modes (v1.22.0+).
 	ActiveOwnersMode ScoringMode = "active_owners"
 	RefactorNowMode  ScoringMode = "refactor_now"
@@ -80,11 +96,16 @@ const (
 	NoneBackend       DatabaseBackend = "none"
 )
 
-// BaseScoringModes returns the four base scoring modes.
-var BaseScoringModes = []ScoringMode{HotMode, RiskMode, ComplexityMode, ROIMode}
+// BaseScoringModes returns the base scoring modes.
+var BaseScoringModes = []ScoringMode{HotMode, RiskMode, ComplexityMode, ROIMode, DefectsMode}
+
+// builtinScoringModes lists the modes compiled into the binary (base + embedded composites).
+var builtinScoringModes = []ScoringMode{HotMode, RiskMode, ComplexityMode, ROIMode, DefectsMode, ActiveOwnersMode, RefactorNowMode, LegacyDebtMode}
 
-// AllScoringModes returns all supported scoring modes (base + composite).
-var AllScoringModes = []ScoringMode{HotMode, RiskMode, ComplexityMode, ROIMode, ActiveOwnersMode, RefactorNowMode, LegacyDebtMode}
+// allScoringModes holds all supported scoring modes (base + composite). Custom modes
+// registered from configuration are appended after the built-ins. Guarded by customModes;
+// read it through ScoringModes.
+var allScoringModes = slices.Clone(builtinScoringModes)
 
 // ValidOutputModes lists all valid output modes.
 var ValidOutputModes = map[OutputMode]struct{}{
@@ -98,12 +119,14 @@ var ValidOutputModes = map[OutputMode]struct{}{
 	NoneOut:     {},
 }
 
-// ValidScoringModes lists all valid scoring modes.
-var ValidScoringModes = map[ScoringMode]struct{}{
+// validScoringModes lists all valid scoring modes. Guarded by customModes; read it
+// through IsValidScoringMode.
+var validScoringModes = map[ScoringMode]struct{}{
 	HotMode:          {},
 	RiskMode:         {},
 	ComplexityMode:   {},
 	ROIMode:          {},
+	DefectsMode:      {},
 	ActiveOwnersMode: {},
 	RefactorNowMode:  {},
 	LegacyDebtMode:   {},
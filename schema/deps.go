@@ -0,0 +1,378 @@
+package schema
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Dependency ecosystems recognized in manifests.
+const (
+	EcosystemGo    = "go"
+	EcosystemNPM   = "npm"
+	EcosystemPyPI  = "pypi"
+	EcosystemCargo = "cargo"
+)
+
+// manifestEcosystems maps manifest and lockfile base names to their ecosystem.
+var manifestEcosystems = map[string]string{
+	"go.mod":            EcosystemGo,
+	"go.sum":            EcosystemGo,
+	"package.json":      EcosystemNPM,
+	"package-lock.json": EcosystemNPM,
+	"requirements.txt":  EcosystemPyPI,
+	"Cargo.toml":        EcosystemCargo,
+	"Cargo.lock":        EcosystemCargo,
+}
+
+// lockfiles pin the resolved versions of the dependencies their manifests constrain.
+var lockfiles = []string{"go.sum", "package-lock.json", "Cargo.lock"}
+
+// vendoredDirs hold third-party manifests that are not the repository's own.
+var vendoredDirs = []string{"vendor", "node_modules"}
+
+// ManifestEcosystem returns the ecosystem of a dependency manifest or lockfile, and
+// whether path is one. Manifests inside vendored directories are not recognized.
+func ManifestEcosystem(path string) (string, bool) {
+	ecosystem, ok := manifestEcosystems[filepath.Base(path)]
+	if !ok {
+		return "", false
+	}
+	for _, part := range strings.Split(filepath.ToSlash(filepath.Dir(path)), "/") {
+		if slices.Contains(vendoredDirs, part) {
+			return "", false
+		}
+	}
+	return ecosystem, true
+}
+
+// IsLockfile reports whether path is a lockfile rather than a manifest.
+func IsLockfile(path string) bool {
+	return slices.Contains(lockfiles, filepath.Base(path))
+}
+
+// ParseManifest returns the dependencies listed in a manifest or lockfile, keyed by name.
+// The value is the version or version constraint; lockfiles that pin several versions
+// of one dependency list them comma-separated.
+func ParseManifest(path string, data []byte) (map[string]string, error) {
+	deps := make(map[string]string)
+	var err error
+	switch filepath.Base(path) {
+	case "go.mod":
+		parseGoMod(data, deps)
+	case "go.sum":
+		parseGoSum(data, deps)
+	case "package.json":
+		err = parsePackageJSON(data, deps)
+	case "package-lock.json":
+		err = parsePackageLock(data, deps)
+	case "requirements.txt":
+		parseRequirements(data, deps)
+	case "Cargo.toml":
+		parseCargoToml(data, deps)
+	case "Cargo.lock":
+		parseCargoLock(data, deps)
+	default:
+		return nil, fmt.Errorf("unsupported manifest %q", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse manifest %q: %w", path, err)
+	}
+	return deps, nil
+}
+
+// addVersion records version for name, merging distinct versions in sorted order.
+func addVersion(deps map[string]string, name, version string) {
+	current, ok := deps[name]
+	if !ok || current == "" {
+		deps[name] = version
+		return
+	}
+	versions := strings.Split(current, ", ")
+	if version == "" || slices.Contains(versions, version) {
+		return
+	}
+	versions = append(versions, version)
+	slices.Sort(versions)
+	deps[name] = strings.Join(versions, ", ")
+}
+
+// manifestLines returns the non-empty trimmed lines of data, cutting comments that
+// start with marker unless marker is empty.
+func manifestLines(data []byte, marker string) []string {
+	var lines []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, marker); marker != "" && i >= 0 {
+			line = line[:i]
+		}
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// parseGoMod reads require directives, both single-line and in blocks.
+func parseGoMod(data []byte, deps map[string]string) {
+	inBlock := false
+	for _, line := range manifestLines(data, "//") {
+		switch {
+		case inBlock && line == ")":
+			inBlock = false
+			continue
+		case line == "require (":
+			inBlock = true
+			continue
+		case strings.HasPrefix(line, "require "):
+			line = strings.TrimSpace(strings.TrimPrefix(line, "require "))
+		case !inBlock:
+			continue
+		}
+		if fields := strings.Fields(line); len(fields) >= 2 {
+			deps[fields[0]] = fields[1]
+		}
+	}
+}
+
+// GoModulePath returns the module path declared by a go.mod file, or "" if there is none.
+func GoModulePath(data []byte) string {
+	for _, line := range manifestLines(data, "//") {
+		if rest, ok := strings.CutPrefix(line, "module"); ok && rest != "" && (rest[0] == ' ' || rest[0] == '\t') {
+			return strings.Trim(strings.TrimSpace(rest), `"`)
+		}
+	}
+	return ""
+}
+
+// parseGoSum reads module checksums, skipping the go.mod-only entries.
+func parseGoSum(data []byte, deps map[string]string) {
+	for _, line := range manifestLines(data, "") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+		addVersion(deps, fields[0], fields[1])
+	}
+}
+
+// parsePackageJSON reads the runtime, development, peer and optional dependencies.
+func parsePackageJSON(data []byte, deps map[string]string) error {
+	var pkg struct {
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		PeerDependencies     map[string]string `json:"peerDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return err
+	}
+	for _, group := range []map[string]string{pkg.PeerDependencies, pkg.OptionalDependencies, pkg.DevDependencies, pkg.Dependencies} {
+		for name, version := range group {
+			deps[name] = version
+		}
+	}
+	return nil
+}
+
+// parsePackageLock reads the installed packages of lockfile versions 1 to 3.
+func parsePackageLock(data []byte, deps map[string]string) error {
+	var lock struct {
+		Packages map[string]struct {
+			Version string `json:"version"`
+		} `json:"packages"`
+		Dependencies map[string]struct {
+			Version string `json:"version"`
+		} `json:"dependencies"`
+	}
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return err
+	}
+	if len(lock.Packages) > 0 {
+		for key, pkg := range lock.Packages {
+			i := strings.LastIndex(key, "node_modules/")
+			if i < 0 {
+				continue // The root project
+			}
+			addVersion(deps, key[i+len("node_modules/"):], pkg.Version)
+		}
+		return nil
+	}
+	for name, pkg := range lock.Dependencies {
+		addVersion(deps, name, pkg.Version)
+	}
+	return nil
+}
+
+// requirementNameEnd matches the first character after a requirement's project name.
+var requirementNameEnd = regexp.MustCompile(`[\s=<>!~;\[@]`)
+
+// parseRequirements reads pip requirement lines, skipping options such as -r and -e.
+// Project names are normalized as in PEP 503.
+func parseRequirements(data []byte, deps map[string]string) {
+	for _, line := range manifestLines(data, "#") {
+		if strings.HasPrefix(line, "-") {
+			continue
+		}
+		name, spec := line, ""
+		if loc := requirementNameEnd.FindStringIndex(line); loc != nil {
+			name, spec = line[:loc[0]], line[loc[0]:]
+		}
+		spec = strings.TrimSpace(spec)
+		if i := strings.Index(spec, ";"); i >= 0 {
+			spec = strings.TrimSpace(spec[:i]) // Drop environment markers
+		}
+		if strings.HasPrefix(spec, "[") {
+			if i := strings.Index(spec, "]"); i >= 0 {
+				spec = strings.TrimSpace(spec[i+1:]) // Drop extras
+			}
+		}
+		name = strings.ToLower(strings.NewReplacer("_", "-", ".", "-").Replace(name))
+		if name != "" {
+			deps[name] = spec
+		}
+	}
+}
+
+// cargoVersion matches the version key of an inline Cargo dependency table.
+var cargoVersion = regexp.MustCompile(`\bversion\s*=\s*"([^"]*)"`)
+
+// parseCargoToml reads the [dependencies] tables, including dev, build, workspace and
+// target-specific ones, and [dependencies.<name>] tables.
+func parseCargoToml(data []byte, deps map[string]string) {
+	inTable, tableDep := false, ""
+	for _, line := range manifestLines(data, "#") {
+		if strings.HasPrefix(line, "[") {
+			header := strings.Trim(line, "[] ")
+			inTable, tableDep = false, ""
+			for _, suffix := range []string{"dependencies", "dev-dependencies", "build-dependencies"} {
+				if header == suffix || strings.HasSuffix(header, "."+suffix) {
+					inTable = true
+				}
+				if i := strings.Index(header, suffix+"."); i >= 0 && (i == 0 || header[i-1] == '.') {
+					tableDep = header[i+len(suffix)+1:]
+				}
+			}
+			if tableDep != "" {
+				deps[tableDep] = ""
+			}
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		key, value = strings.Trim(strings.TrimSpace(key), `"`), strings.TrimSpace(value)
+		switch {
+		case tableDep != "":
+			if key == "version" {
+				deps[tableDep] = strings.Trim(value, `"`)
+			}
+		case inTable && strings.Contains(key, "."):
+			// Dotted keys such as serde.version = "1" or serde.workspace = true
+			name, field, _ := strings.Cut(key, ".")
+			if field == "version" {
+				deps[name] = strings.Trim(value, `"`)
+			} else if _, ok := deps[name]; !ok {
+				deps[name] = ""
+			}
+		case inTable:
+			version := ""
+			if strings.HasPrefix(value, `"`) {
+				version = strings.Trim(value, `"`)
+			} else if m := cargoVersion.FindStringSubmatch(value); m != nil {
+				version = m[1]
+			}
+			deps[key] = version
+		}
+	}
+}
+
+// parseCargoLock reads the [[package]] entries of a Cargo lockfile.
+func parseCargoLock(data []byte, deps map[string]string) {
+	name := ""
+	for _, line := range manifestLines(data, "#") {
+		if line == "[[package]]" {
+			name = ""
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(value), `"`)
+		switch strings.TrimSpace(key) {
+		case "name":
+			name = value
+		case "version":
+			if name != "" {
+				addVersion(deps, name, value)
+			}
+		}
+	}
+}
+
+// DepsSiloShare is the share of a manifest's commits by one author from which the
+// manifest counts as a knowledge silo.
+const DepsSiloShare = 0.8
+
+// DepsSiloMinCommits is the number of commits a manifest needs before it can count as a silo.
+const DepsSiloMinCommits = 3
+
+// DependencyRecord summarizes the history of one dependency across the manifests that list it.
+type DependencyRecord struct {
+	Name        string    `json:"name"`
+	Ecosystem   string    `json:"ecosystem"`    // Package ecosystem (go, npm, pypi, cargo)
+	Version     string    `json:"version"`      // Version at HEAD (empty = no longer listed)
+	Changes     int       `json:"changes"`      // Commits that added, removed or changed its version
+	Manifests   int       `json:"manifests"`    // Manifests that list or listed it
+	Authors     int       `json:"authors"`      // Distinct authors of those commits
+	TopAuthor   string    `json:"top_author"`   // Author who changed it most often
+	LastChanged time.Time `json:"last_changed"` // Date of the latest change (zero = not within the window)
+}
+
+// ManifestRecord summarizes the history of one manifest or lockfile.
+type ManifestRecord struct {
+	Path           string    `json:"path"`
+	Ecosystem      string    `json:"ecosystem"`
+	Dependencies   int       `json:"dependencies"`     // Dependencies listed at HEAD
+	Commits        int       `json:"commits"`          // Commits that changed the manifest
+	Authors        int       `json:"authors"`          // Distinct authors of those commits
+	TopAuthor      string    `json:"top_author"`       // Author with the most commits
+	TopAuthorShare float64   `json:"top_author_share"` // Share of the commits made by the top author (0-1)
+	LastChanged    time.Time `json:"last_changed"`     // Date of the latest commit (zero = not within the window)
+	Silo           bool      `json:"silo"`             // Whether one author makes nearly all of the changes
+}
+
+// DepsAuthor counts the dependency changes made by one author.
+type DepsAuthor struct {
+	Author  string `json:"author"`
+	Changes int    `json:"changes"` // Dependency changes, counted once per dependency and commit
+	Commits int    `json:"commits"` // Commits that changed a manifest
+}
+
+// DepsSummary provides metadata about the dependency analysis.
+type DepsSummary struct {
+	Path          string `json:"path"`           // Path filter the manifests were collected for (empty = whole repo)
+	Manifests     int    `json:"manifests"`      // Manifests and lockfiles found at HEAD
+	Dependencies  int    `json:"dependencies"`   // Distinct dependencies seen
+	Changes       int    `json:"changes"`        // Dependency changes, counted once per dependency and commit
+	SiloManifests int    `json:"silo_manifests"` // Manifests that are knowledge silos
+}
+
+// DepsResult lists the dependencies that change most often, the manifests that hold
+// them and the authors who change them.
+type DepsResult struct {
+	Summary      DepsSummary        `json:"summary"`
+	Dependencies []DependencyRecord `json:"dependencies"`
+	Manifests    []ManifestRecord   `json:"manifests"`
+	Authors      []DepsAuthor       `json:"authors"`
+}
@@ -0,0 +1,90 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestEcosystem(t *testing.T) {
+	ecosystem, ok := schema.ManifestEcosystem("services/api/go.mod")
+	assert.True(t, ok)
+	assert.Equal(t, schema.EcosystemGo, ecosystem)
+
+	ecosystem, ok = schema.ManifestEcosystem("web/package-lock.json")
+	assert.True(t, ok)
+	assert.Equal(t, schema.EcosystemNPM, ecosystem)
+
+	_, ok = schema.ManifestEcosystem("web/node_modules/react/package.json")
+	assert.False(t, ok)
+	_, ok = schema.ManifestEcosystem("vendor/github.com/a/b/go.mod")
+	assert.False(t, ok)
+	_, ok = schema.ManifestEcosystem("main.go")
+	assert.False(t, ok)
+
+	assert.True(t, schema.IsLockfile("Cargo.lock"))
+	assert.False(t, schema.IsLockfile("Cargo.toml"))
+}
+
+func TestParseManifest(t *testing.T) {
+	tests := []struct {
+		path string
+		data string
+		want map[string]string
+	}{
+		{
+			path: "go.mod",
+			data: "module x\n\ngo 1.22\n\nrequire github.com/a/b v1.0.0\n\nrequire (\n\tgithub.com/c/d v0.2.0 // indirect\n)\n\nreplace github.com/a/b => ../b\n",
+			want: map[string]string{"github.com/a/b": "v1.0.0", "github.com/c/d": "v0.2.0"},
+		},
+		{
+			path: "go.sum",
+			data: "github.com/a/b v1.0.0 h1:x=\ngithub.com/a/b v1.0.0/go.mod h1:y=\ngithub.com/a/b v0.9.0 h1:z=\n",
+			want: map[string]string{"github.com/a/b": "v0.9.0, v1.0.0"},
+		},
+		{
+			path: "package.json",
+			data: `{"name": "web", "dependencies": {"react": "^18.2.0"}, "devDependencies": {"jest": "29"}}`,
+			want: map[string]string{"react": "^18.2.0", "jest": "29"},
+		},
+		{
+			path: "package-lock.json",
+			data: `{"lockfileVersion": 3, "packages": {"": {"version": "1.0.0"}, "node_modules/react": {"version": "18.2.0"}, "node_modules/a/node_modules/@scope/b": {"version": "2.0.0"}}}`,
+			want: map[string]string{"react": "18.2.0", "@scope/b": "2.0.0"},
+		},
+		{
+			path: "requirements.txt",
+			data: "# pinned\n-r base.txt\nDjango==4.2 ; python_version >= '3.8'\nrequests[socks]>=2.31\nSome_Package\n",
+			want: map[string]string{"django": "==4.2", "requests": ">=2.31", "some-package": ""},
+		},
+		{
+			path: "Cargo.toml",
+			data: "[package]\nname = \"app\"\nversion = \"0.1.0\"\n\n[dependencies]\nserde = { version = \"1.0\", features = [\"derive\"] }\nlog = \"0.4\"\ntokio.workspace = true\n\n[dev-dependencies.criterion]\nversion = \"0.5\"\n\n[target.'cfg(unix)'.dependencies]\nlibc = \"0.2\"\n",
+			want: map[string]string{"serde": "1.0", "log": "0.4", "tokio": "", "criterion": "0.5", "libc": "0.2"},
+		},
+		{
+			path: "Cargo.lock",
+			data: "version = 3\n\n[[package]]\nname = \"log\"\nversion = \"0.4.20\"\n\n[[package]]\nname = \"log\"\nversion = \"0.3.9\"\n",
+			want: map[string]string{"log": "0.3.9, 0.4.20"},
+		},
+	}
+	for _, tt := range tests {
+		got, err := schema.ParseManifest(tt.path, []byte(tt.data))
+		require.NoError(t, err, tt.path)
+		assert.Equal(t, tt.want, got, tt.path)
+	}
+
+	_, err := schema.ParseManifest("package.json", []byte("{not json"))
+	assert.Error(t, err)
+	_, err = schema.ParseManifest("Gemfile", nil)
+	assert.Error(t, err)
+}
+
+func TestGoModulePath(t *testing.T) {
+	assert.Equal(t, "github.com/a/b", schema.GoModulePath([]byte("// comment\nmodule github.com/a/b\n\ngo 1.22\n")))
+	assert.Equal(t, "example.com/x", schema.GoModulePath([]byte(`module "example.com/x" // quoted`)))
+	assert.Empty(t, schema.GoModulePath([]byte("modules x\n")))
+	assert.Empty(t, schema.GoModulePath(nil))
+}
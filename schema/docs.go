@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DocsDirName is the directory that holds a folder's longer-form documentation.
+const DocsDirName = "docs"
+
+// DocFolder returns the folder that a documentation file describes, and whether path
+// is documentation at all. Files under a docs/ directory describe the docs/ directory's
+// parent; other Markdown files describe the folder they live in.
+func DocFolder(path string) (string, bool) {
+	parts := strings.Split(path, "/")
+	for i, part := range parts[:len(parts)-1] {
+		if part == DocsDirName {
+			if i == 0 {
+				return ".", true
+			}
+			return strings.Join(parts[:i], "/"), true
+		}
+	}
+	if strings.EqualFold(filepath.Ext(path), ".md") {
+		return filepath.Dir(path), true
+	}
+	return "", false
+}
+
+// DocDrift tracks how much a folder's code changed since its docs were last modified.
+// Code is every tracked file directly in the folder that is not documentation.
+type DocDrift struct {
+	DocFiles         int       `json:"doc_files"`          // Existing documentation files describing the folder
+	DocsLastModified time.Time `json:"docs_last_modified"` // Latest commit that changed the docs (zero = not within the window)
+	CodeCommits      Metric    `json:"code_commits"`       // Commits that changed code in the folder
+	CommitsSinceDocs Metric    `json:"commits_since_docs"` // Code commits made after the docs were last modified
+	ChurnSinceDocs   Metric    `json:"churn_since_docs"`   // Lines of code added/deleted after the docs were last modified
+}
+
+// DriftRatio returns the share of code commits made after the docs were last modified.
+func (d DocDrift) DriftRatio() float64 {
+	if d.CodeCommits == 0 {
+		return 0
+	}
+	return d.CommitsSinceDocs.Float64() / d.CodeCommits.Float64()
+}
+
+// DocDriftRecord ranks one documented folder by how stale its docs are.
+type DocDriftRecord struct {
+	Path             string    `json:"path"`               // Relative path to the folder in the repository
+	DocFiles         int       `json:"doc_files"`          // Existing documentation files describing the folder
+	DocsLastModified time.Time `json:"docs_last_modified"` // Latest commit that changed the docs (zero = before the window)
+	CodeCommits      Metric    `json:"code_commits"`       // Commits that changed code in the folder
+	CommitsSinceDocs Metric    `json:"commits_since_docs"` // Code commits made after the docs were last modified
+	ChurnSinceDocs   Metric    `json:"churn_since_docs"`   // Lines of code added/deleted after the docs were last modified
+	DriftRatio       float64   `json:"drift_ratio"`        // Share of code commits made after the docs were last modified (0-1)
+	Score            float64   `json:"score"`              // Folder score in the current scoring mode
+}
+
+// DocDriftSummary provides metadata about the documentation drift analysis.
+type DocDriftSummary struct {
+	Mode                ScoringMode `json:"mode"`                 // Scoring mode used for the folder scores
+	DocumentedFolders   int         `json:"documented_folders"`   // Active folders with at least one documentation file
+	UndocumentedFolders int         `json:"undocumented_folders"` // Active folders without documentation
+	StaleFolders        int         `json:"stale_folders"`        // Documented folders with code commits since the docs last changed
+}
+
+// DocDriftResult lists documented folders ordered by how stale their docs are.
+type DocDriftResult struct {
+	Summary DocDriftSummary  `json:"summary"`
+	Folders []DocDriftRecord `json:"folders"`
+}
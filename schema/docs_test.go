@@ -0,0 +1,35 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocFolder(t *testing.T) {
+	tests := []struct {
+		path   string
+		folder string
+		isDoc  bool
+	}{
+		{"README.md", ".", true},
+		{"internal/payments/README.md", "internal/payments", true},
+		{"internal/payments/CHANGELOG.MD", "internal/payments", true},
+		{"internal/payments/docs/adr/0001-ledger.md", "internal/payments", true},
+		{"internal/payments/docs/diagram.png", "internal/payments", true},
+		{"docs/index.html", ".", true},
+		{"internal/payments/ledger.go", "", false},
+		{"internal/docs.go", "", false},
+	}
+	for _, tt := range tests {
+		folder, isDoc := schema.DocFolder(tt.path)
+		assert.Equal(t, tt.isDoc, isDoc, tt.path)
+		assert.Equal(t, tt.folder, folder, tt.path)
+	}
+}
+
+func TestDocDrift_DriftRatio(t *testing.T) {
+	assert.Zero(t, schema.DocDrift{}.DriftRatio())
+	assert.InDelta(t, 0.75, schema.DocDrift{CodeCommits: 4, CommitsSinceDocs: 3}.DriftRatio(), 1e-9)
+}
@@ -0,0 +1,371 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Limits that keep user-supplied expressions cheap to parse and evaluate.
+const (
+	maxExpressionLength = 1024
+	maxExpressionDepth  = 32
+)
+
+// expressionFuncs lists the pure math functions available to expressions, keyed by name
+// with their exact arity. Nothing else is callable, which keeps the language sandboxed.
+var expressionFuncs = map[string]struct {
+	arity int
+	fn    func(args []float64) float64
+}{
+	"abs":   {1, func(a []float64) float64 { return math.Abs(a[0]) }},
+	"sqrt":  {1, func(a []float64) float64 { return math.Sqrt(math.Max(a[0], 0)) }},
+	"log1p": {1, func(a []float64) float64 { return math.Log1p(math.Max(a[0], 0)) }},
+	"min":   {2, func(a []float64) float64 { return math.Min(a[0], a[1]) }},
+	"max":   {2, func(a []float64) float64 { return math.Max(a[0], a[1]) }},
+	"clamp": {3, func(a []float64) float64 { return math.Min(math.Max(a[0], a[1]), a[2]) }},
+}
+
+// Expression is a compiled scoring formula. The top-level sum is kept as separate terms
+// so each one can be reported in the score breakdown.
+type Expression struct {
+	source string
+	terms  []exprTerm
+}
+
+type exprTerm struct {
+	label string
+	sign  float64
+	node  exprNode
+}
+
+type exprNode interface {
+	eval(vars map[string]float64) float64
+}
+
+type numberNode float64
+
+func (n numberNode) eval(map[string]float64) float64 { return float64(n) }
+
+type varNode string
+
+func (v varNode) eval(vars map[string]float64) float64 { return vars[string(v)] }
+
+type negNode struct{ operand exprNode }
+
+func (n negNode) eval(vars map[string]float64) float64 { return -n.operand.eval(vars) }
+
+type binaryNode struct {
+	op          byte
+	left, right exprNode
+}
+
+func (b binaryNode) eval(vars map[string]float64) float64 {
+	l, r := b.left.eval(vars), b.right.eval(vars)
+	switch b.op {
+	case '+':
+		return l + r
+	case '-':
+		return l - r
+	case '*':
+		return l * r
+	default:
+		if r == 0 {
+			return 0 // Division by zero contributes nothing rather than poisoning the score
+		}
+		return l / r
+	}
+}
+
+type callNode struct {
+	name string
+	args []exprNode
+}
+
+func (c callNode) eval(vars map[string]float64) float64 {
+	values := make([]float64, len(c.args))
+	for i, arg := range c.args {
+		values[i] = arg.eval(vars)
+	}
+	return expressionFuncs[c.name].fn(values)
+}
+
+// CompileExpression parses a scoring formula such as "0.5*churn_norm + 0.5*(1-contrib_norm)".
+// Only numbers, the variables listed by ExpressionVariableNames, + - * /, parentheses and
+// the functions abs, sqrt, log1p, min, max and clamp are allowed.
+func CompileExpression(source string) (*Expression, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, fmt.Errorf("expression is empty")
+	}
+	if len(source) > maxExpressionLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", maxExpressionLength)
+	}
+	p := &exprParser{src: source}
+	p.next()
+	terms, err := p.parseTerms()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokEOF {
+		return nil, p.errorf("unexpected %q", p.tok.text)
+	}
+	return &Expression{source: source, terms: terms}, nil
+}
+
+// String returns the original expression source.
+func (e *Expression) String() string { return e.source }
+
+// Evaluate computes the expression and the contribution of each top-level term.
+// Non-finite results are treated as zero.
+func (e *Expression) Evaluate(vars map[string]float64) (float64, map[BreakdownKey]float64) {
+	var total float64
+	contributions := make(map[BreakdownKey]float64, len(e.terms))
+	for _, term := range e.terms {
+		v := term.sign * term.node.eval(vars)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			v = 0
+		}
+		contributions[BreakdownKey(term.label)] += v
+		total += v
+	}
+	return total, contributions
+}
+
+// --- Lexer ---
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokNumber
+	tokIdent
+	tokOp
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+type exprParser struct {
+	src   string
+	pos   int
+	tok   token
+	depth int
+}
+
+func (p *exprParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("invalid expression at position %d: %s", p.tok.pos+1, fmt.Sprintf(format, args...))
+}
+
+func (p *exprParser) next() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos >= len(p.src) {
+		p.tok = token{kind: tokEOF, pos: start}
+		return
+	}
+	c := p.src[p.pos]
+	switch {
+	case c >= '0' && c <= '9' || c == '.':
+		for p.pos < len(p.src) && (p.src[p.pos] >= '0' && p.src[p.pos] <= '9' || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		p.tok = token{kind: tokNumber, text: p.src[start:p.pos], pos: start}
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || unicode.IsLetter(rune(p.src[p.pos])) || unicode.IsDigit(rune(p.src[p.pos]))) {
+			p.pos++
+		}
+		p.tok = token{kind: tokIdent, text: p.src[start:p.pos], pos: start}
+	default:
+		p.pos++
+		p.tok = token{kind: tokOp, text: string(c), pos: start}
+	}
+}
+
+// --- Parser ---
+
+// parseTerms parses the top-level sum, keeping each additive term separately.
+func (p *exprParser) parseTerms() ([]exprTerm, error) {
+	sign := 1.0
+	if p.tok.kind == tokOp && p.tok.text == "-" {
+		sign = -1
+		p.next()
+	}
+	var terms []exprTerm
+	for {
+		start := p.tok.pos
+		node, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		label := strings.Join(strings.Fields(p.src[start:p.tok.pos]), "")
+		if sign < 0 {
+			label = "-" + label
+		}
+		terms = append(terms, exprTerm{label: label, sign: sign, node: node})
+
+		if p.tok.kind != tokOp || (p.tok.text != "+" && p.tok.text != "-") {
+			return terms, nil
+		}
+		sign = 1
+		if p.tok.text == "-" {
+			sign = -1
+		}
+		p.next()
+	}
+}
+
+func (p *exprParser) parseSum() (exprNode, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOp && (p.tok.text == "+" || p.tok.text == "-") {
+		op := p.tok.text[0]
+		p.next()
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseProduct() (exprNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokOp && (p.tok.text == "*" || p.tok.text == "/") {
+		op := p.tok.text[0]
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if p.tok.kind == tokOp && p.tok.text == "-" {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return negNode{operand: operand}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxExpressionDepth {
+		return nil, p.errorf("expression is nested too deeply")
+	}
+
+	tok := p.tok
+	switch tok.kind {
+	case tokNumber:
+		v, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", tok.text)
+		}
+		p.next()
+		return numberNode(v), nil
+
+	case tokIdent:
+		p.next()
+		if p.tok.kind == tokOp && p.tok.text == "(" {
+			return p.parseCall(tok)
+		}
+		if !slices.Contains(expressionVariableNames, tok.text) {
+			return nil, fmt.Errorf("invalid expression: unknown variable %q (available: %s)", tok.text, strings.Join(expressionVariableNames, ", "))
+		}
+		return varNode(tok.text), nil
+
+	case tokOp:
+		if tok.text == "(" {
+			p.next()
+			inner, err := p.parseSum()
+			if err != nil {
+				return nil, err
+			}
+			if p.tok.kind != tokOp || p.tok.text != ")" {
+				return nil, p.errorf("missing closing parenthesis")
+			}
+			p.next()
+			return inner, nil
+		}
+		return nil, p.errorf("unexpected %q", tok.text)
+
+	default:
+		return nil, p.errorf("unexpected end of expression")
+	}
+}
+
+func (p *exprParser) parseCall(name token) (exprNode, error) {
+	spec, ok := expressionFuncs[name.text]
+	if !ok {
+		return nil, fmt.Errorf("invalid expression: unknown function %q", name.text)
+	}
+	p.next() // consume "("
+	var args []exprNode
+	for {
+		arg, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if p.tok.kind == tokOp && p.tok.text == "," {
+			p.next()
+			continue
+		}
+		break
+	}
+	if p.tok.kind != tokOp || p.tok.text != ")" {
+		return nil, p.errorf("missing closing parenthesis for %s()", name.text)
+	}
+	p.next()
+	if len(args) != spec.arity {
+		return nil, fmt.Errorf("invalid expression: %s() takes %d argument(s), got %d", name.text, spec.arity, len(args))
+	}
+	return callNode{name: name.text, args: args}, nil
+}
+
+// expressionVariableNames lists the identifiers available to expression-based scoring modes.
+var expressionVariableNames = []string{
+	// Normalized metrics [0,1]
+	"contrib_norm", "commits_norm", "size_norm", "age_norm", "churn_norm", "loc_norm",
+	"decayed_commits_norm", "decayed_churn_norm", "gini_norm", "inv_contrib_norm",
+	"recent_commits_norm", "inv_recent_commits_norm", "fixes_norm", "entropy_norm",
+	"minor_contrib_norm", "owner_share_norm", "ownership_entropy_norm", "off_hours_norm",
+	"test_gap_norm", "indent_norm", "cyclomatic_norm", "cognitive_norm",
+	// Raw FileResult fields
+	"contributors", "commits", "churn", "lines_added", "lines_deleted", "lines_of_code",
+	"size_bytes", "age_days", "gini", "decayed_commits", "decayed_churn",
+	"recent_contributors", "recent_commits", "recent_churn", "recent_lines_added",
+	"recent_lines_deleted", "recency_signal", "fix_commits", "fix_churn", "fix_ratio",
+	"reverts", "rework_lines", "rework_ratio", "change_entropy",
+	"minor_contributors", "top_owner_share", "ownership_entropy",
+	"median_commit_files", "median_commit_churn", "large_commit_share",
+	"off_hours_ratio", "weekend_ratio", "test_co_changes", "test_co_change_ratio",
+	"sloc", "comment_lines", "comment_ratio", "indent_complexity", "max_indent",
+	"functions", "cyclomatic_total", "cyclomatic_max", "cognitive_total", "cognitive_max",
+}
+
+// ExpressionVariableNames returns the identifiers that scoring expressions may reference.
+func ExpressionVariableNames() []string {
+	return slices.Clone(expressionVariableNames)
+}
@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileExpression_Evaluate(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		vars     map[string]float64
+		expected float64
+		terms    map[BreakdownKey]float64
+	}{
+		{
+			name:     "weighted terms",
+			source:   "0.5*churn_norm + 0.5*(1-contrib_norm)",
+			vars:     map[string]float64{"churn_norm": 0.8, "contrib_norm": 0.2},
+			expected: 0.8,
+			terms:    map[BreakdownKey]float64{"0.5*churn_norm": 0.4, "0.5*(1-contrib_norm)": 0.4},
+		},
+		{
+			name:     "subtraction keeps sign in label",
+			source:   "gini_norm - 0.25 * age_norm",
+			vars:     map[string]float64{"gini_norm": 0.5, "age_norm": 1},
+			expected: 0.25,
+			terms:    map[BreakdownKey]float64{"gini_norm": 0.5, "-0.25*age_norm": -0.25},
+		},
+		{
+			name:     "functions and precedence",
+			source:   "clamp(commits / 100, 0, 1) * max(gini, 0.5)",
+			vars:     map[string]float64{"commits": 250, "gini": 0.2},
+			expected: 0.5,
+		},
+		{
+			name:     "division by zero is zero",
+			source:   "churn / commits",
+			vars:     map[string]float64{"churn": 10},
+			expected: 0,
+		},
+		{
+			name:     "unary minus",
+			source:   "-(-loc_norm)",
+			vars:     map[string]float64{"loc_norm": 0.3},
+			expected: 0.3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := CompileExpression(tt.source)
+			require.NoError(t, err)
+			total, terms := expr.Evaluate(tt.vars)
+			assert.InDelta(t, tt.expected, total, 1e-9)
+			for k, v := range tt.terms {
+				assert.InDelta(t, v, terms[k], 1e-9, "term %s", k)
+			}
+		})
+	}
+}
+
+func TestCompileExpression_Errors(t *testing.T) {
+	tests := []struct {
+		source string
+		errMsg string
+	}{
+		{"", "empty"},
+		{"churn_norm +", "unexpected end"},
+		{"(churn_norm", "missing closing parenthesis"},
+		{"password", "unknown variable"},
+		{"exec(churn_norm)", "unknown function"},
+		{"min(churn_norm)", "takes 2 argument(s)"},
+		{"churn_norm ; gini", "unexpected"},
+		{"1.2.3", "invalid number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			_, err := CompileExpression(tt.source)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package schema
+
+// FolderAggregation selects how the scores of a folder's files combine into the folder score.
+type FolderAggregation string
+
+const (
+	// MeanFolderAggregation averages file scores weighted by lines of code.
+	MeanFolderAggregation FolderAggregation = "mean"
+	// MaxFolderAggregation takes the score of the folder's worst file.
+	MaxFolderAggregation FolderAggregation = "max"
+	// P90FolderAggregation takes the 90th percentile of the folder's file scores.
+	P90FolderAggregation FolderAggregation = "p90"
+	// ExcessFolderAggregation sums how far each file scores above the folder threshold.
+	ExcessFolderAggregation FolderAggregation = "excess"
+	// CountFolderAggregation counts the files that score above the folder threshold.
+	CountFolderAggregation FolderAggregation = "count"
+)
+
+// DefaultFolderThreshold is the file score above which the excess and count aggregations
+// treat a file as a hotspot. It matches the lower bound of the High label.
+const DefaultFolderThreshold = 60.0
+
+// ValidFolderAggregations lists the accepted folder aggregation strategies.
+var ValidFolderAggregations = map[FolderAggregation]bool{
+	MeanFolderAggregation:   true,
+	MaxFolderAggregation:    true,
+	P90FolderAggregation:    true,
+	ExcessFolderAggregation: true,
+	CountFolderAggregation:  true,
+}
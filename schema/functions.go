@@ -0,0 +1,40 @@
+package schema
+
+import "time"
+
+// DefaultFunctionFiles is the number of top Go files whose functions are analyzed when no
+// file is given.
+const DefaultFunctionFiles = 5
+
+// FunctionResult is the change history, complexity and score of one Go function.
+type FunctionResult struct {
+	Path         string                  `json:"path"`         // File that declares the function
+	Name         string                  `json:"name"`         // Function name, with the receiver for methods
+	Line         int                     `json:"line"`         // First line of the declaration at HEAD
+	EndLine      int                     `json:"end_line"`     // Last line of the declaration at HEAD
+	Cyclomatic   int                     `json:"cyclomatic"`   // Cyclomatic complexity at HEAD
+	Cognitive    int                     `json:"cognitive"`    // Cognitive complexity at HEAD
+	Commits      Metric                  `json:"commits"`      // Commits that changed lines of the function
+	Churn        Metric                  `json:"churn"`        // Lines added and deleted inside the function
+	Contributors Metric                  `json:"contributors"` // Authors who changed the function
+	Owners       []string                `json:"owners"`       // Top 2 authors by commit count
+	LastChanged  time.Time               `json:"last_changed"` // Date of the latest commit that changed it
+	Mode         ScoringMode             `json:"mode"`         // Scoring mode used for Score
+	Score        float64                 `json:"score"`        // Score in the current mode (0-100)
+	Scores       map[ScoringMode]float64 `json:"scores"`       // Score in every base mode
+	Reasoning    []string                `json:"reasoning,omitempty"`
+}
+
+// FunctionsSummary describes a function analysis.
+type FunctionsSummary struct {
+	Mode      ScoringMode `json:"mode"`
+	Files     []string    `json:"files"`     // Go files whose functions were analyzed
+	Functions int         `json:"functions"` // Functions declared in those files at HEAD
+	Commits   int         `json:"commits"`   // Commits that changed those files within the window
+}
+
+// FunctionsResult is the top-level response of a function analysis.
+type FunctionsResult struct {
+	Summary   FunctionsSummary `json:"summary"`
+	Functions []FunctionResult `json:"functions"`
+}
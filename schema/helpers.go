@@ -321,10 +321,22 @@ func IsPathInFilter(path, filter string) bool {
 
 // IsCompositeMode returns true if the given mode is a composite scoring mode.
 func IsCompositeMode(mode ScoringMode) bool {
-	return slices.Contains(AllScoringModes, mode) && !IsBaseMode(mode)
+	return GetCompositeConfig(mode) != nil
 }
 
-// IsBaseMode returns true if the given mode is one of the four base scoring modes.
+// GetModeType returns the kind of scoring mode: "base", "composite" or "expression".
+func GetModeType(mode ScoringMode) string {
+	switch {
+	case IsCompositeMode(mode):
+		return "composite"
+	case IsExpressionMode(mode):
+		return "expression"
+	default:
+		return "base"
+	}
+}
+
+// IsBaseMode returns true if the given mode is one of the base scoring modes.
 func IsBaseMode(mode ScoringMode) bool {
 	return slices.Contains(BaseScoringModes, mode)
 }
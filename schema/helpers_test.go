@@ -129,7 +129,7 @@ func TestModeTypeHelpersUseModeLists(t *testing.T) {
 		assert.False(t, IsCompositeMode(mode))
 	}
 
-	for _, mode := range AllScoringModes {
+	for _, mode := range ScoringModes() {
 		if !IsBaseMode(mode) {
 			assert.True(t, IsCompositeMode(mode))
 		}
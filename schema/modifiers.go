@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ScoreModifier scales the score of files whose path matches a glob or regex.
+// Modifiers dampen (or boost) signals from paths such as tests, generated code and
+// configuration that would otherwise crowd out the files teams care about.
+type ScoreModifier struct {
+	Name       string        // Identifier shown in reasoning output
+	Glob       string        // Path pattern using the same syntax as excludes
+	Regex      string        // Regular expression matched against the full path
+	Modes      []ScoringMode // Modes the modifier applies to (empty = all modes)
+	Multiplier float64       // Factor applied to the score when the rule matches
+
+	matcher *PathMatcher
+	re      *regexp.Regexp
+}
+
+// NewScoreModifier validates and compiles a score modifier rule.
+// Exactly one of glob or regex must be set and the multiplier must be a non-negative number.
+func NewScoreModifier(name, glob, regex string, modes []ScoringMode, multiplier float64) (ScoreModifier, error) {
+	r := ScoreModifier{Name: name, Glob: glob, Regex: regex, Modes: modes, Multiplier: multiplier}
+	if name == "" {
+		return r, fmt.Errorf("modifier must have a name")
+	}
+	if (glob == "") == (regex == "") {
+		return r, fmt.Errorf("modifier %s must set exactly one of glob or regex", name)
+	}
+	if math.IsNaN(multiplier) || math.IsInf(multiplier, 0) || multiplier < 0 {
+		return r, fmt.Errorf("modifier %s has invalid multiplier %v", name, multiplier)
+	}
+	for _, mode := range modes {
+		if !IsValidScoringMode(mode) {
+			return r, fmt.Errorf("modifier %s references unknown mode %s", name, mode)
+		}
+	}
+	if glob != "" {
+		r.matcher = NewPathMatcher([]string{glob})
+	} else {
+		re, err := regexp.Compile(regex)
+		if err != nil {
+			return r, fmt.Errorf("modifier %s has invalid regex: %w", name, err)
+		}
+		r.re = re
+	}
+	return r, nil
+}
+
+// Matches returns true if the modifier applies to the given path and mode.
+func (r ScoreModifier) Matches(path string, mode ScoringMode) bool {
+	if len(r.Modes) > 0 && !slices.Contains(r.Modes, mode) {
+		return false
+	}
+	switch {
+	case r.matcher != nil:
+		return r.matcher.Match(path)
+	case r.re != nil:
+		return r.re.MatchString(path)
+	default:
+		return false
+	}
+}
+
+// configurationExtensions lists data and configuration formats that are dampened in complexity mode.
+var configurationExtensions = []string{
+	"yml", "yaml", "json", "toml", "cfg", "xml", "ini",
+	"lock", "sum", "csv", "tsv", "md", "txt", "tfstate",
+}
+
+// DefaultScoreModifiers returns the built-in modifier rules applied when none are configured.
+// They dampen tests, generated, example and mock code in every base mode (less so in risk,
+// where ownership of test code still matters) and configuration files in complexity mode.
+func DefaultScoreModifiers() []ScoreModifier {
+	const testLike = `(?i)test|generate|example|mock`
+	configFiles := `(?i)\.(` + strings.Join(configurationExtensions, "|") + `)$`
+	return []ScoreModifier{
+		mustScoreModifier("test_and_generated", "", testLike, []ScoringMode{HotMode, ComplexityMode, ROIMode, DefectsMode}, 0.50),
+		mustScoreModifier("test_and_generated_risk", "", testLike, []ScoringMode{RiskMode}, 0.75),
+		mustScoreModifier("configuration_files", "", configFiles, []ScoringMode{ComplexityMode}, 0.50),
+	}
+}
+
+func mustScoreModifier(name, glob, regex string, modes []ScoringMode, multiplier float64) ScoreModifier {
+	r, err := NewScoreModifier(name, glob, regex, modes, multiplier)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
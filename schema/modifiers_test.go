@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewScoreModifier_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		modName    string
+		glob       string
+		regex      string
+		modes      []ScoringMode
+		multiplier float64
+		errMsg     string
+	}{
+		{"missing name", "", "*.go", "", nil, 0.5, "must have a name"},
+		{"no pattern", "r", "", "", nil, 0.5, "exactly one of glob or regex"},
+		{"both patterns", "r", "*.go", "go$", nil, 0.5, "exactly one of glob or regex"},
+		{"negative multiplier", "r", "*.go", "", nil, -1, "invalid multiplier"},
+		{"unknown mode", "r", "*.go", "", []ScoringMode{"bogus"}, 0.5, "unknown mode"},
+		{"bad regex", "r", "", "(", nil, 0.5, "invalid regex"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewScoreModifier(tt.modName, tt.glob, tt.regex, tt.modes, tt.multiplier)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errMsg)
+		})
+	}
+}
+
+func TestScoreModifier_Matches(t *testing.T) {
+	glob, err := NewScoreModifier("tests", "**/*_test.go", "", []ScoringMode{HotMode}, 0.5)
+	require.NoError(t, err)
+	assert.True(t, glob.Matches("core/engine_test.go", HotMode))
+	assert.False(t, glob.Matches("core/engine_test.go", RiskMode))
+	assert.False(t, glob.Matches("contest/engine.go", HotMode))
+
+	regex, err := NewScoreModifier("codegen", "", `^gen/`, nil, 0.2)
+	require.NoError(t, err)
+	assert.True(t, regex.Matches("gen/types.go", RiskMode))
+	assert.False(t, regex.Matches("codegen/core.go", RiskMode))
+}
+
+func TestDefaultScoreModifiers_MatchCurrentDebuffs(t *testing.T) {
+	var configRule ScoreModifier
+	for _, r := range DefaultScoreModifiers() {
+		if r.Name == "configuration_files" {
+			configRule = r
+		}
+	}
+	for _, ext := range configurationExtensions {
+		assert.True(t, configRule.Matches("settings."+ext, ComplexityMode), ext)
+		assert.True(t, configRule.Matches("SETTINGS."+ext, ComplexityMode), ext)
+	}
+	assert.False(t, configRule.Matches("main.go", ComplexityMode))
+	assert.False(t, configRule.Matches("build.gradle", ComplexityMode))
+	assert.False(t, configRule.Matches("settings.yml", HotMode))
+}
@@ -0,0 +1,37 @@
+package schema
+
+// NormalizationStrategy selects how raw metrics are scaled to [0,1] before weighting.
+type NormalizationStrategy string
+
+const (
+	// FixedNormalization divides metrics by fixed, repository-independent maxima.
+	FixedNormalization NormalizationStrategy = "fixed"
+	// PercentileNormalization divides metrics by a high percentile of the analyzed files.
+	PercentileNormalization NormalizationStrategy = "percentile"
+	// LogNormalization log-scales metrics against the largest value among the analyzed files.
+	LogNormalization NormalizationStrategy = "log"
+)
+
+// ValidNormalizationStrategies lists the accepted normalization strategies.
+var ValidNormalizationStrategies = map[NormalizationStrategy]bool{
+	FixedNormalization:      true,
+	PercentileNormalization: true,
+	LogNormalization:        true,
+}
+
+// NormalizationScales holds the per-metric scales that saturate a normalized metric at 1.0.
+// Scales are derived once per analysis run and recorded so scores stay comparable across runs.
+type NormalizationScales struct {
+	Strategy      NormalizationStrategy `json:"strategy"`
+	Percentile    float64               `json:"percentile,omitempty"` // Percentile used by the percentile strategy
+	Contributors  float64               `json:"contributors"`
+	Commits       float64               `json:"commits"`
+	SizeKB        float64               `json:"size_kb"`
+	AgeDays       float64               `json:"age_days"`
+	Churn         float64               `json:"churn"`
+	RecentCommits float64               `json:"recent_commits"`
+	LinesOfCode   float64               `json:"lines_of_code"`
+	Indent        float64               `json:"indent"`
+	Cyclomatic    float64               `json:"cyclomatic"`
+	Cognitive     float64               `json:"cognitive"`
+}
@@ -5,38 +5,77 @@ import "time"
 
 // FileResult represents the Git and file system metrics for a single file.
 type FileResult struct {
-	Path                 string    `json:"path"`                 // Relative path to the file in the repository
-	UniqueContributors   Metric    `json:"unique_contributors"`  // Number of different authors who modified the file
-	Commits              Metric    `json:"commits"`              // Total number of commits affecting this file
-	RecentContributors   Metric    `json:"recent_contributors"`  // Recent contributor count within a time window
-	RecentCommits        Metric    `json:"recent_commits"`       // Recent commit count within a time window
-	RecentChurn          Metric    `json:"recent_churn"`         // Recent churn within a time window
-	RecentLinesAdded     Metric    `json:"recent_lines_added"`   // Recent lines added
-	RecentLinesDeleted   Metric    `json:"recent_lines_deleted"` // Recent lines deleted
-	DecayedCommits       Metric    `json:"decayed_commits"`      // Time-weighted commit count
-	DecayedChurn         Metric    `json:"decayed_churn"`        // Time-weighted churn count
-	RecentWindowDays     int       `json:"recent_window_days"`   // Number of days defining the 'recent' window
-	SizeBytes            int64     `json:"size_bytes"`           // Current size of the file in bytes (Stay int64 as it's a file property)
-	LinesOfCode          Metric    `json:"lines_of_code"`        // Current lines of code
-	AgeDays              Metric    `json:"age_days"`             // Age of the file in days since first commit
-	Churn                Metric    `json:"churn"`                // Total number of lines added/deleted
-	LinesAdded           Metric    `json:"lines_added"`          // Total lines added
-	LinesDeleted         Metric    `json:"lines_deleted"`        // Total lines deleted
-	Gini                 float64   `json:"gini"`                 // Gini coefficient of commit distribution (0-1, lower is more even)
-	FirstCommit          time.Time `json:"first_commit"`         // Timestamp of the file's first commit
-	Owners               []string  `json:"owners"`               // Top 2 owners by commit count
-	RecencySignal        float64   `json:"recency_signal"`       // 0-1 freshness score (recent activity vs lifetime volume)
+	Path                 string    `json:"path"`                          // Relative path to the file in the repository
+	UniqueContributors   Metric    `json:"unique_contributors"`           // Number of different authors who modified the file
+	Commits              Metric    `json:"commits"`                       // Total number of commits affecting this file
+	RecentContributors   Metric    `json:"recent_contributors"`           // Recent contributor count within a time window
+	RecentCommits        Metric    `json:"recent_commits"`                // Recent commit count within a time window
+	RecentChurn          Metric    `json:"recent_churn"`                  // Recent churn within a time window
+	RecentLinesAdded     Metric    `json:"recent_lines_added"`            // Recent lines added
+	RecentLinesDeleted   Metric    `json:"recent_lines_deleted"`          // Recent lines deleted
+	DecayedCommits       Metric    `json:"decayed_commits"`               // Time-weighted commit count
+	DecayedChurn         Metric    `json:"decayed_churn"`                 // Time-weighted churn count
+	RecentWindowDays     int       `json:"recent_window_days"`            // Number of days defining the 'recent' window
+	SizeBytes            int64     `json:"size_bytes"`                    // Current size of the file in bytes (Stay int64 as it's a file property)
+	LinesOfCode          Metric    `json:"lines_of_code"`                 // Current lines of code
+	SLOC                 Metric    `json:"sloc"`                          // Current lines of code, without blank and comment lines
+	CommentLines         Metric    `json:"comment_lines"`                 // Current lines that only hold comments
+	CommentRatio         float64   `json:"comment_ratio"`                 // Share of non-blank lines that are comments (0-1)
+	IndentComplexity     Metric    `json:"indent_complexity"`             // Summed indentation depth of the code lines
+	MaxIndent            Metric    `json:"max_indent"`                    // Deepest indentation of any code line
+	Functions            Metric    `json:"functions"`                     // Go functions and methods with a body (0 for other languages)
+	CyclomaticTotal      Metric    `json:"cyclomatic_total"`              // Summed cyclomatic complexity of the Go functions
+	CyclomaticMax        Metric    `json:"cyclomatic_max"`                // Highest cyclomatic complexity of any Go function
+	CognitiveTotal       Metric    `json:"cognitive_total"`               // Summed cognitive complexity of the Go functions
+	CognitiveMax         Metric    `json:"cognitive_max"`                 // Highest cognitive complexity of any Go function
+	AgeDays              Metric    `json:"age_days"`                      // Age of the file in days since first commit
+	Churn                Metric    `json:"churn"`                         // Total number of lines added/deleted
+	LinesAdded           Metric    `json:"lines_added"`                   // Total lines added
+	LinesDeleted         Metric    `json:"lines_deleted"`                 // Total lines deleted
+	Gini                 float64   `json:"gini"`                          // Gini coefficient of commit distribution (0-1, lower is more even)
+	MinorContributors    Metric    `json:"minor_contributors"`            // Contributors with under 5% of the commits
+	TopOwnerShare        float64   `json:"top_owner_share"`               // Share of commits made by the top owner (0-1)
+	OwnershipEntropy     float64   `json:"ownership_entropy"`             // Shannon entropy of commits across contributors, in bits
+	FixCommits           Metric    `json:"fix_commits"`                   // Commits classified as fixes
+	FixChurn             Metric    `json:"fix_churn"`                     // Lines added/deleted by fix commits
+	FixRatio             float64   `json:"fix_ratio"`                     // Share of commits classified as fixes (0-1)
+	Reverts              Metric    `json:"reverts"`                       // Commits that reverted changes to this file
+	RevertWindowDays     int       `json:"revert_window_days"`            // Days from the first revert to the end of the analysis
+	ReworkLines          Metric    `json:"rework_lines"`                  // Added lines deleted again by another commit within the rework window
+	ReworkRatio          float64   `json:"rework_ratio"`                  // Share of added lines that were reworked (0-1)
+	ReworkWindowDays     int       `json:"rework_window_days"`            // Number of days defining the rework window
+	ChangeEntropy        float64   `json:"change_entropy"`                // Summed contribution to each period's change entropy
+	MedianCommitFiles    Metric    `json:"median_commit_files"`           // Median number of files changed by commits touching this file
+	MedianCommitChurn    Metric    `json:"median_commit_churn"`           // Median lines added/deleted by commits touching this file
+	LargeCommitShare     float64   `json:"large_commit_share"`            // Share of commits that changed 20+ files (0-1)
+	WorkPatterns         bool      `json:"work_patterns"`                 // Whether off-hours and weekend commits were collected
+	OffHoursCommits      Metric    `json:"off_hours_commits"`             // Commits made before 08:00 or from 20:00 in the author's local time
+	OffHoursRatio        float64   `json:"off_hours_ratio"`               // Share of commits made off-hours (0-1)
+	WeekendCommits       Metric    `json:"weekend_commits"`               // Commits made on a Saturday or Sunday in the author's local time
+	WeekendRatio         float64   `json:"weekend_ratio"`                 // Share of commits made on a weekend (0-1)
+	TestStatus           string    `json:"test_status,omitempty"`         // paired, orphan or test; empty if no pairing rule covers the file
+	TestCoChanges        Metric    `json:"test_co_changes"`               // Commits that also changed a paired test
+	TestCoChangeRatio    float64   `json:"test_co_change_ratio"`          // Share of commits that also changed a paired test (0-1)
+	TicketCount          Metric    `json:"ticket_count"`                  // Distinct issue tracker tickets referenced by commits
+	TopTicketPrefixes    []string  `json:"top_ticket_prefixes,omitempty"` // Most frequent ticket prefixes (e.g. PAY, #)
+	FirstCommit          time.Time `json:"first_commit"`                  // Timestamp of the file's first commit
+	Owners               []string  `json:"owners"`                        // Top 2 owners by commit count
+	RecencySignal        float64   `json:"recency_signal"`                // 0-1 freshness score (recent activity vs lifetime volume)
 	RecencyThresholdLow  float64   `json:"recency_threshold_low"`
 	RecencyThresholdHigh float64   `json:"recency_threshold_high"`
 
 	Mode          ScoringMode                              `json:"mode"`                 // Scoring mode used (hot, risk, complexity, roi)
-	ModeType      string                                   `json:"mode_type"`            // Type of mode: 'base' or 'composite'
+	ModeType      string                                   `json:"mode_type"`            // Type of mode: 'base', 'composite' or 'expression'
 	ModeScore     float64                                  `json:"score"`                // Computed score for the current mode (0-100)
 	Reasoning     []string                                 `json:"reasoning,omitempty"`  // Human-and-AI-readable justifications for the score
+	Modifiers     []string                                 `json:"modifiers,omitempty"`  // Score modifiers applied in the current mode (e.g. "test_and_generated x0.50")
 	ModeBreakdown map[BreakdownKey]float64                 `json:"breakdown"`            // Normalized contribution of each metric to the score
 	AllScores     map[ScoringMode]float64                  `json:"scores"`               // All computed scores by mode
 	AllBreakdowns map[ScoringMode]map[BreakdownKey]float64 `json:"breakdowns,omitempty"` // Score breakdowns for all modes
 	AllReasoning  map[ScoringMode][]string                 `json:"reasonings,omitempty"` // Reasoning for all computed modes
+
+	Normalization *NormalizationScales `json:"-"` // Scales used to normalize metrics (nil = fixed maxima)
+	Contributors  map[string]Metric    `json:"-"` // Commits per contributor, used to aggregate folder ownership
 }
 
 // GetPath returns the file path.
@@ -75,14 +114,22 @@ type FolderResult struct {
 	DecayedCommits     Metric   `json:"decayed_commits"`     // Time-weighted commits across all contained files
 	DecayedChurn       Metric   `json:"decayed_churn"`       // Time-weighted churn across all contained files
 	Score              float64  `json:"score"`               // Computed importance score for the folder
-	ModeType           string   `json:"mode_type"`           // Type of mode: 'base' or 'composite'
+	ModeType           string   `json:"mode_type"`           // Type of mode: 'base', 'composite' or 'expression'
 	Gini               float64  `json:"gini"`                // Gini coefficient of commit distribution in the folder
 	UniqueContributors Metric   `json:"unique_contributors"` // Number of unique contributors in the folder
 	Owners             []string `json:"owners"`              // Top 2 owners by commit count
-
-	TotalLOC         Metric      `json:"total_loc"`          // Sum of LOC of all contained files (used for weighted average)
-	WeightedScoreSum float64     `json:"weighted_score_sum"` // Sum of (FileScore * FileLOC)
-	Mode             ScoringMode `json:"mode"`               // Scoring mode used (hot, risk, complexity, roi)
+	MinorContributors  Metric   `json:"minor_contributors"`  // Contributors with under 5% of the folder's commits
+	TopOwnerShare      float64  `json:"top_owner_share"`     // Share of the folder's commits made by the top owner (0-1)
+	OwnershipEntropy   float64  `json:"ownership_entropy"`   // Shannon entropy of the folder's commits across contributors, in bits
+	TopFile            string   `json:"top_file"`            // File that contributes most to the folder score
+	TopFileScore       float64  `json:"top_file_score"`      // Score of the top contributing file
+
+	TotalLOC         Metric            `json:"total_loc"`          // Sum of LOC of all contained files (used for weighted average)
+	WeightedScoreSum float64           `json:"weighted_score_sum"` // Sum of (FileScore * FileLOC)
+	Mode             ScoringMode       `json:"mode"`               // Scoring mode used (hot, risk, complexity, roi)
+	Aggregation      FolderAggregation `json:"aggregation"`        // Strategy that combined the file scores into Score
+
+	Normalization *NormalizationScales `json:"-"` // Scales used to normalize the contained files (nil = fixed maxima)
 }
 
 // GetPath returns the folder path.
@@ -120,3 +167,11 @@ type CompositeConfig struct {
 	BaseModes    []ScoringMode           `yaml:"base_modes"`
 	BlendWeights map[ScoringMode]float64 `yaml:"blend_weights"`
 }
+
+// ExpressionModeConfig represents a user-defined scoring mode computed from a formula
+// over normalized metrics and raw file fields.
+type ExpressionModeConfig struct {
+	DisplayName string `yaml:"display_name"`
+	Description string `yaml:"description"`
+	Expression  string `yaml:"expression"`
+}
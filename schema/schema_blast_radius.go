@@ -1,5 +1,10 @@
 package schema
 
+import (
+	"fmt"
+	"strings"
+)
+
 // CoupledFile represents a file and its coupling strength to a target.
 type CoupledFile struct {
 	Path     string  `json:"path"`      // Path of the coupled file
@@ -8,23 +13,240 @@ type CoupledFile struct {
 	CoChange int     `json:"co_change"` // Number of times these files changed together
 }
 
+// CouplingRelation says whether a static dependency explains why two files change together.
+type CouplingRelation string
+
+// Relations between the Go files of a coupled pair. Pairs that are not both Go files
+// inside a module have no relation.
+const (
+	RelationSamePackage CouplingRelation = "same_package" // Both files are in one package
+	RelationImport      CouplingRelation = "import"       // One file's package imports the other's
+	RelationHidden      CouplingRelation = "hidden"       // No static dependency between the packages
+)
+
+// IsExplained reports whether a static dependency explains the coupling.
+func (r CouplingRelation) IsExplained() bool {
+	return r == RelationSamePackage || r == RelationImport
+}
+
+// CouplingLevel is the unit whose co-changes blast radius analysis counts.
+type CouplingLevel string
+
+// Coupling levels. A commit counts once for every folder or module it touches.
+const (
+	CouplingLevelFile   CouplingLevel = "file"   // Individual files
+	CouplingLevelFolder CouplingLevel = "folder" // Folders cut to a configurable depth
+	CouplingLevelModule CouplingLevel = "module" // User-defined modules from the config file
+)
+
+// DefaultImpactDepth is the number of association rules an impact set follows from the
+// changed file, so the default includes what changes with its direct partners.
+const DefaultImpactDepth = 2
+
+// DefaultCouplingFolderDepth is the number of leading directories that name a folder
+// at the folder level, so services/api/handler.go belongs to services.
+const DefaultCouplingFolderDepth = 1
+
+// ParseCouplingLevel validates a coupling level, defaulting to the file level.
+func ParseCouplingLevel(s string) (CouplingLevel, error) {
+	switch level := CouplingLevel(strings.ToLower(strings.TrimSpace(s))); level {
+	case "":
+		return CouplingLevelFile, nil
+	case CouplingLevelFile, CouplingLevelFolder, CouplingLevelModule:
+		return level, nil
+	default:
+		return "", fmt.Errorf("invalid level %q: expected file, folder or module", s)
+	}
+}
+
+// CouplingFolder returns the first depth directories of a file's folder, or "" for
+// files in the repository root.
+func CouplingFolder(path string, depth int) string {
+	parts := strings.Split(path, "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	dirs := parts[:len(parts)-1]
+	return strings.Join(dirs[:min(depth, len(dirs))], "/")
+}
+
+// CouplingModule is a named group of paths whose changes count as one unit.
+type CouplingModule struct {
+	Name  string   // Module name shown in the results
+	Paths []string // Path patterns, with the same syntax as excludes
+	Allow []string // Modules it may change together with, for architecture checks
+}
+
+// CouplingModules maps files to the first module whose patterns match them.
+type CouplingModules struct {
+	names    []string
+	matchers []*PathMatcher
+	allowed  map[string]map[string]bool // Allowed module pairs, in both directions
+}
+
+// NewCouplingModules validates module definitions.
+func NewCouplingModules(modules []CouplingModule) (*CouplingModules, error) {
+	m := &CouplingModules{allowed: make(map[string]map[string]bool)}
+	seen := make(map[string]bool, len(modules))
+	for i, module := range modules {
+		name := strings.TrimSpace(module.Name)
+		if name == "" {
+			return nil, fmt.Errorf("module %d has no name", i+1)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("module %q is defined twice", name)
+		}
+		if len(module.Paths) == 0 {
+			return nil, fmt.Errorf("module %q has no paths", name)
+		}
+		seen[name] = true
+		m.names = append(m.names, name)
+		m.matchers = append(m.matchers, NewPathMatcher(module.Paths))
+	}
+	for _, module := range modules {
+		name := strings.TrimSpace(module.Name)
+		for _, other := range module.Allow {
+			other = strings.TrimSpace(other)
+			if !seen[other] {
+				return nil, fmt.Errorf("module %q allows unknown module %q", name, other)
+			}
+			m.allow(name, other)
+			m.allow(other, name)
+		}
+	}
+	return m, nil
+}
+
+func (m *CouplingModules) allow(a, b string) {
+	if m.allowed[a] == nil {
+		m.allowed[a] = make(map[string]bool)
+	}
+	m.allowed[a][b] = true
+}
+
+// Allows reports whether two modules may change together: a module may always change
+// with itself, and two modules may when either lists the other under allow.
+func (m *CouplingModules) Allows(a, b string) bool {
+	return a == b || (m != nil && m.allowed[a][b])
+}
+
+// Len returns the number of modules.
+func (m *CouplingModules) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.names)
+}
+
+// ModuleOf returns the module a file belongs to, or "" if no module matches it.
+func (m *CouplingModules) ModuleOf(path string) string {
+	if m == nil {
+		return ""
+	}
+	for i, matcher := range m.matchers {
+		if matcher.Match(path) {
+			return m.names[i]
+		}
+	}
+	return ""
+}
+
 // BlastRadiusPair represents a pair of files that are logically coupled.
 type BlastRadiusPair struct {
-	Source   string  `json:"source"`    // The "source" file or the center of the radius
-	Target   string  `json:"target"`    // The coupled file
-	Score    float64 `json:"score"`     // Coupling score (Jaccard Index)
-	CoChange int     `json:"co_change"` // Number of times they changed together
+	Source            string           `json:"source"`             // The "source" file or the center of the radius
+	Target            string           `json:"target"`             // The coupled file
+	Score             float64          `json:"score"`              // Coupling score (Jaccard Index)
+	CoChange          int              `json:"co_change"`          // Number of times they changed together
+	Support           float64          `json:"support"`            // Share of analyzed commits that changed both
+	Confidence        float64          `json:"confidence"`         // Share of the source's commits that also changed the target
+	ReverseConfidence float64          `json:"reverse_confidence"` // Share of the target's commits that also changed the source
+	Relation          CouplingRelation `json:"relation,omitempty"` // Static dependency between Go files, if any
+}
+
+// BlastRadiusImpact is a unit that tends to change when the analyzed path changes,
+// reached through a chain of association rules.
+type BlastRadiusImpact struct {
+	Path       string           `json:"path"`               // The impacted file, folder or module
+	Depth      int              `json:"depth"`              // Rules followed from the analyzed path (1 = direct)
+	Via        string           `json:"via"`                // Unit whose changes the last rule starts from
+	CoChange   int              `json:"co_change"`          // Commits that changed both Via and Path
+	Support    float64          `json:"support"`            // Share of analyzed commits that changed both Via and Path
+	Confidence float64          `json:"confidence"`         // P(Path | Via): share of Via's commits that also changed Path
+	Lift       float64          `json:"lift"`               // Confidence divided by the share of commits that changed Path
+	Impact     float64          `json:"impact"`             // Product of the confidences along the chain
+	Relation   CouplingRelation `json:"relation,omitempty"` // Static dependency with the analyzed path, for Go files
 }
 
 // BlastRadiusSummary provides metadata about the analysis.
 type BlastRadiusSummary struct {
-	TotalCommits int     `json:"total_commits"` // Total commits analyzed
-	TotalPairs   int     `json:"total_pairs"`   // Number of highly coupled pairs found
-	Threshold    float64 `json:"threshold"`     // Min coupling score used for filtering
+	TotalCommits   int           `json:"total_commits"`          // Total commits analyzed
+	TotalPairs     int           `json:"total_pairs"`            // Number of highly coupled pairs found
+	Threshold      float64       `json:"threshold"`              // Min coupling score used for filtering
+	ExplainedPairs int           `json:"explained_pairs"`        // Shown Go pairs explained by the import graph
+	HiddenPairs    int           `json:"hidden_pairs"`           // Shown Go pairs with no static dependency
+	HiddenOnly     bool          `json:"hidden_only"`            // Whether only hidden pairs were ranked
+	Level          CouplingLevel `json:"level"`                  // Unit whose co-changes were counted
+	FolderDepth    int           `json:"folder_depth,omitempty"` // Directories that name a folder at the folder level
+	Path           string        `json:"path,omitempty"`         // Unit whose impact set was computed, if any
+	PathCommits    int           `json:"path_commits,omitempty"` // Commits that changed Path
+	ImpactDepth    int           `json:"impact_depth,omitempty"` // Maximum rules followed from Path
 }
 
-// BlastRadiusResult is the top-level response for the get_blast_radius tool.
+// BlastRadiusResult is the top-level response for the get_blast_radius tool. It holds
+// the top coupled pairs, or the impact set of a path when one was given.
 type BlastRadiusResult struct {
-	Summary BlastRadiusSummary `json:"summary"`
-	Pairs   []BlastRadiusPair  `json:"pairs"`
+	Summary BlastRadiusSummary  `json:"summary"`
+	Pairs   []BlastRadiusPair   `json:"pairs"`
+	Impact  []BlastRadiusImpact `json:"impact,omitempty"`
+}
+
+// GraphFormat is the file format of an exported coupling graph.
+type GraphFormat string
+
+// Coupling graph formats.
+const (
+	GraphFormatDOT     GraphFormat = "dot"     // Graphviz
+	GraphFormatGraphML GraphFormat = "graphml" // GraphML, for Gephi and yEd
+	GraphFormatJSON    GraphFormat = "json"    // Nodes and edges as JSON
+)
+
+// ParseGraphFormat validates a coupling graph format. An empty format exports no graph.
+func ParseGraphFormat(s string) (GraphFormat, error) {
+	switch format := GraphFormat(strings.ToLower(strings.TrimSpace(s))); format {
+	case "", GraphFormatDOT, GraphFormatGraphML, GraphFormatJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid graph format %q: expected dot, graphml or json", s)
+	}
+}
+
+// CouplingGraphNode is a file, folder or module of a coupling graph.
+type CouplingGraphNode struct {
+	ID     string   `json:"id"`               // File path, folder or module name
+	Folder string   `json:"folder,omitempty"` // Folder of a file ("." for the root); empty for folders and modules
+	Files  int      `json:"files"`            // Scored files in the unit
+	Score  float64  `json:"score"`            // Hotspot score in the current mode; the top file's score for folders and modules
+	Label  string   `json:"label,omitempty"`  // Criticality label of the score; empty when no file was scored
+	Owners []string `json:"owners,omitempty"` // Top owners of the file, or of the top file of a folder or module
+}
+
+// CouplingGraphEdge connects two coupled units of a coupling graph.
+type CouplingGraphEdge struct {
+	Source     string           `json:"source"`
+	Target     string           `json:"target"`
+	Weight     float64          `json:"weight"`             // Jaccard score of a pair, or confidence of an impact rule
+	CoChange   int              `json:"co_change"`          // Commits that changed both units
+	Support    float64          `json:"support"`            // Share of all commits that changed both units
+	Confidence float64          `json:"confidence"`         // Share of the source's commits that also changed the target
+	Relation   CouplingRelation `json:"relation,omitempty"` // Static dependency between Go files
+}
+
+// CouplingGraph is the coupling of a blast radius analysis as a graph. Pairs give an
+// undirected graph, and an impact set a directed one from the path outward.
+type CouplingGraph struct {
+	Level    CouplingLevel       `json:"level"`
+	Mode     ScoringMode         `json:"mode"`     // Scoring mode of the node scores
+	Directed bool                `json:"directed"` // Whether edges point from source to target
+	Nodes    []CouplingGraphNode `json:"nodes"`
+	Edges    []CouplingGraphEdge `json:"edges"`
 }
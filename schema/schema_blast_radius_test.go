@@ -0,0 +1,78 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCouplingLevel(t *testing.T) {
+	level, err := schema.ParseCouplingLevel("")
+	require.NoError(t, err)
+	assert.Equal(t, schema.CouplingLevelFile, level)
+
+	level, err = schema.ParseCouplingLevel(" Folder ")
+	require.NoError(t, err)
+	assert.Equal(t, schema.CouplingLevelFolder, level)
+
+	_, err = schema.ParseCouplingLevel("package")
+	assert.Error(t, err)
+}
+
+func TestParseGraphFormat(t *testing.T) {
+	format, err := schema.ParseGraphFormat("")
+	require.NoError(t, err)
+	assert.Empty(t, format)
+
+	format, err = schema.ParseGraphFormat("GraphML")
+	require.NoError(t, err)
+	assert.Equal(t, schema.GraphFormatGraphML, format)
+
+	_, err = schema.ParseGraphFormat("png")
+	assert.Error(t, err)
+}
+
+func TestCouplingFolder(t *testing.T) {
+	assert.Equal(t, "services", schema.CouplingFolder("services/api/handler.go", 1))
+	assert.Equal(t, "services/api", schema.CouplingFolder("services/api/handler.go", 2))
+	assert.Equal(t, "services/api", schema.CouplingFolder("services/api/handler.go", 5))
+	assert.Empty(t, schema.CouplingFolder("go.mod", 1))
+}
+
+func TestCouplingModules(t *testing.T) {
+	modules, err := schema.NewCouplingModules([]schema.CouplingModule{
+		{Name: "api", Paths: []string{"services/api/**", "libs/api/**"}},
+		{Name: "services", Paths: []string{"services/**"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, modules.Len())
+	assert.Equal(t, "api", modules.ModuleOf("libs/api/client.go"))
+	assert.Equal(t, "services", modules.ModuleOf("services/billing/invoice.go"))
+	assert.Empty(t, modules.ModuleOf("README.md"))
+
+	assert.False(t, modules.Allows("api", "services"))
+
+	allowing, err := schema.NewCouplingModules([]schema.CouplingModule{
+		{Name: "api", Paths: []string{"api/**"}, Allow: []string{"shared"}},
+		{Name: "shared", Paths: []string{"libs/**"}},
+		{Name: "web", Paths: []string{"web/**"}},
+	})
+	require.NoError(t, err)
+	assert.True(t, allowing.Allows("api", "api"))
+	assert.True(t, allowing.Allows("api", "shared"))
+	assert.True(t, allowing.Allows("shared", "api")) // Either side's rule allows the pair
+	assert.False(t, allowing.Allows("web", "shared"))
+
+	var none *schema.CouplingModules
+	assert.Equal(t, 0, none.Len())
+	assert.Empty(t, none.ModuleOf("services/api/handler.go"))
+
+	_, err = schema.NewCouplingModules([]schema.CouplingModule{{Name: "api"}})
+	assert.ErrorContains(t, err, "no paths")
+	_, err = schema.NewCouplingModules([]schema.CouplingModule{{Name: "a", Paths: []string{"a/**"}, Allow: []string{"b"}}})
+	assert.ErrorContains(t, err, "unknown module")
+	_, err = schema.NewCouplingModules([]schema.CouplingModule{{Name: "a", Paths: []string{"a/**"}}, {Name: "a", Paths: []string{"b/**"}}})
+	assert.ErrorContains(t, err, "defined twice")
+}
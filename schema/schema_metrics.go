@@ -20,9 +20,30 @@ type MetricsRenderModel struct {
 	Title               string                `json:"title"`
 	Description         string                `json:"description"`
 	Modes               []MetricsModeWithData `json:"modes"`
+	Composites          []MetricsComposite    `json:"composites"`
+	CustomModes         []MetricsCustomMode   `json:"custom_modes,omitempty"`
 	SpecialRelationship map[string]string     `json:"special_relationship"`
 }
 
+// MetricsComposite describes a composite mode as a blend of base modes.
+type MetricsComposite struct {
+	Name         string             `json:"name"`
+	DisplayName  string             `json:"display_name"`
+	Description  string             `json:"description"`
+	BaseModes    []string           `json:"base_modes"`
+	BlendWeights map[string]float64 `json:"blend_weights"`
+	Formula      string             `json:"formula"`
+	Custom       bool               `json:"custom"`
+}
+
+// MetricsCustomMode describes a user-defined expression mode.
+type MetricsCustomMode struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+	Expression  string `json:"expression"`
+}
+
 // MetricsModeWithData extends MetricsMode with computed weights and formula.
 type MetricsModeWithData struct {
 	MetricsMode
@@ -59,6 +80,8 @@ func BuildMetricsRenderModel(activeWeights map[ScoringMode]map[BreakdownKey]floa
 		Title:       "Hotspot Scoring Modes",
 		Description: "All scores = weighted sum of normalized factors",
 		Modes:       modesWithData,
+		Composites:  buildMetricsComposites(),
+		CustomModes: buildMetricsCustomModes(),
 		SpecialRelationship: map[string]string{
 			"description": "RISK Score = Weighted balance of Ownership concentration and Staleness",
 			"note":        "(Focuses on Gini Index, Contributor diversity, and Knowledge decay)",
@@ -66,6 +89,58 @@ func BuildMetricsRenderModel(activeWeights map[ScoringMode]map[BreakdownKey]floa
 	}
 }
 
+// buildMetricsComposites describes every registered composite mode, built-in and custom.
+func buildMetricsComposites() []MetricsComposite {
+	var composites []MetricsComposite
+	for _, mode := range CompositeScoringModes() {
+		cfg := GetCompositeConfig(mode)
+		if cfg == nil {
+			continue
+		}
+		// Blend weights are normalized at scoring time, so show the effective shares.
+		var sum float64
+		for _, base := range cfg.BaseModes {
+			sum += cfg.BlendWeights[base]
+		}
+		baseModes := make([]string, 0, len(cfg.BaseModes))
+		weights := make(map[string]float64, len(cfg.BaseModes))
+		for _, base := range cfg.BaseModes {
+			baseModes = append(baseModes, string(base))
+			if sum > 0 {
+				weights[string(base)] = cfg.BlendWeights[base] / sum
+			}
+		}
+		composites = append(composites, MetricsComposite{
+			Name:         string(mode),
+			DisplayName:  cfg.DisplayName,
+			Description:  cfg.Description,
+			BaseModes:    baseModes,
+			BlendWeights: weights,
+			Formula:      FormatWeights(weights, baseModes),
+			Custom:       IsCustomCompositeMode(mode),
+		})
+	}
+	return composites
+}
+
+// buildMetricsCustomModes describes every registered expression mode.
+func buildMetricsCustomModes() []MetricsCustomMode {
+	var modes []MetricsCustomMode
+	for _, mode := range ExpressionScoringModes() {
+		cfg := GetExpressionModeConfig(mode)
+		if cfg == nil {
+			continue
+		}
+		modes = append(modes, MetricsCustomMode{
+			Name:        string(mode),
+			DisplayName: cfg.DisplayName,
+			Description: cfg.Description,
+			Expression:  cfg.Expression,
+		})
+	}
+	return modes
+}
+
 // GetDisplayNameForMode returns the display name for a given mode name.
 func GetDisplayNameForMode(modeName string) string {
 	switch modeName {
@@ -23,3 +23,23 @@ func TestBuildMetricsRenderModel_UsesBaseModesOnly(t *testing.T) {
 	assert.NotContains(t, names, string(RefactorNowMode))
 	assert.NotContains(t, names, string(LegacyDebtMode))
 }
+
+func TestBuildMetricsRenderModel_IncludesComposites(t *testing.T) {
+	t.Cleanup(func() { _ = RegisterCustomComposites(nil) })
+	assert.NoError(t, RegisterCustomComposites(map[ScoringMode]*CompositeConfig{
+		"churny_silos": {
+			BaseModes:    []ScoringMode{HotMode, RiskMode},
+			BlendWeights: map[ScoringMode]float64{HotMode: 3, RiskMode: 1},
+		},
+	}))
+
+	model := BuildMetricsRenderModel(nil)
+	assert.Len(t, model.Composites, 4)
+
+	custom := model.Composites[3]
+	assert.Equal(t, "churny_silos", custom.Name)
+	assert.True(t, custom.Custom)
+	assert.Equal(t, []string{"hot", "risk"}, custom.BaseModes)
+	assert.Equal(t, "0.75*hot+0.25*risk", custom.Formula)
+	assert.False(t, model.Composites[0].Custom)
+}
@@ -57,6 +57,14 @@ func EnrichFolders(folders []FolderResult) []EnrichedFolderResult {
 	return output
 }
 
+// FolderTreeNode is a folder of a hierarchical roll-up together with its subfolders.
+type FolderTreeNode struct {
+	Label string `json:"label"`
+	Depth int    `json:"depth"` // Directories below the repository root (0 for the root)
+	FolderResult
+	Children []FolderTreeNode `json:"children,omitempty"` // Subfolders by descending score
+}
+
 // Metadata contains runtime information about the analysis.
 type Metadata struct {
 	AnalysisDuration time.Duration `json:"analysis_duration_ns"`
@@ -64,6 +72,8 @@ type Metadata struct {
 	Workers          int           `json:"workers"`
 	CacheBackend     string        `json:"cache_backend"`
 	Timestamp        time.Time     `json:"timestamp"`
+
+	Normalization *NormalizationScales `json:"normalization,omitempty"` // Scales used to normalize scores
 }
 
 // FileResultsOutput is the standard container for file analysis results.
@@ -78,6 +88,12 @@ type FolderResultsOutput struct {
 	Metadata Metadata               `json:"metadata"`
 }
 
+// FolderTreeOutput is the standard container for hierarchical folder analysis results.
+type FolderTreeOutput struct {
+	Results  FolderTreeNode `json:"results"`
+	Metadata Metadata       `json:"metadata"`
+}
+
 // ComparisonResultsOutput is the standard container for comparison analysis results.
 type ComparisonResultsOutput struct {
 	Results  ComparisonResult `json:"results"`
@@ -102,6 +118,12 @@ type BlastRadiusResultsOutput struct {
 	Metadata Metadata          `json:"metadata"`
 }
 
+// FunctionsResultsOutput is the standard container for function analysis results.
+type FunctionsResultsOutput struct {
+	Results  FunctionsResult `json:"results"`
+	Metadata Metadata        `json:"metadata"`
+}
+
 // JourneyResultsOutput is the standard container for release journey analysis results.
 type JourneyResultsOutput struct {
 	Results  JourneyResult `json:"results"`
@@ -136,3 +158,29 @@ func BuildMetadata(runtime RuntimeSettings, duration time.Duration) Metadata {
 		Timestamp:        time.Now().UTC(),
 	}
 }
+
+// WithNormalization returns a copy of the metadata that records the given normalization scales.
+func (m Metadata) WithNormalization(scales *NormalizationScales) Metadata {
+	m.Normalization = scales
+	return m
+}
+
+// FileNormalization returns the normalization scales shared by the file results, if any.
+func FileNormalization(results []FileResult) *NormalizationScales {
+	for i := range results {
+		if results[i].Normalization != nil {
+			return results[i].Normalization
+		}
+	}
+	return nil
+}
+
+// FolderNormalization returns the normalization scales shared by the folder results, if any.
+func FolderNormalization(results []FolderResult) *NormalizationScales {
+	for i := range results {
+		if results[i].Normalization != nil {
+			return results[i].Normalization
+		}
+	}
+	return nil
+}
@@ -15,35 +15,40 @@ const (
 // Preset is a named collection of recommended configuration defaults derived from
 // the example configuration files in examples/cli/.
 type Preset struct {
-	Name                 PresetName  `json:"name"`
-	Description          string      `json:"description"`
-	Mode                 ScoringMode `json:"mode"`
-	Limit                int         `json:"limit"`
-	Workers              int         `json:"workers"`
-	Follow               bool        `json:"follow"`
-	Detail               bool        `json:"detail"`
-	Start                string      `json:"start,omitempty"` // relative time string, e.g. "2 years ago"
-	Transitions          int         `json:"transitions"`     // Suggested value for get_release_journey
-	RecencyThresholdLow  float64     `json:"recency_threshold_low"`
-	RecencyThresholdHigh float64     `json:"recency_threshold_high"`
-	Exclude              string      `json:"exclude,omitempty"`
-	Output               OutputMode  `json:"output,omitempty"`
-	Color                bool        `json:"color,omitempty"`
-	Owner                bool        `json:"owner,omitempty"`
-	Precision            int         `json:"precision,omitempty"`
-	Explain              bool        `json:"explain,omitempty"`
+	Name                 PresetName  `json:"name" yaml:"name"`
+	Description          string      `json:"description" yaml:"description"`
+	Mode                 ScoringMode `json:"mode" yaml:"mode"`
+	Limit                int         `json:"limit" yaml:"limit"`
+	Workers              int         `json:"workers" yaml:"workers"`
+	Follow               bool        `json:"follow" yaml:"follow"`
+	Detail               bool        `json:"detail" yaml:"detail"`
+	Start                string      `json:"start,omitempty" yaml:"start"`   // relative time string, e.g. "2 years ago"
+	Transitions          int         `json:"transitions" yaml:"transitions"` // Suggested value for get_release_journey
+	RecencyThresholdLow  float64     `json:"recency_threshold_low" yaml:"recency_threshold_low"`
+	RecencyThresholdHigh float64     `json:"recency_threshold_high" yaml:"recency_threshold_high"`
+	RecentWindowDays     int         `json:"recent_window_days,omitempty" yaml:"recent_window_days"`
+	DecayHalfLifeDays    float64     `json:"decay_half_life_days,omitempty" yaml:"decay_half_life_days"`
+	DecayKernel          DecayKernel `json:"decay_kernel,omitempty" yaml:"decay_kernel"`
+	Exclude              string      `json:"exclude,omitempty" yaml:"exclude"`
+	Output               OutputMode  `json:"output,omitempty" yaml:"output"`
+	Color                bool        `json:"color,omitempty" yaml:"color"`
+	Owner                bool        `json:"owner,omitempty" yaml:"owner"`
+	Precision            int         `json:"precision,omitempty" yaml:"precision"`
+	Explain              bool        `json:"explain,omitempty" yaml:"explain"`
 }
 
 // RepoShape captures key metrics from the first aggregation pass to characterize a repository.
 type RepoShape struct {
-	URN                string     `json:"urn,omitempty"`
-	FileCount          int        `json:"file_count"`
-	TotalCommits       float64    `json:"total_commits"`
-	UniqueContributors int        `json:"unique_contributors"`
-	AvgChurnPerFile    float64    `json:"avg_churn_per_file"`
-	IaCFileRatio       float64    `json:"iac_file_ratio"`
-	RecommendedPreset  PresetName `json:"recommended_preset"`
-	Reasoning          []string   `json:"reasoning,omitempty"`
-	Preset             Preset     `json:"preset"`
-	AnalyzedAt         time.Time  `json:"analyzed_at"`
+	URN                string        `json:"urn,omitempty"`
+	FileCount          int           `json:"file_count"`
+	TotalCommits       float64       `json:"total_commits"`
+	UniqueContributors int           `json:"unique_contributors"`
+	AvgChurnPerFile    float64       `json:"avg_churn_per_file"`
+	IaCFileRatio       float64       `json:"iac_file_ratio"`
+	RecommendedPreset  PresetName    `json:"recommended_preset"`
+	Reasoning          []string      `json:"reasoning,omitempty"`
+	AuthorFocus        []AuthorFocus `json:"author_focus,omitempty"` // Most active authors and how focused their commits are
+	WorkHeatmap        *WorkHeatmap  `json:"work_heatmap,omitempty"` // Commits by local weekday (Sunday first) and hour
+	Preset             Preset        `json:"preset"`
+	AnalyzedAt         time.Time     `json:"analyzed_at"`
 }
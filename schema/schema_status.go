@@ -44,6 +44,9 @@ type FileScoresMetricsRecord struct {
 	RecentContributorCount Metric
 	AgeDays                Metric
 	GiniCoefficient        float64
+	MinorContributorCount  Metric
+	TopOwnerShare          float64
+	OwnershipEntropy       float64
 	FileOwner              *string
 	ScoreHot               float64
 	ScoreRisk              float64
@@ -54,4 +57,5 @@ type FileScoresMetricsRecord struct {
 	RecencySignal          float64
 	RecencyThresholdLow    float64
 	RecencyThresholdHigh   float64
+	CustomScores           map[ScoringMode]float64
 }
@@ -13,6 +13,34 @@ type FileAggregation struct {
 	FirstCommit    time.Time
 	Contributors   map[string]Metric // Author name -> commit count
 
+	// Fix Activity (commits whose subject is classified as a fix)
+	FixCommits Metric
+	FixChurn   Metric
+
+	// Instability (reverts and lines rewritten soon after being added)
+	Reverts     Metric
+	FirstRevert time.Time
+	ReworkLines Metric
+
+	// Change Entropy (the file's share of how scattered each period's changes were)
+	ChangeEntropy float64
+
+	// Commit Size (how many files and lines the commits that touched this file changed)
+	MedianCommitFiles Metric
+	MedianCommitChurn Metric
+	LargeCommits      Metric // Commits that changed at least LargeCommitFiles files
+
+	// Work Patterns (commits made outside working hours or on weekends, in the author's local time)
+	OffHoursCommits Metric
+	WeekendCommits  Metric
+
+	// Test Co-evolution (how often a production file's commits also changed its tests)
+	TestStatus    string // TestStatusPaired, TestStatusOrphan, TestStatusTest or "" if no rule covers the file
+	TestCoChanges Metric // Commits that also changed a paired test
+
+	// Ticket Activity (issue tracker keys found in commit subjects)
+	Tickets map[string]Metric // Ticket key -> commit count
+
 	// Recent Activity (Fixed window, e.g. 30 days)
 	RecentCommits      Metric
 	RecentChurn        Metric
@@ -21,10 +49,96 @@ type FileAggregation struct {
 	RecentContributors map[string]Metric
 }
 
+// FixRatio returns the share of commits classified as fixes.
+func (a *FileAggregation) FixRatio() float64 {
+	if a == nil || a.Commits == 0 {
+		return 0
+	}
+	return a.FixCommits.Float64() / a.Commits.Float64()
+}
+
+// ReworkRatio returns the share of added lines that were deleted again within the rework window.
+func (a *FileAggregation) ReworkRatio() float64 {
+	if a == nil || a.LinesAdded == 0 {
+		return 0
+	}
+	return min(1, a.ReworkLines.Float64()/a.LinesAdded.Float64())
+}
+
+// LargeCommitShare returns the share of commits that changed at least LargeCommitFiles files.
+func (a *FileAggregation) LargeCommitShare() float64 {
+	if a == nil || a.Commits == 0 {
+		return 0
+	}
+	return min(1, a.LargeCommits.Float64()/a.Commits.Float64())
+}
+
+// OffHoursRatio returns the share of commits made outside working hours.
+func (a *FileAggregation) OffHoursRatio() float64 {
+	if a == nil || a.Commits == 0 {
+		return 0
+	}
+	return a.OffHoursCommits.Float64() / a.Commits.Float64()
+}
+
+// WeekendRatio returns the share of commits made on a weekend.
+func (a *FileAggregation) WeekendRatio() float64 {
+	if a == nil || a.Commits == 0 {
+		return 0
+	}
+	return a.WeekendCommits.Float64() / a.Commits.Float64()
+}
+
+// TestCoChangeRatio returns the share of commits that also changed a paired test.
+func (a *FileAggregation) TestCoChangeRatio() float64 {
+	if a == nil || a.Commits == 0 {
+		return 0
+	}
+	return a.TestCoChanges.Float64() / a.Commits.Float64()
+}
+
+// LargeCommitFiles is the number of files from which a commit counts as large.
+const LargeCommitFiles = 20
+
+// AuthorFocus describes how focused an author's commits are.
+type AuthorFocus struct {
+	Author           string  `json:"author"`
+	Commits          int     `json:"commits"`            // Commits that changed at least one file
+	MedianFiles      float64 `json:"median_files"`       // Median number of files changed per commit
+	LargeCommitShare float64 `json:"large_commit_share"` // Share of commits that changed at least LargeCommitFiles files
+	Focus            float64 `json:"focus"`              // Mean of 1/files over all commits (1 = every commit changed one file)
+}
+
+// EntropyPeriod holds the change entropy of one period of the analysis window.
+type EntropyPeriod struct {
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Commits int       `json:"commits"` // Commits with at least one tracked file
+	Files   int       `json:"files"`   // Distinct files changed
+	Entropy float64   `json:"entropy"` // Shannon entropy of the changes across files, normalized to 0-1
+}
+
 // AggregateOutput is the aggregation of all things from the one-pass Git operation.
 type AggregateOutput struct {
-	FileStats map[string]*FileAggregation
-	EndTime   time.Time // The end time of the analysis window (reference for decay)
+	FileStats      map[string]*FileAggregation
+	EndTime        time.Time              // The end time of the analysis window (reference for decay)
+	Temporal       TemporalModel          // Recent window and decay model used for the aggregation
+	EntropyPeriods []EntropyPeriod        // Change entropy per period, newest first
+	AuthorFocus    map[string]AuthorFocus // Commit size focus per author
+	WorkHeatmap    *WorkHeatmap           // Commits by local weekday and hour (nil = not collected)
+	DocDrift       map[string]DocDrift    // Documentation drift per folder
+}
+
+// ChangeEntropy returns the mean entropy of the periods that had changes, or 0 if none did.
+func (o *AggregateOutput) ChangeEntropy() float64 {
+	if o == nil || len(o.EntropyPeriods) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, p := range o.EntropyPeriods {
+		sum += p.Entropy
+	}
+	return sum / float64(len(o.EntropyPeriods))
 }
 
 // FileMetrics represents raw git metrics for a single file.
@@ -45,6 +159,9 @@ type FileMetrics struct {
 	RecentContributorCount Metric
 	AgeDays                Metric
 	GiniCoefficient        float64
+	MinorContributorCount  Metric
+	TopOwnerShare          float64
+	OwnershipEntropy       float64
 	FileOwner              string
 	RecencySignal          float64
 	RecencyThresholdLow    float64
@@ -60,6 +177,9 @@ type FileScores struct {
 	ROIScore        float64  // roi mode score
 	ScoreLabel      string   // current mode name
 	Reasoning       []string // justifications for the current score
+
+	// CustomScores holds scores for modes without a dedicated column (composites and custom modes).
+	CustomScores map[ScoringMode]float64
 }
 
 // BatchFileResult groups all data for a single file to be stored in the analysis store.
@@ -71,13 +191,14 @@ type BatchFileResult struct {
 
 // AnalysisRunRecord represents a row from the hotspot_analysis_runs table.
 type AnalysisRunRecord struct {
-	AnalysisID         int64
-	URN                string
-	StartTime          time.Time
-	EndTime            *time.Time
-	RunDurationMs      *int32
-	TotalFilesAnalyzed *int32
-	ConfigParams       *string
+	AnalysisID          int64
+	URN                 string
+	StartTime           time.Time
+	EndTime             *time.Time
+	RunDurationMs       *int32
+	TotalFilesAnalyzed  *int32
+	ConfigParams        *string
+	NormalizationScales *string
 }
 
 // AnalysisQueryFilter provides filtering and pagination for analysis queries.
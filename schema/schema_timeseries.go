@@ -4,14 +4,15 @@ import "time"
 
 // TimeseriesPoint represents a single data point in the timeseries.
 type TimeseriesPoint struct {
-	Period   string        `json:"period"`
-	Start    time.Time     `json:"start"`
-	End      time.Time     `json:"end"`
-	Score    float64       `json:"score"`
-	Path     string        `json:"path"`
-	Owners   []string      `json:"owners"`   // Top owners for this time period
-	Mode     ScoringMode   `json:"mode"`     // Scoring mode (hot, risk, complexity, roi)
-	Lookback time.Duration `json:"lookback"` // Dynamic lookback duration for this point
+	Period        string        `json:"period"`
+	Start         time.Time     `json:"start"`
+	End           time.Time     `json:"end"`
+	Score         float64       `json:"score"`
+	Path          string        `json:"path"`
+	Owners        []string      `json:"owners"`         // Top owners for this time period
+	Mode          ScoringMode   `json:"mode"`           // Scoring mode (hot, risk, complexity, roi)
+	Lookback      time.Duration `json:"lookback"`       // Dynamic lookback duration for this point
+	ChangeEntropy float64       `json:"change_entropy"` // Repository-wide mean change entropy for this window (0-1)
 }
 
 // TimeseriesResult holds the timeseries data points.
@@ -0,0 +1,146 @@
+package schema
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+)
+
+// CommentSyntax describes how a language writes comments and how wide one level of
+// indentation usually is.
+type CommentSyntax struct {
+	Line        []string // Markers that start a comment running to the end of the line
+	BlockStart  string   // Marker that opens a block comment (empty = none)
+	BlockEnd    string   // Marker that closes a block comment
+	IndentWidth int      // Spaces per indentation level (0 = DefaultIndentWidth)
+}
+
+// DefaultIndentWidth is the number of spaces per indentation level when a language
+// does not say otherwise. A tab always counts as one level.
+const DefaultIndentWidth = 4
+
+var (
+	cStyle    = CommentSyntax{Line: []string{"//"}, BlockStart: "/*", BlockEnd: "*/"}
+	cStyle2   = CommentSyntax{Line: []string{"//"}, BlockStart: "/*", BlockEnd: "*/", IndentWidth: 2}
+	hashStyle = CommentSyntax{Line: []string{"#"}}
+	markup    = CommentSyntax{BlockStart: "<!--", BlockEnd: "-->", IndentWidth: 2}
+)
+
+// commentSyntaxes maps lowercase file extensions to their comment syntax.
+var commentSyntaxes = map[string]CommentSyntax{
+	".go": cStyle, ".c": cStyle, ".h": cStyle, ".cc": cStyle, ".cpp": cStyle, ".hpp": cStyle,
+	".cs": cStyle, ".java": cStyle, ".kt": cStyle, ".kts": cStyle, ".scala": cStyle,
+	".swift": cStyle, ".rs": cStyle, ".groovy": cStyle, ".proto": cStyle,
+	".js": cStyle2, ".jsx": cStyle2, ".mjs": cStyle2, ".cjs": cStyle2, ".ts": cStyle2, ".tsx": cStyle2,
+	".dart": cStyle2, ".scss": cStyle2,
+	".css": {BlockStart: "/*", BlockEnd: "*/", IndentWidth: 2},
+	".php": {Line: []string{"//", "#"}, BlockStart: "/*", BlockEnd: "*/"},
+	".py":  {Line: []string{"#"}, BlockStart: `"""`, BlockEnd: `"""`},
+	".rb":  {Line: []string{"#"}, BlockStart: "=begin", BlockEnd: "=end", IndentWidth: 2},
+	".ex":  {Line: []string{"#"}, IndentWidth: 2},
+	".exs": {Line: []string{"#"}, IndentWidth: 2},
+	".sh":  hashStyle, ".bash": hashStyle, ".zsh": hashStyle, ".pl": hashStyle, ".r": hashStyle,
+	".tf":   {Line: []string{"#", "//"}, BlockStart: "/*", BlockEnd: "*/", IndentWidth: 2},
+	".toml": hashStyle, ".cmake": hashStyle,
+	".yaml": {Line: []string{"#"}, IndentWidth: 2},
+	".yml":  {Line: []string{"#"}, IndentWidth: 2},
+	".sql":  {Line: []string{"--"}, BlockStart: "/*", BlockEnd: "*/"},
+	".lua":  {Line: []string{"--"}, BlockStart: "--[[", BlockEnd: "]]", IndentWidth: 2},
+	".hs":   {Line: []string{"--"}, BlockStart: "{-", BlockEnd: "-}", IndentWidth: 2},
+	".erl":  {Line: []string{"%"}},
+	".clj":  {Line: []string{";"}, IndentWidth: 2},
+	".html": markup, ".xml": markup, ".vue": markup, ".svelte": markup,
+}
+
+// CommentSyntaxFor returns the comment syntax of a file, and whether its language is known.
+func CommentSyntaxFor(path string) (CommentSyntax, bool) {
+	syntax, ok := commentSyntaxes[strings.ToLower(filepath.Ext(path))]
+	return syntax, ok
+}
+
+// SourceStats classifies the lines of a source file.
+type SourceStats struct {
+	Lines        int // Physical lines
+	SLOC         int // Lines with code
+	CommentLines int // Lines that only hold comments
+	BlankLines   int // Empty or whitespace-only lines
+	IndentTotal  int // Sum of the indentation depth of every code line
+	IndentMax    int // Deepest indentation of any code line
+}
+
+// CommentRatio returns the share of non-blank lines that are comments.
+func (s SourceStats) CommentRatio() float64 {
+	if s.SLOC+s.CommentLines == 0 {
+		return 0
+	}
+	return float64(s.CommentLines) / float64(s.SLOC+s.CommentLines)
+}
+
+// ClassifySource counts the code, comment and blank lines of content and measures the
+// indentation of its code lines, a language-neutral proxy for nesting complexity.
+// Languages without a known comment syntax have no comment lines.
+func ClassifySource(path string, content []byte) SourceStats {
+	syntax, _ := CommentSyntaxFor(path)
+	width := syntax.IndentWidth
+	if width <= 0 {
+		width = DefaultIndentWidth
+	}
+
+	var stats SourceStats
+	inBlock := false
+	// Lines are split by hand rather than with bufio.Scanner, which stops at the
+	// first line longer than its buffer (minified or generated files)
+	for rest := content; len(rest) > 0; {
+		var raw []byte
+		raw, rest, _ = bytes.Cut(rest, []byte("\n"))
+		line := string(bytes.TrimSuffix(raw, []byte("\r")))
+		stats.Lines++
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case inBlock:
+			stats.CommentLines++
+			inBlock = !strings.Contains(trimmed, syntax.BlockEnd)
+		case trimmed == "":
+			stats.BlankLines++
+		case syntax.BlockStart != "" && strings.HasPrefix(trimmed, syntax.BlockStart):
+			stats.CommentLines++
+			inBlock = !strings.Contains(trimmed[len(syntax.BlockStart):], syntax.BlockEnd)
+		case hasAnyPrefix(trimmed, syntax.Line):
+			stats.CommentLines++
+		default:
+			stats.SLOC++
+			depth := indentDepth(line, width)
+			stats.IndentTotal += depth
+			stats.IndentMax = max(stats.IndentMax, depth)
+		}
+	}
+	return stats
+}
+
+// hasAnyPrefix reports whether s starts with any of the prefixes.
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// indentDepth returns the logical indentation of a line: one level per tab and one per
+// width spaces.
+func indentDepth(line string, width int) int {
+	tabs, spaces := 0, 0
+	for _, r := range line {
+		switch r {
+		case '\t':
+			tabs++
+		case ' ':
+			spaces++
+		default:
+			return tabs + spaces/width
+		}
+	}
+	return tabs + spaces/width
+}
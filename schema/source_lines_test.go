@@ -0,0 +1,85 @@
+package schema_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifySource(t *testing.T) {
+	goSrc := []byte(`// Package demo shows the classifier.
+package demo
+
+/*
+Block comments count as comments
+until they close.
+*/
+func Demo(xs []int) int {
+	total := 0
+	for _, x := range xs {
+		if x > 0 {
+			total += x // Trailing comments keep the line as code
+		}
+	}
+
+	return total
+}
+`)
+	stats := schema.ClassifySource("demo.go", goSrc)
+	assert.Equal(t, 17, stats.Lines)
+	assert.Equal(t, 10, stats.SLOC)
+	assert.Equal(t, 5, stats.CommentLines)
+	assert.Equal(t, 2, stats.BlankLines)
+	assert.Equal(t, 3, stats.IndentMax)
+	assert.Equal(t, 1+1+2+3+2+1+1, stats.IndentTotal)
+	assert.InDelta(t, 5.0/15.0, stats.CommentRatio(), 1e-9)
+
+	pySrc := []byte(`"""Module docstring."""
+# A comment
+def f(x):
+    """
+    Multi-line docstring.
+    """
+    if x:
+        return 1
+    return 0
+`)
+	stats = schema.ClassifySource("lib/f.py", pySrc)
+	assert.Equal(t, 4, stats.SLOC)
+	assert.Equal(t, 5, stats.CommentLines)
+	assert.Equal(t, 2, stats.IndentMax)
+	assert.Equal(t, 0+1+2+1, stats.IndentTotal)
+
+	// JavaScript indents two spaces per level
+	stats = schema.ClassifySource("app.js", []byte("function f() {\n  if (x) {\n    return 1;\n  }\n}\n"))
+	assert.Equal(t, 2, stats.IndentMax)
+
+	// Lua block comments start with its line comment marker
+	stats = schema.ClassifySource("init.lua", []byte("--[[\nlocal x = 1\n]]\nlocal y = 2\n"))
+	assert.Equal(t, 1, stats.SLOC)
+	assert.Equal(t, 3, stats.CommentLines)
+
+	// Unknown languages have no comments
+	stats = schema.ClassifySource("notes.txt", []byte("# not a comment\n\n// neither\n"))
+	assert.Equal(t, 2, stats.SLOC)
+	assert.Zero(t, stats.CommentLines)
+	assert.Zero(t, schema.SourceStats{}.CommentRatio())
+
+	// CRLF endings and a missing final newline split like bufio.ScanLines
+	stats = schema.ClassifySource("app.js", []byte("// c\r\nvar x = 1;\r\n\r\nvar y = 2;"))
+	assert.Equal(t, 4, stats.Lines)
+	assert.Equal(t, 2, stats.SLOC)
+	assert.Equal(t, 1, stats.CommentLines)
+	assert.Equal(t, 1, stats.BlankLines)
+}
+
+func TestClassifySource_LongLines(t *testing.T) {
+	// Minified files can have lines of several MiB; the lines after them still count
+	long := "var x = \"" + strings.Repeat("a", 2*1024*1024) + "\";"
+	stats := schema.ClassifySource("bundle.js", []byte(long+"\n// tail\nvar y = 1;\n"))
+	assert.Equal(t, 3, stats.Lines)
+	assert.Equal(t, 2, stats.SLOC)
+	assert.Equal(t, 1, stats.CommentLines)
+}
@@ -0,0 +1,118 @@
+package schema
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Test co-evolution status of a file.
+const (
+	TestStatusPaired = "paired" // Production file with at least one existing test
+	TestStatusOrphan = "orphan" // Production file without any existing test
+	TestStatusTest   = "test"   // Test file
+)
+
+// TestPairingRule pairs production files with their tests. Source and Test each contain
+// exactly one '*', which stands for the same text in both and may span directories.
+type TestPairingRule struct {
+	Source string `json:"source" yaml:"source"`
+	Test   string `json:"test" yaml:"test"`
+}
+
+// DefaultTestPairingRules cover Go, TypeScript and JavaScript test files next to their
+// sources, and Maven-style src/main <-> src/test mirroring.
+var DefaultTestPairingRules = []TestPairingRule{
+	{Source: "*.go", Test: "*_test.go"},
+	{Source: "*.ts", Test: "*.test.ts"},
+	{Source: "*.ts", Test: "*.spec.ts"},
+	{Source: "*.tsx", Test: "*.test.tsx"},
+	{Source: "*.js", Test: "*.test.js"},
+	{Source: "*.js", Test: "*.spec.js"},
+	{Source: "src/main/*.java", Test: "src/test/*Test.java"},
+	{Source: "src/main/*.kt", Test: "src/test/*Test.kt"},
+	{Source: "src/main/*", Test: "src/test/*"},
+}
+
+// TestPairing decides which files are tests and which tests belong to a production file.
+type TestPairing struct {
+	Rules []TestPairingRule
+}
+
+// NewTestPairing validates the pairing rules.
+func NewTestPairing(rules []TestPairingRule) (*TestPairing, error) {
+	for _, rule := range rules {
+		if strings.Count(rule.Source, "*") != 1 || strings.Count(rule.Test, "*") != 1 {
+			return nil, fmt.Errorf("test pairing rule %q -> %q must have exactly one '*' on each side", rule.Source, rule.Test)
+		}
+	}
+	return &TestPairing{Rules: slices.Clone(rules)}, nil
+}
+
+// DefaultTestPairing returns the pairing used when no rules are configured.
+func DefaultTestPairing() *TestPairing {
+	p, err := NewTestPairing(DefaultTestPairingRules)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// IsTest reports whether path matches the test side of any rule.
+func (p *TestPairing) IsTest(path string) bool {
+	if p == nil {
+		return false
+	}
+	for _, rule := range p.Rules {
+		if _, ok := matchWildcard(rule.Test, path); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// TestCandidates returns the test paths that would pair with a production file, and
+// whether any rule covers the file at all. Test files are never production files.
+func (p *TestPairing) TestCandidates(path string) ([]string, bool) {
+	if p == nil || p.IsTest(path) {
+		return nil, false
+	}
+	var candidates []string
+	covered := false
+	for _, rule := range p.Rules {
+		stem, ok := matchWildcard(rule.Source, path)
+		if !ok {
+			continue
+		}
+		covered = true
+		test := strings.Replace(rule.Test, "*", stem, 1)
+		if !slices.Contains(candidates, test) {
+			candidates = append(candidates, test)
+		}
+	}
+	return candidates, covered
+}
+
+// Signature returns a stable description of the rules, used to key cached aggregations.
+func (p *TestPairing) Signature() string {
+	if p == nil {
+		return ""
+	}
+	parts := make([]string, len(p.Rules))
+	for i, rule := range p.Rules {
+		parts[i] = rule.Source + "=>" + rule.Test
+	}
+	return strings.Join(parts, "|")
+}
+
+// matchWildcard matches path against a pattern with one '*' and returns the text it stands for.
+func matchWildcard(pattern, path string) (string, bool) {
+	prefix, suffix, found := strings.Cut(pattern, "*")
+	if !found {
+		return "", false
+	}
+	if len(path) <= len(prefix)+len(suffix) || !strings.HasPrefix(path, prefix) || !strings.HasSuffix(path, suffix) {
+		return "", false
+	}
+	return path[len(prefix) : len(path)-len(suffix)], true
+}
@@ -0,0 +1,57 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTestPairing_Defaults(t *testing.T) {
+	pairing := schema.DefaultTestPairing()
+
+	assert.True(t, pairing.IsTest("core/agg/agg_test.go"))
+	assert.True(t, pairing.IsTest("web/src/app.spec.ts"))
+	assert.True(t, pairing.IsTest("src/test/java/com/acme/FooTest.java"))
+	assert.False(t, pairing.IsTest("core/agg/agg.go"))
+
+	candidates, covered := pairing.TestCandidates("core/agg/agg.go")
+	assert.True(t, covered)
+	assert.Equal(t, []string{"core/agg/agg_test.go"}, candidates)
+
+	candidates, covered = pairing.TestCandidates("web/src/app.ts")
+	assert.True(t, covered)
+	assert.Equal(t, []string{"web/src/app.test.ts", "web/src/app.spec.ts"}, candidates)
+
+	// Maven-style sources pair with both the Test-suffixed and the mirrored file
+	candidates, covered = pairing.TestCandidates("src/main/java/com/acme/Foo.java")
+	assert.True(t, covered)
+	assert.Equal(t, []string{"src/test/java/com/acme/FooTest.java", "src/test/java/com/acme/Foo.java"}, candidates)
+
+	// Tests and files no rule covers are not production files
+	_, covered = pairing.TestCandidates("core/agg/agg_test.go")
+	assert.False(t, covered)
+	_, covered = pairing.TestCandidates("README.md")
+	assert.False(t, covered)
+}
+
+func TestTestPairing_Custom(t *testing.T) {
+	pairing, err := schema.NewTestPairing([]schema.TestPairingRule{{Source: "lib/*.py", Test: "tests/test_*.py"}})
+	require.NoError(t, err)
+	assert.Equal(t, "lib/*.py=>tests/test_*.py", pairing.Signature())
+
+	candidates, covered := pairing.TestCandidates("lib/billing.py")
+	assert.True(t, covered)
+	assert.Equal(t, []string{"tests/test_billing.py"}, candidates)
+	assert.True(t, pairing.IsTest("tests/test_billing.py"))
+
+	_, err = schema.NewTestPairing([]schema.TestPairingRule{{Source: "*.go", Test: "*_test.*"}})
+	assert.Error(t, err)
+	_, err = schema.NewTestPairing([]schema.TestPairingRule{{Source: "main.go", Test: "main_test.go"}})
+	assert.Error(t, err)
+
+	var none *schema.TestPairing
+	assert.False(t, none.IsTest("a_test.go"))
+	assert.Empty(t, none.Signature())
+}
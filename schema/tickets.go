@@ -0,0 +1,151 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DefaultTicketPatterns match JIRA-style keys (PAY-1234) and GitHub references (#567).
+var DefaultTicketPatterns = []string{`\b[A-Z][A-Z0-9]+-[0-9]+\b`, `#[0-9]+\b`}
+
+// TicketExtractor finds issue tracker keys in commit subjects.
+// If a pattern has a capture group, the first group is used as the key.
+type TicketExtractor struct {
+	Patterns []string
+
+	regexes []*regexp.Regexp
+}
+
+// NewTicketExtractor validates and compiles the ticket patterns.
+func NewTicketExtractor(patterns []string) (*TicketExtractor, error) {
+	e := &TicketExtractor{}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ticket pattern %q: %w", pattern, err)
+		}
+		e.Patterns = append(e.Patterns, pattern)
+		e.regexes = append(e.regexes, re)
+	}
+	return e, nil
+}
+
+// DefaultTicketExtractor returns the extractor used when no ticket patterns are configured.
+func DefaultTicketExtractor() *TicketExtractor {
+	e, err := NewTicketExtractor(DefaultTicketPatterns)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Extract returns the distinct ticket keys in the subject, in order of appearance.
+func (e *TicketExtractor) Extract(subject string) []string {
+	if e == nil || subject == "" {
+		return nil
+	}
+	var keys []string
+	for _, re := range e.regexes {
+		for _, m := range re.FindAllStringSubmatch(subject, -1) {
+			key := m[0]
+			if len(m) > 1 && m[1] != "" {
+				key = m[1]
+			}
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// ExtractMessage returns the distinct ticket keys referenced by a commit subject or body,
+// subject keys first.
+func (e *TicketExtractor) ExtractMessage(subject, body string) []string {
+	if body == "" {
+		return e.Extract(subject)
+	}
+	return e.Extract(subject + "\n" + body)
+}
+
+// Signature returns a stable description of the patterns, used to key cached aggregations.
+func (e *TicketExtractor) Signature() string {
+	if e == nil {
+		return ""
+	}
+	return strings.Join(e.Patterns, "|")
+}
+
+// TicketPrefix returns the project part of a ticket key: "PAY" for "PAY-1234" and "#" for "#567".
+func TicketPrefix(key string) string {
+	prefix := strings.TrimRight(key, "0123456789")
+	if prefix == "#" {
+		return prefix
+	}
+	prefix = strings.TrimRight(prefix, "-_/# ")
+	if prefix == "" {
+		return key
+	}
+	return prefix
+}
+
+// TopTicketPrefixes returns up to n prefixes ordered by the number of distinct tickets that use them.
+func TopTicketPrefixes(tickets map[string]Metric, n int) []string {
+	counts := make(map[string]int)
+	for key := range tickets {
+		counts[TicketPrefix(key)]++
+	}
+	prefixes := make([]string, 0, len(counts))
+	for prefix := range counts {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		if counts[prefixes[i]] != counts[prefixes[j]] {
+			return counts[prefixes[i]] > counts[prefixes[j]]
+		}
+		return prefixes[i] < prefixes[j]
+	})
+	if len(prefixes) > n {
+		prefixes = prefixes[:n]
+	}
+	return prefixes
+}
+
+// TicketRecord summarizes the commits that reference one ticket.
+type TicketRecord struct {
+	Key        string    `json:"key"`         // Ticket key (e.g. PAY-1234 or #567)
+	Prefix     string    `json:"prefix"`      // Project prefix of the key
+	Commits    int       `json:"commits"`     // Commits that referenced the ticket
+	FixCommits int       `json:"fix_commits"` // Referencing commits classified as fixes
+	Churn      int       `json:"churn"`       // Lines added/deleted by those commits within the path
+	Files      int       `json:"files"`       // Distinct files touched within the path
+	FirstSeen  time.Time `json:"first_seen"`  // Date of the earliest referencing commit
+	LastSeen   time.Time `json:"last_seen"`   // Date of the latest referencing commit
+	Subject    string    `json:"subject"`     // Subject of the latest referencing commit
+}
+
+// TicketPrefixCount counts tickets and commits for a ticket prefix.
+type TicketPrefixCount struct {
+	Prefix  string `json:"prefix"`
+	Tickets int    `json:"tickets"`
+	Commits int    `json:"commits"`
+}
+
+// TicketsSummary provides metadata about the ticket analysis.
+type TicketsSummary struct {
+	Path          string              `json:"path"`           // Path filter the tickets were collected for (empty = whole repo)
+	TotalCommits  int                 `json:"total_commits"`  // Commits that touched the path
+	LinkedCommits int                 `json:"linked_commits"` // Commits that referenced at least one ticket
+	TotalTickets  int                 `json:"total_tickets"`  // Distinct tickets found
+	Prefixes      []TicketPrefixCount `json:"prefixes"`       // Ticket prefixes ordered by ticket count
+}
+
+// TicketsResult lists the tickets whose commits touched a path.
+type TicketsResult struct {
+	Summary TicketsSummary `json:"summary"`
+	Tickets []TicketRecord `json:"tickets"`
+}
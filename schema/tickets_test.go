@@ -0,0 +1,46 @@
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/huangsam/hotspot/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTicketExtractor_Extract(t *testing.T) {
+	extractor := schema.DefaultTicketExtractor()
+
+	assert.Equal(t, []string{"PAY-1234", "OPS-7", "#567"}, extractor.Extract("PAY-1234: retry charges, see OPS-7 (#567)"))
+	assert.Equal(t, []string{"PAY-1"}, extractor.Extract("PAY-1 and PAY-1 again"))
+	assert.Empty(t, extractor.Extract("refactor: tidy up"))
+	assert.Empty(t, extractor.Extract("pay-12 lowercase keys are ignored"))
+
+	// A capture group selects the key
+	custom, err := schema.NewTicketExtractor([]string{`\[(\d+)\]`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"42"}, custom.Extract("[42] fix login"))
+	assert.Equal(t, `\[(\d+)\]`, custom.Signature())
+
+	_, err = schema.NewTicketExtractor([]string{"("})
+	assert.Error(t, err)
+
+	var none *schema.TicketExtractor
+	assert.Nil(t, none.Extract("PAY-1"))
+}
+
+func TestTicketPrefix(t *testing.T) {
+	assert.Equal(t, "PAY", schema.TicketPrefix("PAY-1234"))
+	assert.Equal(t, "#", schema.TicketPrefix("#567"))
+	assert.Equal(t, "GH", schema.TicketPrefix("GH_12"))
+	assert.Equal(t, "42", schema.TicketPrefix("42"))
+}
+
+func TestTopTicketPrefixes(t *testing.T) {
+	tickets := map[string]schema.Metric{
+		"PAY-1": 3, "PAY-2": 1, "OPS-9": 5, "#4": 1, "#5": 1,
+	}
+	assert.Equal(t, []string{"#", "PAY"}, schema.TopTicketPrefixes(tickets, 2))
+	assert.Equal(t, []string{"#", "PAY", "OPS"}, schema.TopTicketPrefixes(tickets, 5))
+	assert.Empty(t, schema.TopTicketPrefixes(nil, 3))
+}
@@ -133,3 +133,125 @@ func CalculateDecayFactor(ageDays float64, halfLifeDays float64) float64 {
 	k := math.Log(2) / halfLifeDays
 	return math.Exp(-k * ageDays)
 }
+
+// DecayKernel selects the shape of the weighting curve applied to older commits.
+type DecayKernel string
+
+const (
+	// ExponentialDecay halves a commit's weight every half-life.
+	ExponentialDecay DecayKernel = "exponential"
+	// LinearDecay lowers a commit's weight linearly, reaching 0.5 at the half-life and 0 at twice the half-life.
+	LinearDecay DecayKernel = "linear"
+	// StepDecay gives full weight to commits within the half-life and none to older commits.
+	StepDecay DecayKernel = "step"
+)
+
+// ValidDecayKernels lists the accepted decay kernels.
+var ValidDecayKernels = map[DecayKernel]bool{
+	ExponentialDecay: true,
+	LinearDecay:      true,
+	StepDecay:        true,
+}
+
+// Default temporal model values.
+const (
+	DefaultRecentWindowDays  = 30
+	DefaultDecayHalfLifeDays = 180.0
+	DefaultReworkWindowDays  = 21
+	DefaultEntropyPeriodDays = 30
+)
+
+// TemporalModel controls how commit timing shapes the metrics: which commits count as
+// recent, how quickly older activity loses weight in the decayed metrics, how soon
+// after being written deleted lines count as rework, and how history is split into
+// periods for change entropy.
+type TemporalModel struct {
+	RecentWindowDays  int         `json:"recent_window_days"`
+	DecayHalfLifeDays float64     `json:"decay_half_life_days"`
+	DecayKernel       DecayKernel `json:"decay_kernel"`
+	ReworkWindowDays  int         `json:"rework_window_days"`
+	EntropyPeriodDays int         `json:"entropy_period_days"`
+}
+
+// DefaultTemporalModel returns the built-in 30-day window with a 180-day exponential half-life
+// a 21-day rework window and 30-day change entropy periods.
+func DefaultTemporalModel() TemporalModel {
+	return TemporalModel{
+		RecentWindowDays:  DefaultRecentWindowDays,
+		DecayHalfLifeDays: DefaultDecayHalfLifeDays,
+		DecayKernel:       ExponentialDecay,
+		ReworkWindowDays:  DefaultReworkWindowDays,
+		EntropyPeriodDays: DefaultEntropyPeriodDays,
+	}
+}
+
+// WithDefaults fills unset fields with the default temporal model.
+func (t TemporalModel) WithDefaults() TemporalModel {
+	defaults := DefaultTemporalModel()
+	if t.RecentWindowDays <= 0 {
+		t.RecentWindowDays = defaults.RecentWindowDays
+	}
+	if t.DecayHalfLifeDays <= 0 {
+		t.DecayHalfLifeDays = defaults.DecayHalfLifeDays
+	}
+	if t.DecayKernel == "" {
+		t.DecayKernel = defaults.DecayKernel
+	}
+	if t.ReworkWindowDays <= 0 {
+		t.ReworkWindowDays = defaults.ReworkWindowDays
+	}
+	if t.EntropyPeriodDays <= 0 {
+		t.EntropyPeriodDays = defaults.EntropyPeriodDays
+	}
+	return t
+}
+
+// DecayFactor computes the weight [0,1] of activity that is ageDays old under this model.
+func (t TemporalModel) DecayFactor(ageDays float64) float64 {
+	switch t.DecayKernel {
+	case LinearDecay:
+		if t.DecayHalfLifeDays <= 0 {
+			return 1.0
+		}
+		return math.Max(0, math.Min(1, 1-ageDays/(2*t.DecayHalfLifeDays)))
+	case StepDecay:
+		if t.DecayHalfLifeDays <= 0 || ageDays <= t.DecayHalfLifeDays {
+			return 1.0
+		}
+		return 0.0
+	default:
+		return CalculateDecayFactor(ageDays, t.DecayHalfLifeDays)
+	}
+}
+
+// String summarizes the model for headers, e.g. "recent 30d, half-life 180d (exponential)".
+func (t TemporalModel) String() string {
+	return fmt.Sprintf("recent %dd, half-life %sd (%s)", t.RecentWindowDays, strconv.FormatFloat(t.DecayHalfLifeDays, 'f', -1, 64), t.DecayKernel)
+}
+
+// Local working hours. Commits made outside them count as off-hours.
+const (
+	WorkdayStartHour = 8
+	WorkdayEndHour   = 20
+)
+
+// IsOffHours reports whether t falls outside working hours in its own time zone, which for
+// commit dates is the author's local time.
+func IsOffHours(t time.Time) bool {
+	hour := t.Hour()
+	return hour < WorkdayStartHour || hour >= WorkdayEndHour
+}
+
+// IsWeekend reports whether t falls on a Saturday or Sunday in its own time zone.
+func IsWeekend(t time.Time) bool {
+	day := t.Weekday()
+	return day == time.Saturday || day == time.Sunday
+}
+
+// WorkHeatmap counts commits by the author's local weekday (Sunday first) and hour.
+type WorkHeatmap [7][24]int
+
+// Add counts a commit made at t in the author's local time.
+func (h *WorkHeatmap) Add(t time.Time) {
+	h[t.Weekday()][t.Hour()]++
+}
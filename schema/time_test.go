@@ -309,3 +309,56 @@ func FuzzParseLookbackDuration(f *testing.F) {
 		_ = err
 	})
 }
+
+func TestTemporalModel_DecayFactor(t *testing.T) {
+	tests := []struct {
+		kernel   schema.DecayKernel
+		ageDays  float64
+		expected float64
+	}{
+		{schema.ExponentialDecay, 0, 1.0},
+		{schema.ExponentialDecay, 100, 0.5},
+		{schema.ExponentialDecay, 200, 0.25},
+		{schema.LinearDecay, 50, 0.75},
+		{schema.LinearDecay, 100, 0.5},
+		{schema.LinearDecay, 300, 0.0},
+		{schema.StepDecay, 100, 1.0},
+		{schema.StepDecay, 101, 0.0},
+	}
+	for _, tt := range tests {
+		model := schema.TemporalModel{RecentWindowDays: 30, DecayHalfLifeDays: 100, DecayKernel: tt.kernel}
+		assert.InDelta(t, tt.expected, model.DecayFactor(tt.ageDays), 1e-9, "%s at %v days", tt.kernel, tt.ageDays)
+	}
+}
+
+func TestTemporalModel_WithDefaults(t *testing.T) {
+	assert.Equal(t, schema.DefaultTemporalModel(), schema.TemporalModel{}.WithDefaults())
+
+	custom := schema.TemporalModel{RecentWindowDays: 7, DecayKernel: schema.StepDecay}.WithDefaults()
+	assert.Equal(t, 7, custom.RecentWindowDays)
+	assert.Equal(t, schema.DefaultDecayHalfLifeDays, custom.DecayHalfLifeDays)
+	assert.Equal(t, schema.StepDecay, custom.DecayKernel)
+	assert.Equal(t, "recent 7d, half-life 180d (step)", custom.String())
+}
+
+func TestWorkPatterns(t *testing.T) {
+	// 2024-03-02 is a Saturday; times are judged in the commit's own time zone
+	saturdayNight := time.Date(2024, 3, 2, 23, 30, 0, 0, time.FixedZone("CEST", 2*60*60))
+	assert.True(t, schema.IsOffHours(saturdayNight))
+	assert.True(t, schema.IsWeekend(saturdayNight))
+
+	// 09:00 on a Monday in Tokyo is midnight in UTC, but working hours for the author
+	mondayMorning := time.Date(2024, 3, 4, 9, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+	assert.False(t, schema.IsOffHours(mondayMorning))
+	assert.False(t, schema.IsWeekend(mondayMorning))
+	assert.True(t, schema.IsOffHours(mondayMorning.UTC()))
+
+	assert.False(t, schema.IsOffHours(time.Date(2024, 3, 4, 19, 59, 0, 0, time.UTC)))
+	assert.True(t, schema.IsOffHours(time.Date(2024, 3, 4, 20, 0, 0, 0, time.UTC)))
+
+	var heatmap schema.WorkHeatmap
+	heatmap.Add(saturdayNight)
+	heatmap.Add(mondayMorning)
+	assert.Equal(t, 1, heatmap[time.Saturday][23])
+	assert.Equal(t, 1, heatmap[time.Monday][9])
+}